@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/cmd/flags"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/roles"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	manifeststores "github.com/consensys/quorum-key-manager/src/stores/api/manifest"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database/postgres"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	manifestvaults "github.com/consensys/quorum-key-manager/src/vaults/api/manifest"
+	vaultsdb "github.com/consensys/quorum-key-manager/src/vaults/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/vaults/service/vaults"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newBackupCommand() *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up and restore stores",
+	}
+
+	// Register Create command
+	var output, passphraseFile, publicKey string
+	createCmd := &cobra.Command{
+		Use:   "create [store]",
+		Short: "Write an encrypted archive of the items of a store and their index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := backupCreateCmd(cmd, args[0], output, passphraseFile, publicKey)
+			if err != nil {
+				cmd.SilenceUsage = true
+			}
+			return err
+		},
+	}
+	backupCmd.AddCommand(createCmd)
+	flags.PGFlags(createCmd.Flags())
+	flags.ManifestFlags(createCmd.Flags())
+	createCmd.Flags().StringVar(&output, "output", "", "File the archive is written to")
+	createCmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "File containing the passphrase encrypting the archive")
+	createCmd.Flags().StringVar(&publicKey, "public-key", "", "Hex encoded secp256k1 public key of the recipient of the archive")
+	_ = createCmd.MarkFlagRequired("output")
+
+	// Register Restore command
+	var input, restorePassphraseFile, privateKeyFile string
+	restoreCmd := &cobra.Command{
+		Use:   "restore [store]",
+		Short: "Restore an encrypted archive into an empty store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := backupRestoreCmd(cmd, args[0], input, restorePassphraseFile, privateKeyFile)
+			if err != nil {
+				cmd.SilenceUsage = true
+			}
+			return err
+		},
+	}
+	backupCmd.AddCommand(restoreCmd)
+	flags.PGFlags(restoreCmd.Flags())
+	flags.ManifestFlags(restoreCmd.Flags())
+	restoreCmd.Flags().StringVar(&input, "input", "", "File the archive is read from")
+	restoreCmd.Flags().StringVar(&restorePassphraseFile, "passphrase-file", "", "File containing the passphrase of the archive")
+	restoreCmd.Flags().StringVar(&privateKeyFile, "private-key-file", "", "File containing the hex encoded secp256k1 private key of the recipient of the archive")
+	_ = restoreCmd.MarkFlagRequired("input")
+
+	return backupCmd
+}
+
+func backupCreateCmd(cmd *cobra.Command, storeName, output, passphraseFile, publicKey string) error {
+	ctx := cmd.Context()
+
+	encryption := &storesentities.BackupEncryption{}
+	var err error
+	if passphraseFile != "" {
+		encryption.Passphrase, err = readSecretFile(passphraseFile)
+		if err != nil {
+			return err
+		}
+	}
+	if publicKey != "" {
+		encryption.PublicKey, err = hexutil.Decode(publicKey)
+		if err != nil {
+			return fmt.Errorf("invalid public key. %s", err.Error())
+		}
+	}
+
+	logger, err := getLogger()
+	if err != nil {
+		return err
+	}
+	defer syncZapLogger(logger)
+
+	storesConnector, err := newStoresConnector(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	archive, err := storesConnector.Backup(ctx, storeName, encryption, auth.NewWildcardUser())
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(output, archive, 0600)
+}
+
+func backupRestoreCmd(cmd *cobra.Command, storeName, input, passphraseFile, privateKeyFile string) error {
+	ctx := cmd.Context()
+
+	archive, err := ioutil.ReadFile(input)
+	if err != nil {
+		return err
+	}
+
+	decryption := &storesentities.BackupDecryption{}
+	if passphraseFile != "" {
+		decryption.Passphrase, err = readSecretFile(passphraseFile)
+		if err != nil {
+			return err
+		}
+	}
+	if privateKeyFile != "" {
+		var privKey string
+		privKey, err = readSecretFile(privateKeyFile)
+		if err != nil {
+			return err
+		}
+
+		decryption.PrivateKey, err = hexutil.Decode(privKey)
+		if err != nil {
+			return fmt.Errorf("invalid private key. %s", err.Error())
+		}
+	}
+
+	logger, err := getLogger()
+	if err != nil {
+		return err
+	}
+	defer syncZapLogger(logger)
+
+	storesConnector, err := newStoresConnector(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	items, err := storesConnector.RestoreBackup(ctx, storeName, archive, decryption, auth.NewWildcardUser())
+	for _, item := range items {
+		cmd.Printf("%s\t%s\t%s\n", item.ID, item.Status, item.Error)
+	}
+
+	return err
+}
+
+// newStoresConnector creates the stores declared in the manifests
+func newStoresConnector(ctx context.Context, logger log.Logger) (*stores.Connector, error) {
+	postgresClient, err := client.New(flags.NewPostgresConfig(viper.GetViper()))
+	if err != nil {
+		return nil, err
+	}
+
+	mnfs, err := getManifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := roles.New(nil, logger)
+	vaultService := vaults.New(vaultsdb.NewDataEncryptionKeys(postgresClient), roles, logger)
+	if err = manifestvaults.NewVaultsHandler(vaultService).Register(ctx, mnfs[entities.VaultKind]); err != nil {
+		return nil, err
+	}
+
+	storesConnector := stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, logger)
+	if err = manifeststores.NewStoresHandler(storesConnector).Register(ctx, mnfs[entities.StoreKind]); err != nil {
+		return nil, err
+	}
+
+	return storesConnector, nil
+}
+
+func readSecretFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
@@ -11,13 +11,36 @@ func NewAppConfig(vipr *viper.Viper) (*app.Config, error) {
 		return nil, err
 	}
 
+	oidcCfg, err := NewOIDCConfig(vipr)
+	if err != nil {
+		return nil, err
+	}
+
+	introspectionCfg, err := NewIntrospectionConfig(vipr)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsIdentityMapping, err := NewTLSIdentityMapping(vipr)
+	if err != nil {
+		return nil, err
+	}
+
 	return &app.Config{
 		Logger:   NewLoggerConfig(vipr),
 		HTTP:     httpCfg,
 		Manifest: NewManifestConfig(vipr),
-		OIDC:     NewOIDCConfig(vipr),
+		OIDC:     oidcCfg,
 		APIKey:   NewAPIKeyConfig(vipr),
 		TLS:      NewTLSConfig(vipr),
 		Postgres: NewPostgresConfig(vipr),
+
+		Introspection: introspectionCfg,
+		TLSRevocation: NewTLSRevocationConfig(vipr),
+
+		TLSIdentityMapping: tlsIdentityMapping,
+
+		KeyRotationInterval: NewKeyRotationInterval(vipr),
+		PurgeInterval:       NewPurgeInterval(vipr),
 	}, nil
 }
@@ -0,0 +1,101 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/jwt/introspection"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	_ = viper.BindEnv(authOIDCIntrospectionURLViperKey, authOIDCIntrospectionURLEnv)
+	_ = viper.BindEnv(authOIDCIntrospectionClientIDViperKey, authOIDCIntrospectionClientIDEnv)
+	_ = viper.BindEnv(authOIDCIntrospectionClientSecretViperKey, authOIDCIntrospectionClientSecretEnv)
+	_ = viper.BindEnv(authOIDCIntrospectionCacheTTLViperKey, authOIDCIntrospectionCacheTTLEnv)
+}
+
+const (
+	authOIDCIntrospectionURLFlag     = "auth-oidc-introspection-url"
+	authOIDCIntrospectionURLViperKey = "auth.oidc.introspection.url"
+	authOIDCIntrospectionURLEnv      = "AUTH_OIDC_INTROSPECTION_URL"
+)
+
+const (
+	authOIDCIntrospectionClientIDFlag     = "auth-oidc-introspection-client-id"
+	authOIDCIntrospectionClientIDViperKey = "auth.oidc.introspection.client.id"
+	authOIDCIntrospectionClientIDEnv      = "AUTH_OIDC_INTROSPECTION_CLIENT_ID"
+)
+
+const (
+	authOIDCIntrospectionClientSecretFlag     = "auth-oidc-introspection-client-secret"
+	authOIDCIntrospectionClientSecretViperKey = "auth.oidc.introspection.client.secret"
+	authOIDCIntrospectionClientSecretEnv      = "AUTH_OIDC_INTROSPECTION_CLIENT_SECRET"
+)
+
+const (
+	authOIDCIntrospectionCacheTTLFlag     = "auth-oidc-introspection-cache-ttl"
+	authOIDCIntrospectionCacheTTLViperKey = "auth.oidc.introspection.cache.ttl"
+	authOIDCIntrospectionCacheTTLDefault  = time.Minute
+	authOIDCIntrospectionCacheTTLEnv      = "AUTH_OIDC_INTROSPECTION_CACHE_TTL"
+)
+
+func IntrospectionFlags(f *pflag.FlagSet) {
+	authOIDCIntrospectionURL(f)
+	authOIDCIntrospectionClientID(f)
+	authOIDCIntrospectionClientSecret(f)
+	authOIDCIntrospectionCacheTTL(f)
+}
+
+func authOIDCIntrospectionURL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`OAuth2 token introspection endpoint (RFC 7662) used to validate opaque access tokens.
+Environment variable: %q`, authOIDCIntrospectionURLEnv)
+	f.String(authOIDCIntrospectionURLFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCIntrospectionURLViperKey, f.Lookup(authOIDCIntrospectionURLFlag))
+}
+
+func authOIDCIntrospectionClientID(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Client ID used to authenticate to the token introspection endpoint.
+Environment variable: %q`, authOIDCIntrospectionClientIDEnv)
+	f.String(authOIDCIntrospectionClientIDFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCIntrospectionClientIDViperKey, f.Lookup(authOIDCIntrospectionClientIDFlag))
+}
+
+func authOIDCIntrospectionClientSecret(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Client secret used to authenticate to the token introspection endpoint.
+Environment variable: %q`, authOIDCIntrospectionClientSecretEnv)
+	f.String(authOIDCIntrospectionClientSecretFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCIntrospectionClientSecretViperKey, f.Lookup(authOIDCIntrospectionClientSecretFlag))
+}
+
+func authOIDCIntrospectionCacheTTL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum duration an introspected active token is cached for, revoked tokens are accepted until then. Tokens are cached until their expiry when 0.
+Environment variable: %q`, authOIDCIntrospectionCacheTTLEnv)
+	f.Duration(authOIDCIntrospectionCacheTTLFlag, authOIDCIntrospectionCacheTTLDefault, desc)
+	_ = viper.BindPFlag(authOIDCIntrospectionCacheTTLViperKey, f.Lookup(authOIDCIntrospectionCacheTTLFlag))
+}
+
+// NewIntrospectionConfig shares the audience and claim mapping of the default OIDC issuer
+func NewIntrospectionConfig(vipr *viper.Viper) (*introspection.Config, error) {
+	introspectionURL := vipr.GetString(authOIDCIntrospectionURLViperKey)
+	if introspectionURL == "" {
+		return nil, nil
+	}
+
+	cfg := introspection.NewConfig(
+		introspectionURL,
+		vipr.GetString(authOIDCIntrospectionClientIDViperKey),
+		vipr.GetString(authOIDCIntrospectionClientSecretViperKey),
+		splitList(vipr.GetString(AuthOIDCAudienceViperKey)),
+		vipr.GetDuration(authOIDCIntrospectionCacheTTLViperKey),
+	)
+
+	claimMapping, err := newDefaultClaimMapping(vipr)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ClaimMapping = claimMapping
+
+	return cfg, nil
+}
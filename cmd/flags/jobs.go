@@ -0,0 +1,58 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(keyRotationIntervalViperKey, keyRotationIntervalDefault)
+	_ = viper.BindEnv(keyRotationIntervalViperKey, keyRotationIntervalEnv)
+	viper.SetDefault(purgeIntervalViperKey, purgeIntervalDefault)
+	_ = viper.BindEnv(purgeIntervalViperKey, purgeIntervalEnv)
+}
+
+const (
+	keyRotationIntervalFlag     = "key-rotation-interval"
+	keyRotationIntervalViperKey = "jobs.key-rotation.interval"
+	keyRotationIntervalDefault  = time.Minute
+	keyRotationIntervalEnv      = "KEY_ROTATION_INTERVAL"
+)
+
+const (
+	purgeIntervalFlag     = "purge-interval"
+	purgeIntervalViperKey = "jobs.purge.interval"
+	purgeIntervalDefault  = time.Hour
+	purgeIntervalEnv      = "PURGE_INTERVAL"
+)
+
+// JobsFlags register flags for the background jobs
+func JobsFlags(f *pflag.FlagSet) {
+	keyRotationInterval(f)
+	purgeInterval(f)
+}
+
+func keyRotationInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which the keys whose rotation period has elapsed are rotated, 0 disables scheduled rotations
+Environment variable: %q`, keyRotationIntervalEnv)
+	f.Duration(keyRotationIntervalFlag, keyRotationIntervalDefault, desc)
+	_ = viper.BindPFlag(keyRotationIntervalViperKey, f.Lookup(keyRotationIntervalFlag))
+}
+
+func NewKeyRotationInterval(vipr *viper.Viper) time.Duration {
+	return vipr.GetDuration(keyRotationIntervalViperKey)
+}
+
+func purgeInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which the deleted items whose recovery period has elapsed are destroyed, 0 disables automatic purges
+Environment variable: %q`, purgeIntervalEnv)
+	f.Duration(purgeIntervalFlag, purgeIntervalDefault, desc)
+	_ = viper.BindPFlag(purgeIntervalViperKey, f.Lookup(purgeIntervalFlag))
+}
+
+func NewPurgeInterval(vipr *viper.Viper) time.Duration {
+	return vipr.GetDuration(purgeIntervalViperKey)
+}
@@ -2,10 +2,12 @@ package flags
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strings"
 	"time"
 
 	"github.com/consensys/quorum-key-manager/src/infra/jwt/jose"
+	"gopkg.in/yaml.v2"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -15,6 +17,14 @@ func init() {
 	_ = viper.BindEnv(authOIDCIssuerURLViperKey, authOIDCIssuerURLEnv)
 	_ = viper.BindEnv(AuthOIDCAudienceViperKey, authOIDCAudienceEnv)
 	_ = viper.BindEnv(authOIDCCustomClaimsViperKey, authOIDCCustomClaimsEnv)
+	_ = viper.BindEnv(authOIDCAlgorithmsViperKey, authOIDCAlgorithmsEnv)
+	_ = viper.BindEnv(authOIDCCacheTTLViperKey, authOIDCCacheTTLEnv)
+	_ = viper.BindEnv(authOIDCIssuersFileViperKey, authOIDCIssuersFileEnv)
+	_ = viper.BindEnv(authOIDCTenantClaimViperKey, authOIDCTenantClaimEnv)
+	_ = viper.BindEnv(authOIDCUsernameClaimViperKey, authOIDCUsernameClaimEnv)
+	_ = viper.BindEnv(authOIDCRolesClaimViperKey, authOIDCRolesClaimEnv)
+	_ = viper.BindEnv(authOIDCPermissionsClaimViperKey, authOIDCPermissionsClaimEnv)
+	_ = viper.BindEnv(authOIDCRoleMappingViperKey, authOIDCRoleMappingEnv)
 }
 
 const (
@@ -36,10 +46,68 @@ const (
 	authOIDCCustomClaimsEnv      = "AUTH_OIDC_CUSTOM_CLAIMS"
 )
 
+const (
+	authOIDCAlgorithmsFlag     = "auth-oidc-algorithms"
+	authOIDCAlgorithmsViperKey = "auth.oidc.algorithms"
+	authOIDCAlgorithmsDefault  = jose.DefaultAlgorithm
+	authOIDCAlgorithmsEnv      = "AUTH_OIDC_ALGORITHMS"
+)
+
+const (
+	authOIDCCacheTTLFlag     = "auth-oidc-cache-ttl"
+	authOIDCCacheTTLViperKey = "auth.oidc.cache.ttl"
+	authOIDCCacheTTLDefault  = 5 * time.Minute
+	authOIDCCacheTTLEnv      = "AUTH_OIDC_CACHE_TTL"
+)
+
+const (
+	authOIDCIssuersFileFlag     = "auth-oidc-issuers-file"
+	authOIDCIssuersFileViperKey = "auth.oidc.issuers.file"
+	authOIDCIssuersFileEnv      = "AUTH_OIDC_ISSUERS_FILE"
+)
+
+const (
+	authOIDCTenantClaimFlag     = "auth-oidc-tenant-claim"
+	authOIDCTenantClaimViperKey = "auth.oidc.tenant.claim"
+	authOIDCTenantClaimEnv      = "AUTH_OIDC_TENANT_CLAIM"
+)
+
+const (
+	authOIDCUsernameClaimFlag     = "auth-oidc-username-claim"
+	authOIDCUsernameClaimViperKey = "auth.oidc.username.claim"
+	authOIDCUsernameClaimEnv      = "AUTH_OIDC_USERNAME_CLAIM"
+)
+
+const (
+	authOIDCRolesClaimFlag     = "auth-oidc-roles-claim"
+	authOIDCRolesClaimViperKey = "auth.oidc.roles.claim"
+	authOIDCRolesClaimEnv      = "AUTH_OIDC_ROLES_CLAIM"
+)
+
+const (
+	authOIDCPermissionsClaimFlag     = "auth-oidc-permissions-claim"
+	authOIDCPermissionsClaimViperKey = "auth.oidc.permissions.claim"
+	authOIDCPermissionsClaimEnv      = "AUTH_OIDC_PERMISSIONS_CLAIM"
+)
+
+const (
+	authOIDCRoleMappingFlag     = "auth-oidc-role-mapping"
+	authOIDCRoleMappingViperKey = "auth.oidc.role.mapping"
+	authOIDCRoleMappingEnv      = "AUTH_OIDC_ROLE_MAPPING"
+)
+
 func OIDCFlags(f *pflag.FlagSet) {
 	authOIDCIssuerServer(f)
 	authOIDCAudience(f)
 	authOIDCCustomClaimsPath(f)
+	authOIDCAlgorithms(f)
+	authOIDCCacheTTL(f)
+	authOIDCIssuersFile(f)
+	authOIDCTenantClaim(f)
+	authOIDCUsernameClaim(f)
+	authOIDCRolesClaim(f)
+	authOIDCPermissionsClaim(f)
+	authOIDCRoleMapping(f)
 }
 
 func authOIDCIssuerServer(f *pflag.FlagSet) {
@@ -63,22 +131,187 @@ Environment variable: %q`, authOIDCCustomClaimsEnv)
 	_ = viper.BindPFlag(authOIDCCustomClaimsViperKey, f.Lookup(authOIDCCustomClaimsFlag))
 }
 
-func NewOIDCConfig(vipr *viper.Viper) *jose.Config {
-	issuerURL := vipr.GetString(authOIDCIssuerURLViperKey)
+func authOIDCAlgorithms(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Comma separated list of allowed JWT signing algorithms (RS256, RS384, RS512, PS256, PS384, PS512, ES256, ES384, ES512 or EdDSA).
+Environment variable: %q`, authOIDCAlgorithmsEnv)
+	f.String(authOIDCAlgorithmsFlag, authOIDCAlgorithmsDefault, desc)
+	_ = viper.BindPFlag(authOIDCAlgorithmsViperKey, f.Lookup(authOIDCAlgorithmsFlag))
+}
 
-	aud := []string{}
-	if vipr.GetString(AuthOIDCAudienceViperKey) != "" {
-		aud = strings.Split(vipr.GetString(AuthOIDCAudienceViperKey), ",")
-	}
+func authOIDCCacheTTL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration the issuer JSON Web Key Set is cached for.
+Environment variable: %q`, authOIDCCacheTTLEnv)
+	f.Duration(authOIDCCacheTTLFlag, authOIDCCacheTTLDefault, desc)
+	_ = viper.BindPFlag(authOIDCCacheTTLViperKey, f.Lookup(authOIDCCacheTTLFlag))
+}
+
+func authOIDCIssuersFile(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Path to a YAML file listing additional OpenID Connect issuers, each with its own audience, algorithms, cache TTL and claim mapping.
+Environment variable: %q`, authOIDCIssuersFileEnv)
+	f.String(authOIDCIssuersFileFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCIssuersFileViperKey, f.Lookup(authOIDCIssuersFileFlag))
+}
+
+func authOIDCTenantClaim(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`JSON path of the tenant claim in the JWT (ie. "org.tenant"), defaults to the subject.
+Environment variable: %q`, authOIDCTenantClaimEnv)
+	f.String(authOIDCTenantClaimFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCTenantClaimViperKey, f.Lookup(authOIDCTenantClaimFlag))
+}
+
+func authOIDCUsernameClaim(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`JSON path of the username claim in the JWT (ie. "preferred_username").
+Environment variable: %q`, authOIDCUsernameClaimEnv)
+	f.String(authOIDCUsernameClaimFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCUsernameClaimViperKey, f.Lookup(authOIDCUsernameClaimFlag))
+}
+
+func authOIDCRolesClaim(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`JSON path of the roles or groups claim in the JWT (ie. "realm_access.roles" or "groups").
+Environment variable: %q`, authOIDCRolesClaimEnv)
+	f.String(authOIDCRolesClaimFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCRolesClaimViperKey, f.Lookup(authOIDCRolesClaimFlag))
+}
+
+func authOIDCPermissionsClaim(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`JSON path of the permissions claim in the JWT, defaults to the scope.
+Environment variable: %q`, authOIDCPermissionsClaimEnv)
+	f.String(authOIDCPermissionsClaimFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCPermissionsClaimViperKey, f.Lookup(authOIDCPermissionsClaimFlag))
+}
 
+func authOIDCRoleMapping(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Comma separated list of identity provider group to Quorum Key Manager role mappings (ie. "kms-admins=admin,kms-users=signer"). Once set, groups without a mapping grant no role.
+Environment variable: %q`, authOIDCRoleMappingEnv)
+	f.String(authOIDCRoleMappingFlag, "", desc)
+	_ = viper.BindPFlag(authOIDCRoleMappingViperKey, f.Lookup(authOIDCRoleMappingFlag))
+}
+
+type oidcIssuerConfig struct {
+	IssuerURL    string              `yaml:"issuer_url"`
+	Audience     []string            `yaml:"audience"`
+	Algorithms   []string            `yaml:"algorithms"`
+	CacheTTL     time.Duration       `yaml:"cache_ttl"`
+	CustomClaims string              `yaml:"custom_claims"`
+	Claims       *oidcClaimsConfig   `yaml:"claims"`
+	RoleMapping  map[string][]string `yaml:"role_mapping"`
+}
+
+type oidcClaimsConfig struct {
+	Tenant      string `yaml:"tenant"`
+	Username    string `yaml:"username"`
+	Roles       string `yaml:"roles"`
+	Permissions string `yaml:"permissions"`
+}
+
+func NewOIDCConfig(vipr *viper.Viper) ([]*jose.Config, error) {
+	var cfgs []*jose.Config
+
+	issuerURL := vipr.GetString(authOIDCIssuerURLViperKey)
 	if issuerURL != "" {
-		return jose.NewConfig(
+		cfg := jose.NewConfig(
 			issuerURL,
-			aud,
+			splitList(vipr.GetString(AuthOIDCAudienceViperKey)),
 			vipr.GetString(authOIDCCustomClaimsViperKey),
-			5*time.Minute, // TODO: Make the cache ttl an ENV var if needed
+			vipr.GetDuration(authOIDCCacheTTLViperKey),
+			splitList(vipr.GetString(authOIDCAlgorithmsViperKey))...,
 		)
+
+		claimMapping, err := newDefaultClaimMapping(vipr)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.ClaimMapping = claimMapping
+		cfgs = append(cfgs, cfg)
+	}
+
+	issuersFile := vipr.GetString(authOIDCIssuersFileViperKey)
+	if issuersFile != "" {
+		data, err := ioutil.ReadFile(issuersFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OIDC issuers file %q: %w", issuersFile, err)
+		}
+
+		var issuers []*oidcIssuerConfig
+		if err = yaml.UnmarshalStrict(data, &issuers); err != nil {
+			return nil, fmt.Errorf("failed to parse OIDC issuers file %q: %w", issuersFile, err)
+		}
+
+		for _, issuer := range issuers {
+			if issuer.IssuerURL == "" {
+				return nil, fmt.Errorf("missing issuer_url in OIDC issuers file %q", issuersFile)
+			}
+
+			audience := issuer.Audience
+			if audience == nil {
+				audience = []string{}
+			}
+
+			cacheTTL := issuer.CacheTTL
+			if cacheTTL == 0 {
+				cacheTTL = vipr.GetDuration(authOIDCCacheTTLViperKey)
+			}
+
+			cfg := jose.NewConfig(issuer.IssuerURL, audience, issuer.CustomClaims, cacheTTL, issuer.Algorithms...)
+			cfg.ClaimMapping = newClaimMapping(issuer.Claims, issuer.RoleMapping)
+			cfgs = append(cfgs, cfg)
+		}
+	}
+
+	return cfgs, nil
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+
+	return strings.Split(value, ",")
+}
+
+func newDefaultClaimMapping(vipr *viper.Viper) (*jose.ClaimMapping, error) {
+	roleMapping, err := parseRoleMapping(vipr.GetString(authOIDCRoleMappingViperKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return newClaimMapping(&oidcClaimsConfig{
+		Tenant:      vipr.GetString(authOIDCTenantClaimViperKey),
+		Username:    vipr.GetString(authOIDCUsernameClaimViperKey),
+		Roles:       vipr.GetString(authOIDCRolesClaimViperKey),
+		Permissions: vipr.GetString(authOIDCPermissionsClaimViperKey),
+	}, roleMapping), nil
+}
+
+func newClaimMapping(claims *oidcClaimsConfig, roleMapping map[string][]string) *jose.ClaimMapping {
+	if claims == nil {
+		claims = &oidcClaimsConfig{}
+	}
+
+	if *claims == (oidcClaimsConfig{}) && len(roleMapping) == 0 {
+		return nil
+	}
+
+	return &jose.ClaimMapping{
+		Tenant:      claims.Tenant,
+		Username:    claims.Username,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+		RoleMapping: roleMapping,
+	}
+}
+
+func parseRoleMapping(value string) (map[string][]string, error) {
+	roleMapping := make(map[string][]string)
+	for _, entry := range splitList(value) {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid OIDC role mapping %q, expected format is group=role", entry)
+		}
+
+		roleMapping[parts[0]] = append(roleMapping[parts[0]], parts[1])
 	}
 
-	return nil
+	return roleMapping, nil
 }
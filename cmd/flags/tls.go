@@ -2,14 +2,25 @@ package flags
 
 import (
 	"fmt"
+	"io/ioutil"
+	"time"
 
 	tls "github.com/consensys/quorum-key-manager/src/infra/tls/filesystem"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/identity"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/revocation"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 )
 
 func init() {
 	_ = viper.BindEnv(authTLSCertsFileViperKey, authTLSCertsFileEnv)
+	_ = viper.BindEnv(authTLSCRLViperKey, authTLSCRLEnv)
+	_ = viper.BindEnv(authTLSCRLRefreshIntervalViperKey, authTLSCRLRefreshIntervalEnv)
+	_ = viper.BindEnv(authTLSOCSPViperKey, authTLSOCSPEnv)
+	_ = viper.BindEnv(authTLSOCSPCacheTTLViperKey, authTLSOCSPCacheTTLEnv)
+	_ = viper.BindEnv(authTLSRevocationFailOpenViperKey, authTLSRevocationFailOpenEnv)
+	_ = viper.BindEnv(authTLSIdentityMappingFileViperKey, authTLSIdentityMappingFileEnv)
 }
 
 const (
@@ -19,8 +30,56 @@ const (
 	authTLSCertsFileEnv      = "AUTH_TLS_CA"
 )
 
+const (
+	authTLSCRLFlag     = "auth-tls-crl"
+	authTLSCRLViperKey = "auth.tls.crl"
+	authTLSCRLDefault  = ""
+	authTLSCRLEnv      = "AUTH_TLS_CRL"
+)
+
+const (
+	authTLSCRLRefreshIntervalFlag     = "auth-tls-crl-refresh-interval"
+	authTLSCRLRefreshIntervalViperKey = "auth.tls.crl.refresh.interval"
+	authTLSCRLRefreshIntervalDefault  = time.Hour
+	authTLSCRLRefreshIntervalEnv      = "AUTH_TLS_CRL_REFRESH_INTERVAL"
+)
+
+const (
+	authTLSOCSPFlag     = "auth-tls-ocsp"
+	authTLSOCSPViperKey = "auth.tls.ocsp"
+	authTLSOCSPDefault  = false
+	authTLSOCSPEnv      = "AUTH_TLS_OCSP"
+)
+
+const (
+	authTLSOCSPCacheTTLFlag     = "auth-tls-ocsp-cache-ttl"
+	authTLSOCSPCacheTTLViperKey = "auth.tls.ocsp.cache.ttl"
+	authTLSOCSPCacheTTLDefault  = 5 * time.Minute
+	authTLSOCSPCacheTTLEnv      = "AUTH_TLS_OCSP_CACHE_TTL"
+)
+
+const (
+	authTLSRevocationFailOpenFlag     = "auth-tls-revocation-fail-open"
+	authTLSRevocationFailOpenViperKey = "auth.tls.revocation.fail.open"
+	authTLSRevocationFailOpenDefault  = false
+	authTLSRevocationFailOpenEnv      = "AUTH_TLS_REVOCATION_FAIL_OPEN"
+)
+
+const (
+	authTLSIdentityMappingFileFlag     = "auth-tls-identity-mapping-file"
+	authTLSIdentityMappingFileViperKey = "auth.tls.identity.mapping.file"
+	authTLSIdentityMappingFileDefault  = ""
+	authTLSIdentityMappingFileEnv      = "AUTH_TLS_IDENTITY_MAPPING_FILE"
+)
+
 func TLSFlags(f *pflag.FlagSet) {
 	authTLSCertFile(f)
+	authTLSCRL(f)
+	authTLSCRLRefreshInterval(f)
+	authTLSOCSP(f)
+	authTLSOCSPCacheTTL(f)
+	authTLSRevocationFailOpen(f)
+	authTLSIdentityMappingFile(f)
 }
 
 func authTLSCertFile(f *pflag.FlagSet) {
@@ -30,6 +89,48 @@ Environment variable: %q`, authTLSCertsFileEnv)
 	_ = viper.BindPFlag(authTLSCertsFileViperKey, f.Lookup(authTLSCertsFileFlag))
 }
 
+func authTLSCRL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Comma separated list of certificate revocation list file paths or URLs checked on TLS authentication.
+Environment variable: %q`, authTLSCRLEnv)
+	f.String(authTLSCRLFlag, authTLSCRLDefault, desc)
+	_ = viper.BindPFlag(authTLSCRLViperKey, f.Lookup(authTLSCRLFlag))
+}
+
+func authTLSCRLRefreshInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which certificate revocation lists are reloaded in the background. A CRL that fails to reload is kept until the next reload.
+Environment variable: %q`, authTLSCRLRefreshIntervalEnv)
+	f.Duration(authTLSCRLRefreshIntervalFlag, authTLSCRLRefreshIntervalDefault, desc)
+	_ = viper.BindPFlag(authTLSCRLRefreshIntervalViperKey, f.Lookup(authTLSCRLRefreshIntervalFlag))
+}
+
+func authTLSOCSP(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Query the OCSP responders of client certificates on TLS authentication.
+Environment variable: %q`, authTLSOCSPEnv)
+	f.Bool(authTLSOCSPFlag, authTLSOCSPDefault, desc)
+	_ = viper.BindPFlag(authTLSOCSPViperKey, f.Lookup(authTLSOCSPFlag))
+}
+
+func authTLSOCSPCacheTTL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum duration OCSP responses are cached for.
+Environment variable: %q`, authTLSOCSPCacheTTLEnv)
+	f.Duration(authTLSOCSPCacheTTLFlag, authTLSOCSPCacheTTLDefault, desc)
+	_ = viper.BindPFlag(authTLSOCSPCacheTTLViperKey, f.Lookup(authTLSOCSPCacheTTLFlag))
+}
+
+func authTLSRevocationFailOpen(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Accept client certificates whose revocation status cannot be determined (CRL or OCSP responder unavailable).
+Environment variable: %q`, authTLSRevocationFailOpenEnv)
+	f.Bool(authTLSRevocationFailOpenFlag, authTLSRevocationFailOpenDefault, desc)
+	_ = viper.BindPFlag(authTLSRevocationFailOpenViperKey, f.Lookup(authTLSRevocationFailOpenFlag))
+}
+
+func authTLSIdentityMappingFile(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`YAML file mapping client certificate fields (subject, URI SAN, DNS SAN, email) and SPIFFE IDs to tenant, username, roles and permissions.
+Environment variable: %q`, authTLSIdentityMappingFileEnv)
+	f.String(authTLSIdentityMappingFileFlag, authTLSIdentityMappingFileDefault, desc)
+	_ = viper.BindPFlag(authTLSIdentityMappingFileViperKey, f.Lookup(authTLSIdentityMappingFileFlag))
+}
+
 func NewTLSConfig(vipr *viper.Viper) *tls.Config {
 	path := vipr.GetString(authTLSCertsFileViperKey)
 
@@ -39,3 +140,89 @@ func NewTLSConfig(vipr *viper.Viper) *tls.Config {
 
 	return nil
 }
+
+func NewTLSRevocationConfig(vipr *viper.Viper) *revocation.Config {
+	crls := splitList(vipr.GetString(authTLSCRLViperKey))
+	ocsp := vipr.GetBool(authTLSOCSPViperKey)
+
+	if len(crls) == 0 && !ocsp {
+		return nil
+	}
+
+	return revocation.NewConfig(
+		crls,
+		vipr.GetDuration(authTLSCRLRefreshIntervalViperKey),
+		ocsp,
+		vipr.GetDuration(authTLSOCSPCacheTTLViperKey),
+		vipr.GetBool(authTLSRevocationFailOpenViperKey),
+	)
+}
+
+type tlsIdentityMappingConfig struct {
+	Tenant      *tlsIdentityFieldConfig  `yaml:"tenant"`
+	Username    *tlsIdentityFieldConfig  `yaml:"username"`
+	Roles       *tlsIdentityFieldConfig  `yaml:"roles"`
+	Permissions *tlsIdentityFieldConfig  `yaml:"permissions"`
+	Rules       []*tlsIdentityRuleConfig `yaml:"rules"`
+}
+
+type tlsIdentityFieldConfig struct {
+	Source  string `yaml:"source"`
+	Pattern string `yaml:"pattern"`
+}
+
+type tlsIdentityRuleConfig struct {
+	SPIFFEID    string   `yaml:"spiffe_id"`
+	Pattern     string   `yaml:"pattern"`
+	Tenant      string   `yaml:"tenant"`
+	Username    string   `yaml:"username"`
+	Roles       []string `yaml:"roles"`
+	Permissions []string `yaml:"permissions"`
+}
+
+func NewTLSIdentityMapping(vipr *viper.Viper) (*identity.Mapping, error) {
+	mappingFile := vipr.GetString(authTLSIdentityMappingFileViperKey)
+	if mappingFile == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(mappingFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS identity mapping file %q: %w", mappingFile, err)
+	}
+
+	cfg := &tlsIdentityMappingConfig{}
+	if err = yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse TLS identity mapping file %q: %w", mappingFile, err)
+	}
+
+	mapping := &identity.Mapping{}
+	fields := []struct {
+		cfg   *tlsIdentityFieldConfig
+		field **identity.Field
+	}{
+		{cfg.Tenant, &mapping.Tenant},
+		{cfg.Username, &mapping.Username},
+		{cfg.Roles, &mapping.Roles},
+		{cfg.Permissions, &mapping.Permissions},
+	}
+	for _, f := range fields {
+		if f.cfg == nil {
+			continue
+		}
+
+		if *f.field, err = identity.NewField(f.cfg.Source, f.cfg.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid TLS identity mapping file %q: %w", mappingFile, err)
+		}
+	}
+
+	for _, ruleCfg := range cfg.Rules {
+		rule, err := identity.NewRule(ruleCfg.SPIFFEID, ruleCfg.Pattern, ruleCfg.Tenant, ruleCfg.Username, ruleCfg.Roles, ruleCfg.Permissions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS identity mapping file %q: %w", mappingFile, err)
+		}
+		mapping.Rules = append(mapping.Rules, rule)
+	}
+
+	return mapping, nil
+}
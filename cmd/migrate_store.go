@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/consensys/quorum-key-manager/cmd/flags"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/roles"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	manifeststores "github.com/consensys/quorum-key-manager/src/stores/api/manifest"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database/postgres"
+	manifestvaults "github.com/consensys/quorum-key-manager/src/vaults/api/manifest"
+	vaultsdb "github.com/consensys/quorum-key-manager/src/vaults/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/vaults/service/vaults"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newMigrateStoreCommand() *cobra.Command {
+	var dryRun, deleteSource bool
+
+	migrateStoreCmd := &cobra.Command{
+		Use:   "migrate-store [store] [destination]",
+		Short: "Copy the keys, secrets or Ethereum accounts of a store into another store of the same type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := migrateStoreCmd(cmd, args[0], args[1], dryRun, deleteSource)
+			if err != nil {
+				cmd.SilenceUsage = true
+			}
+			return err
+		},
+	}
+
+	flags.PGFlags(migrateStoreCmd.Flags())
+	flags.ManifestFlags(migrateStoreCmd.Flags())
+	migrateStoreCmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the items that would be migrated without copying them")
+	migrateStoreCmd.Flags().BoolVar(&deleteSource, "delete-source", false, "Delete the migrated items from the source store")
+
+	return migrateStoreCmd
+}
+
+func migrateStoreCmd(cmd *cobra.Command, storeName, destStoreName string, dryRun, deleteSource bool) error {
+	ctx := cmd.Context()
+
+	logger, err := getLogger()
+	if err != nil {
+		return err
+	}
+	defer syncZapLogger(logger)
+
+	postgresClient, err := client.New(flags.NewPostgresConfig(viper.GetViper()))
+	if err != nil {
+		return err
+	}
+
+	mnfs, err := getManifests(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles := roles.New(nil, logger)
+	vaultService := vaults.New(vaultsdb.NewDataEncryptionKeys(postgresClient), roles, logger)
+	if err = manifestvaults.NewVaultsHandler(vaultService).Register(ctx, mnfs[entities.VaultKind]); err != nil {
+		return err
+	}
+
+	storesConnector := stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, logger)
+	if err = manifeststores.NewStoresHandler(storesConnector).Register(ctx, mnfs[entities.StoreKind]); err != nil {
+		return err
+	}
+
+	items, err := storesConnector.Migrate(ctx, storeName, destStoreName, dryRun, deleteSource, auth.NewWildcardUser())
+	for _, item := range items {
+		cmd.Printf("%s\t%s\t%s\n", item.ID, item.Status, item.Error)
+	}
+
+	return err
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/cmd/flags"
+	"github.com/consensys/quorum-key-manager/src/auth/service/roles"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	manifeststores "github.com/consensys/quorum-key-manager/src/stores/api/manifest"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database/postgres"
+	manifestvaults "github.com/consensys/quorum-key-manager/src/vaults/api/manifest"
+	vaultsdb "github.com/consensys/quorum-key-manager/src/vaults/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/vaults/service/vaults"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newPurgeCommand() *cobra.Command {
+	var dryRun bool
+
+	purgeCmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Destroy the deleted items whose recovery period has elapsed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := purgeCmd(cmd, dryRun)
+			if err != nil {
+				cmd.SilenceUsage = true
+			}
+			return err
+		},
+	}
+
+	flags.PGFlags(purgeCmd.Flags())
+	flags.ManifestFlags(purgeCmd.Flags())
+	purgeCmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the deleted items that would be destroyed without destroying them")
+
+	return purgeCmd
+}
+
+func purgeCmd(cmd *cobra.Command, dryRun bool) error {
+	ctx := cmd.Context()
+
+	logger, err := getLogger()
+	if err != nil {
+		return err
+	}
+	defer syncZapLogger(logger)
+
+	postgresClient, err := client.New(flags.NewPostgresConfig(viper.GetViper()))
+	if err != nil {
+		return err
+	}
+
+	mnfs, err := getManifests(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles := roles.New(nil, logger)
+	vaultService := vaults.New(vaultsdb.NewDataEncryptionKeys(postgresClient), roles, logger)
+	if err = manifestvaults.NewVaultsHandler(vaultService).Register(ctx, mnfs[entities.VaultKind]); err != nil {
+		return err
+	}
+
+	storesConnector := stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, logger)
+	if err = manifeststores.NewStoresHandler(storesConnector).Register(ctx, mnfs[entities.StoreKind]); err != nil {
+		return err
+	}
+
+	purgedItems, err := storesConnector.PurgeDeleted(ctx, dryRun)
+	for _, item := range purgedItems {
+		cmd.Printf("%s\t%s\t%s\t%s\n", item.StoreName, item.StoreType, item.ID, item.DeletedAt.Format(time.RFC3339))
+	}
+
+	return err
+}
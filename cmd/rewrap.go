@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"github.com/consensys/quorum-key-manager/cmd/flags"
+	"github.com/consensys/quorum-key-manager/src/auth/service/roles"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	manifeststores "github.com/consensys/quorum-key-manager/src/stores/api/manifest"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database/postgres"
+	manifestvaults "github.com/consensys/quorum-key-manager/src/vaults/api/manifest"
+	vaultsdb "github.com/consensys/quorum-key-manager/src/vaults/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/vaults/service/vaults"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newRewrapCommand() *cobra.Command {
+	rewrapCmd := &cobra.Command{
+		Use:   "rewrap",
+		Short: "Re-wrap the data encryption keys of the postgres vaults and local key stores with their current master key",
+		Long: "Re-wrap the data encryption keys still wrapped with the previous master key of their postgres vault or local key store. " +
+			"Once done, the previous master key can be removed from the manifests and retired. " +
+			"The private keys stored unencrypted by local key stores before their master key was configured are encrypted as a new version of their secret, " +
+			"previous versions should be destroyed in secret stores with versioning.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := rewrapCmd(cmd)
+			if err != nil {
+				cmd.SilenceUsage = true
+			}
+			return err
+		},
+	}
+
+	flags.PGFlags(rewrapCmd.Flags())
+	flags.ManifestFlags(rewrapCmd.Flags())
+
+	return rewrapCmd
+}
+
+// rewrapCmd loads the vaults and stores of the manifests, which re-wraps the data encryption keys whose vault or store
+// defines a previous master key, and encrypts the private keys of the local key stores stored before their master key
+func rewrapCmd(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	logger, err := getLogger()
+	if err != nil {
+		return err
+	}
+	defer syncZapLogger(logger)
+
+	postgresClient, err := client.New(flags.NewPostgresConfig(viper.GetViper()))
+	if err != nil {
+		return err
+	}
+
+	mnfs, err := getManifests(ctx)
+	if err != nil {
+		return err
+	}
+
+	roles := roles.New(nil, logger)
+	vaultService := vaults.New(vaultsdb.NewDataEncryptionKeys(postgresClient), roles, logger)
+	if err = manifestvaults.NewVaultsHandler(vaultService).Register(ctx, mnfs[entities.VaultKind]); err != nil {
+		return err
+	}
+
+	storesConnector := stores.NewConnector(roles, postgres.New(logger, postgresClient), vaultService, logger)
+	if err = manifeststores.NewStoresHandler(storesConnector).Register(ctx, mnfs[entities.StoreKind]); err != nil {
+		return err
+	}
+
+	logger.Info("data encryption keys re-wrapped successfully")
+
+	nEncrypted, err := storesConnector.EncryptPrivateKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("private keys encrypted successfully", "n_encrypted", nEncrypted)
+	return nil
+}
@@ -20,6 +20,10 @@ func NewCommand() *cobra.Command {
 	rootCmd.AddCommand(newRunCommand())
 	rootCmd.AddCommand(newMigrateCommand())
 	rootCmd.AddCommand(newSyncCommand())
+	rootCmd.AddCommand(newPurgeCommand())
+	rootCmd.AddCommand(newRewrapCommand())
+	rootCmd.AddCommand(newMigrateStoreCommand())
+	rootCmd.AddCommand(newBackupCommand())
 
 	return rootCmd
 }
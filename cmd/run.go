@@ -32,8 +32,10 @@ func newRunCommand() *cobra.Command {
 	flags.LoggerFlags(runCmd.Flags())
 	flags.PGFlags(runCmd.Flags())
 	flags.OIDCFlags(runCmd.Flags())
+	flags.IntrospectionFlags(runCmd.Flags())
 	flags.APIKeyFlags(runCmd.Flags())
 	flags.TLSFlags(runCmd.Flags())
+	flags.JobsFlags(runCmd.Flags())
 
 	return runCmd
 }
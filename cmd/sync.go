@@ -9,6 +9,7 @@ import (
 	storesservice "github.com/consensys/quorum-key-manager/src/stores"
 	manifeststores "github.com/consensys/quorum-key-manager/src/stores/api/manifest"
 	manifestvaults "github.com/consensys/quorum-key-manager/src/vaults/api/manifest"
+	vaultsdb "github.com/consensys/quorum-key-manager/src/vaults/database/postgres"
 	"github.com/consensys/quorum-key-manager/src/vaults/service/vaults"
 
 	"github.com/consensys/quorum-key-manager/cmd/flags"
@@ -53,8 +54,8 @@ func newSyncCommand() *cobra.Command {
 			}
 
 			// Instantiate register vaults
-			roles := roles.New(logger)
-			vaultService := vaults.New(roles, logger)
+			roles := roles.New(nil, logger)
+			vaultService := vaults.New(vaultsdb.NewDataEncryptionKeys(postgresClient), roles, logger)
 			if err := manifestvaults.NewVaultsHandler(vaultService).Register(ctx, mnfs[entities.VaultKind]); err != nil {
 				return err
 			}
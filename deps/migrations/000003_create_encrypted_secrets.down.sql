@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS encrypted_secrets;
+DROP TABLE IF EXISTS data_encryption_keys;
+
+COMMIT;
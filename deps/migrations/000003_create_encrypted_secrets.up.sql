@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS data_encryption_keys (
+    pk SERIAL PRIMARY KEY,
+    vault TEXT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    UNIQUE(vault)
+);
+
+CREATE TABLE IF NOT EXISTS encrypted_secrets (
+    pk SERIAL PRIMARY KEY,
+    id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    vault TEXT NOT NULL,
+    ciphertext BYTEA NOT NULL,
+    tags JSONB,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    deleted_at TIMESTAMPTZ,
+    UNIQUE(id, version, vault)
+);
+
+COMMIT;
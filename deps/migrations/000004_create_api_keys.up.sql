@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    pk SERIAL PRIMARY KEY,
+    id TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    hash TEXT NOT NULL UNIQUE,
+    owner TEXT,
+    tenant TEXT,
+    roles TEXT [],
+    permissions TEXT [],
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_tenant_idx ON api_keys (tenant);
+
+COMMIT;
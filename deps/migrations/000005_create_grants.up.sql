@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS grants (
+    pk SERIAL PRIMARY KEY,
+    id TEXT NOT NULL UNIQUE,
+    tenant TEXT,
+    username TEXT NOT NULL,
+    role TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    duration BIGINT NOT NULL,
+    status TEXT NOT NULL,
+    approver TEXT,
+    approved_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS grants_tenant_username_idx ON grants (tenant, username, status);
+
+COMMIT;
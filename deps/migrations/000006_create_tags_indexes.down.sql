@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS eth_accounts_tags_idx;
+DROP INDEX IF EXISTS keys_tags_idx;
+DROP INDEX IF EXISTS secrets_tags_idx;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS secrets_tags_idx ON secrets USING GIN (tags);
+CREATE INDEX IF NOT EXISTS keys_tags_idx ON keys USING GIN (tags);
+CREATE INDEX IF NOT EXISTS eth_accounts_tags_idx ON eth_accounts USING GIN (tags);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS eth_accounts_store_created_at_idx;
+DROP INDEX IF EXISTS keys_store_created_at_idx;
+DROP INDEX IF EXISTS secrets_store_created_at_idx;
+
+COMMIT;
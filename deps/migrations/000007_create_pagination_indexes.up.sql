@@ -0,0 +1,7 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS secrets_store_created_at_idx ON secrets (store_id, created_at, id);
+CREATE INDEX IF NOT EXISTS keys_store_created_at_idx ON keys (store_id, created_at, id);
+CREATE INDEX IF NOT EXISTS eth_accounts_store_created_at_idx ON eth_accounts (store_id, created_at, address);
+
+COMMIT;
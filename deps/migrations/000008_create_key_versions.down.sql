@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS keys_next_rotation_at_idx;
+DROP TABLE IF EXISTS key_versions;
+
+ALTER TABLE keys
+    DROP COLUMN IF EXISTS next_rotation_at,
+    DROP COLUMN IF EXISTS rotation_period,
+    DROP COLUMN IF EXISTS version;
+
+COMMIT;
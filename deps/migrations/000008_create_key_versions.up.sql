@@ -0,0 +1,30 @@
+BEGIN;
+
+ALTER TABLE keys
+    ADD COLUMN IF NOT EXISTS version TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS rotation_period BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_rotation_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS key_versions (
+    pk SERIAL PRIMARY KEY,
+    id TEXT NOT NULL,
+    version TEXT NOT NULL,
+    store_id TEXT NOT NULL,
+    public_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    UNIQUE(id, version, store_id),
+    FOREIGN KEY (id, store_id) REFERENCES keys (id, store_id) ON DELETE CASCADE
+);
+
+-- Keys indexed before versioning are backfilled with an empty version as their version in the vault is not known to the
+-- index (Azure versions are random identifiers and local key stores keep them in their secret store). The empty version
+-- designates the version of the key at the time of the upgrade: it is the latest version, used when no version is
+-- requested, until the key is rotated. Afterwards it remains listed as an empty version but cannot be requested
+-- explicitly anymore, requests without a version using the new latest version
+INSERT INTO key_versions (id, version, store_id, public_key, created_at)
+SELECT id, version, store_id, public_key, created_at FROM keys
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS keys_next_rotation_at_idx ON keys (next_rotation_at) WHERE next_rotation_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE eth_accounts
+    DROP COLUMN IF EXISTS expire_at;
+
+ALTER TABLE keys
+    DROP COLUMN IF EXISTS expire_at,
+    DROP COLUMN IF EXISTS operations;
+
+COMMIT;
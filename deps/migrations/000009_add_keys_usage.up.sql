@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE keys
+    ADD COLUMN IF NOT EXISTS operations JSONB,
+    ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ;
+
+ALTER TABLE eth_accounts
+    ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE eth_accounts
+    DROP COLUMN IF EXISTS recovery_period;
+
+ALTER TABLE keys
+    DROP COLUMN IF EXISTS recovery_period;
+
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS recovery_period;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE secrets
+    ADD COLUMN IF NOT EXISTS recovery_period BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE keys
+    ADD COLUMN IF NOT EXISTS recovery_period BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE eth_accounts
+    ADD COLUMN IF NOT EXISTS recovery_period BIGINT NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS value_type;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE secrets
+    ADD COLUMN IF NOT EXISTS value_type VARCHAR(10) NOT NULL DEFAULT 'string';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE grants
+    DROP COLUMN IF EXISTS auth_mode,
+    DROP COLUMN IF EXISTS issuer;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Grants only apply to the authentication mode and issuer they were requested with, existing grants apply to none
+ALTER TABLE grants
+    ADD COLUMN IF NOT EXISTS auth_mode TEXT,
+    ADD COLUMN IF NOT EXISTS issuer TEXT;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- Audit trail of the deleted items destroyed once their recovery period has elapsed
+CREATE TABLE IF NOT EXISTS purged_items (
+    pk SERIAL PRIMARY KEY,
+    store_name TEXT NOT NULL,
+    store_type TEXT NOT NULL,
+    id TEXT NOT NULL,
+    deleted_at TIMESTAMPTZ NOT NULL,
+    purged_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS purged_items_store_name_idx ON purged_items (store_name);
+
+COMMIT;
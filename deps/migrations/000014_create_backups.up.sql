@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Audit trail of the backups of stores, which export their private material
+CREATE TABLE IF NOT EXISTS backups (
+    pk SERIAL PRIMARY KEY,
+    store_name TEXT NOT NULL,
+    store_type TEXT NOT NULL,
+    tenant TEXT,
+    username TEXT,
+    auth_mode TEXT,
+    recipient TEXT,
+    items INTEGER NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS backups_store_name_idx ON backups (store_name);
+
+COMMIT;
@@ -178,6 +178,13 @@ func (app *App) RegisterService(srv interface{}) error {
 	return nil
 }
 
+// AddReadinessInfo reports states of components in the readiness output of the healthz server
+func (app *App) AddReadinessInfo(name string, info server.InfoFunc) {
+	if healthz, ok := app.healthz.Handler.(*server.HealthzHandler); ok {
+		healthz.AddReadinessInfo(name, info)
+	}
+}
+
 // Service loads a service into srv
 // It expects a pointer and then sets its value
 func (app *App) Service(srv interface{}) error {
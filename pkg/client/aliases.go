@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 )
 
 const aliasPathf = "%s/registries/%s/aliases/%s"
@@ -43,6 +44,17 @@ func (c *HTTPClient) GetAlias(ctx context.Context, registry, aliasKey string) (*
 	return &a, nil
 }
 
+// ListAliases lists a page of the aliases of the registry.
+func (c *HTTPClient) ListAliases(ctx context.Context, registry string, opts *CursorOptions) ([]*types.AliasResponse, *http2.PagePagingResponse, error) {
+	var aliases []*types.AliasResponse
+	paging, err := cursorListRequest(ctx, c.client, fmt.Sprintf(registryPathf+"/aliases", c.config.URL, registry), opts, &aliases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aliases, paging, nil
+}
+
 // UpdateAlias updates an alias in the registry.
 func (c *HTTPClient) UpdateAlias(ctx context.Context, registry, aliasKey string, req *types.AliasRequest) (*types.AliasResponse, error) {
 	requestURL := fmt.Sprintf(aliasPathf, c.config.URL, registry, aliasKey)
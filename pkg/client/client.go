@@ -5,32 +5,50 @@ import (
 
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	aliastypes "github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	storestypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
 	utilstypes "github.com/consensys/quorum-key-manager/src/utils/api/types"
 )
 
 //go:generate mockgen -source=client.go -destination=mock/mock.go -package=mock
 
+// CursorOptions selects a page of a list paginated by cursor
+type CursorOptions struct {
+	Deleted bool
+	Limit   uint64
+	// Cursor is the previous or next cursor returned with a page, empty for the first page
+	Cursor string
+	// Total requests the total count of items
+	Total bool
+}
+
 type SecretsClient interface {
 	SetSecret(ctx context.Context, storeName, id string, request *storestypes.SetSecretRequest) (*storestypes.SecretResponse, error)
+	GenerateSecret(ctx context.Context, storeName, id string, request *storestypes.GenerateSecretRequest) (*storestypes.SecretResponse, error)
 	GetSecret(ctx context.Context, storeName, id, version string) (*storestypes.SecretResponse, error)
 	GetDeletedSecret(ctx context.Context, storeName, id string) (*storestypes.SecretResponse, error)
 	DeleteSecret(ctx context.Context, storeName, id string) error
 	RestoreSecret(ctx context.Context, storeName, id string) error
 	DestroySecret(ctx context.Context, storeName, id string) error
+	ListSecretVersions(ctx context.Context, storeName, id string) ([]*storestypes.SecretResponse, error)
+	RestoreSecretVersion(ctx context.Context, storeName, id, version string) error
+	DestroySecretVersion(ctx context.Context, storeName, id, version string) error
 	ListSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	ListDeletedSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
+	ListSecretsWithCursor(ctx context.Context, storeName string, opts *CursorOptions) ([]string, *http2.PagePagingResponse, error)
 }
 
 type KeysClient interface {
 	CreateKey(ctx context.Context, storeName, id string, request *storestypes.CreateKeyRequest) (*storestypes.KeyResponse, error)
 	ImportKey(ctx context.Context, storeName, id string, request *storestypes.ImportKeyRequest) (*storestypes.KeyResponse, error)
 	SignKey(ctx context.Context, storeName, id string, request *storestypes.SignBase64PayloadRequest) (string, error)
+	RotateKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
 	GetKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
 	ListKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	DeleteKey(ctx context.Context, storeName, id string) error
 	GetDeletedKey(ctx context.Context, storeName, id string) (*storestypes.KeyResponse, error)
 	ListDeletedKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
+	ListKeysWithCursor(ctx context.Context, storeName string, opts *CursorOptions) ([]string, *http2.PagePagingResponse, error)
 	RestoreKey(ctx context.Context, storeName, id string) error
 	DestroyKey(ctx context.Context, storeName, id string) error
 }
@@ -47,11 +65,18 @@ type EthClient interface {
 	GetEthAccount(ctx context.Context, storeName, address string) (*storestypes.EthAccountResponse, error)
 	ListEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	ListDeletedEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
+	ListEthAccountsWithCursor(ctx context.Context, storeName string, opts *CursorOptions) ([]string, *http2.PagePagingResponse, error)
 	DeleteEthAccount(ctx context.Context, storeName, address string) error
 	DestroyEthAccount(ctx context.Context, storeName, address string) error
 	RestoreEthAccount(ctx context.Context, storeName, address string) error
 }
 
+type StoresClient interface {
+	MigrateStore(ctx context.Context, storeName string, request *storestypes.MigrateStoreRequest) (*storestypes.MigrateStoreResponse, error)
+	BackupStore(ctx context.Context, storeName string, request *storestypes.BackupStoreRequest) (*storestypes.BackupStoreResponse, error)
+	RestoreStore(ctx context.Context, storeName string, request *storestypes.RestoreStoreRequest) (*storestypes.RestoreStoreResponse, error)
+}
+
 type UtilsClient interface {
 	VerifyKeySignature(ctx context.Context, request *utilstypes.VerifyKeySignatureRequest) error
 	ECRecover(ctx context.Context, request *utilstypes.ECRecoverRequest) (string, error)
@@ -62,6 +87,7 @@ type UtilsClient interface {
 type AliasClient interface {
 	CreateAlias(ctx context.Context, registry, aliasKey string, req *aliastypes.AliasRequest) (*aliastypes.AliasResponse, error)
 	GetAlias(ctx context.Context, registry, aliasKey string) (*aliastypes.AliasResponse, error)
+	ListAliases(ctx context.Context, registry string, opts *CursorOptions) ([]*aliastypes.AliasResponse, *http2.PagePagingResponse, error)
 	UpdateAlias(ctx context.Context, registry, aliasKey string, req *aliastypes.AliasRequest) (*aliastypes.AliasResponse, error)
 	DeleteAlias(ctx context.Context, registry, aliasKey string) error
 }
@@ -80,6 +106,7 @@ type KeyManagerClient interface {
 	SecretsClient
 	KeysClient
 	EthClient
+	StoresClient
 	UtilsClient
 	AliasRegistryClient
 	AliasClient
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 )
 
@@ -141,6 +142,16 @@ func (c *HTTPClient) ListDeletedEthAccounts(ctx context.Context, storeName strin
 	return listRequest(ctx, c.client, fmt.Sprintf("%s/%s", withURLStore(c.config.URL, storeName), ethPath), true, limit, page)
 }
 
+func (c *HTTPClient) ListEthAccountsWithCursor(ctx context.Context, storeName string, opts *CursorOptions) ([]string, *http2.PagePagingResponse, error) {
+	var data []string
+	paging, err := cursorListRequest(ctx, c.client, fmt.Sprintf("%s/%s", withURLStore(c.config.URL, storeName), ethPath), opts, &data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, paging, nil
+}
+
 func (c *HTTPClient) DeleteEthAccount(ctx context.Context, storeName, address string) error {
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), ethPath, address)
 	response, err := deleteRequest(ctx, c.client, reqURL)
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 )
 
 type HTTPClient struct {
@@ -54,3 +56,42 @@ func listRequest(ctx context.Context, client *http.Client, urlPath string, delet
 
 	return pageRes.Data, nil
 }
+
+// cursorListRequest gets a page of a list paginated by cursor, the page data is parsed in data
+func cursorListRequest(ctx context.Context, client *http.Client, urlPath string, opts *CursorOptions, data interface{}) (*http2.PagePagingResponse, error) {
+	if opts == nil {
+		opts = &CursorOptions{}
+	}
+
+	reqURL, _ := url.Parse(urlPath)
+	values := url.Values{}
+	if opts.Deleted {
+		values.Set("deleted", "true")
+	}
+	if opts.Limit != 0 {
+		values.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Total {
+		values.Set("total", "true")
+	}
+	// An empty cursor requests the first page
+	values.Set("cursor", opts.Cursor)
+
+	reqURL.RawQuery = values.Encode()
+	response, err := getRequest(ctx, client, reqURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes := &struct {
+		Data   interface{}              `json:"data"`
+		Paging http2.PagePagingResponse `json:"paging"`
+	}{Data: data}
+	defer closeResponse(response)
+	err = parseResponse(response, pageRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pageRes.Paging, nil
+}
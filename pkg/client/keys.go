@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 )
 
@@ -54,6 +55,23 @@ func (c *HTTPClient) SignKey(ctx context.Context, storeName, id string, req *typ
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) RotateKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
+	key := &types.KeyResponse{}
+	reqURL := fmt.Sprintf("%s/%s/%s/rotate", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
 func (c *HTTPClient) GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	key := &types.KeyResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), keysPath, id)
@@ -116,6 +134,16 @@ func (c *HTTPClient) ListDeletedKeys(ctx context.Context, storeName string, limi
 	return listRequest(ctx, c.client, fmt.Sprintf("%s/%s", withURLStore(c.config.URL, storeName), keysPath), true, limit, page)
 }
 
+func (c *HTTPClient) ListKeysWithCursor(ctx context.Context, storeName string, opts *CursorOptions) ([]string, *http2.PagePagingResponse, error) {
+	var data []string
+	paging, err := cursorListRequest(ctx, c.client, fmt.Sprintf("%s/%s", withURLStore(c.config.URL, storeName), keysPath), opts, &data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, paging, nil
+}
+
 func (c *HTTPClient) DeleteKey(ctx context.Context, storeName, id string) error {
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), keysPath, id)
 	response, err := deleteRequest(ctx, c.client, reqURL)
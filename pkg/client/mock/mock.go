@@ -6,8 +6,10 @@ package mock
 
 import (
 	context "context"
+	client "github.com/consensys/quorum-key-manager/pkg/client"
 	jsonrpc "github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	types "github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	http "github.com/consensys/quorum-key-manager/src/infra/http"
 	types0 "github.com/consensys/quorum-key-manager/src/stores/api/types"
 	types1 "github.com/consensys/quorum-key-manager/src/utils/api/types"
 	gomock "github.com/golang/mock/gomock"
@@ -52,6 +54,21 @@ func (mr *MockSecretsClientMockRecorder) SetSecret(ctx, storeName, id, request i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockSecretsClient)(nil).SetSecret), ctx, storeName, id, request)
 }
 
+// GenerateSecret mocks base method
+func (m *MockSecretsClient) GenerateSecret(ctx context.Context, storeName, id string, request *types0.GenerateSecretRequest) (*types0.SecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret", ctx, storeName, id, request)
+	ret0, _ := ret[0].(*types0.SecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret
+func (mr *MockSecretsClientMockRecorder) GenerateSecret(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockSecretsClient)(nil).GenerateSecret), ctx, storeName, id, request)
+}
+
 // GetSecret mocks base method
 func (m *MockSecretsClient) GetSecret(ctx context.Context, storeName, id, version string) (*types0.SecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +141,49 @@ func (mr *MockSecretsClientMockRecorder) DestroySecret(ctx, storeName, id interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockSecretsClient)(nil).DestroySecret), ctx, storeName, id)
 }
 
+// ListSecretVersions mocks base method
+func (m *MockSecretsClient) ListSecretVersions(ctx context.Context, storeName, id string) ([]*types0.SecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretVersions", ctx, storeName, id)
+	ret0, _ := ret[0].([]*types0.SecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretVersions indicates an expected call of ListSecretVersions
+func (mr *MockSecretsClientMockRecorder) ListSecretVersions(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretVersions", reflect.TypeOf((*MockSecretsClient)(nil).ListSecretVersions), ctx, storeName, id)
+}
+
+// RestoreSecretVersion mocks base method
+func (m *MockSecretsClient) RestoreSecretVersion(ctx context.Context, storeName, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSecretVersion", ctx, storeName, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreSecretVersion indicates an expected call of RestoreSecretVersion
+func (mr *MockSecretsClientMockRecorder) RestoreSecretVersion(ctx, storeName, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecretVersion", reflect.TypeOf((*MockSecretsClient)(nil).RestoreSecretVersion), ctx, storeName, id, version)
+}
+
+// DestroySecretVersion mocks base method
+func (m *MockSecretsClient) DestroySecretVersion(ctx context.Context, storeName, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySecretVersion", ctx, storeName, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroySecretVersion indicates an expected call of DestroySecretVersion
+func (mr *MockSecretsClientMockRecorder) DestroySecretVersion(ctx, storeName, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecretVersion", reflect.TypeOf((*MockSecretsClient)(nil).DestroySecretVersion), ctx, storeName, id, version)
+}
+
 // ListSecrets mocks base method
 func (m *MockSecretsClient) ListSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +214,22 @@ func (mr *MockSecretsClientMockRecorder) ListDeletedSecrets(ctx, storeName, limi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedSecrets", reflect.TypeOf((*MockSecretsClient)(nil).ListDeletedSecrets), ctx, storeName, limit, page)
 }
 
+// ListSecretsWithCursor mocks base method
+func (m *MockSecretsClient) ListSecretsWithCursor(ctx context.Context, storeName string, opts *client.CursorOptions) ([]string, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretsWithCursor", ctx, storeName, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSecretsWithCursor indicates an expected call of ListSecretsWithCursor
+func (mr *MockSecretsClientMockRecorder) ListSecretsWithCursor(ctx, storeName, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretsWithCursor", reflect.TypeOf((*MockSecretsClient)(nil).ListSecretsWithCursor), ctx, storeName, opts)
+}
+
 // MockKeysClient is a mock of KeysClient interface
 type MockKeysClient struct {
 	ctrl     *gomock.Controller
@@ -222,6 +298,21 @@ func (mr *MockKeysClientMockRecorder) SignKey(ctx, storeName, id, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeysClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// RotateKey mocks base method
+func (m *MockKeysClient) RotateKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, storeName, id)
+	ret0, _ := ret[0].(*types0.KeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockKeysClientMockRecorder) RotateKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockKeysClient)(nil).RotateKey), ctx, storeName, id)
+}
+
 // GetKey mocks base method
 func (m *MockKeysClient) GetKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +387,22 @@ func (mr *MockKeysClientMockRecorder) ListDeletedKeys(ctx, storeName, limit, pag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedKeys", reflect.TypeOf((*MockKeysClient)(nil).ListDeletedKeys), ctx, storeName, limit, page)
 }
 
+// ListKeysWithCursor mocks base method
+func (m *MockKeysClient) ListKeysWithCursor(ctx context.Context, storeName string, opts *client.CursorOptions) ([]string, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeysWithCursor", ctx, storeName, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListKeysWithCursor indicates an expected call of ListKeysWithCursor
+func (mr *MockKeysClientMockRecorder) ListKeysWithCursor(ctx, storeName, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeysWithCursor", reflect.TypeOf((*MockKeysClient)(nil).ListKeysWithCursor), ctx, storeName, opts)
+}
+
 // RestoreKey mocks base method
 func (m *MockKeysClient) RestoreKey(ctx context.Context, storeName, id string) error {
 	m.ctrl.T.Helper()
@@ -512,6 +619,22 @@ func (mr *MockEthClientMockRecorder) ListDeletedEthAccounts(ctx, storeName, limi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedEthAccounts", reflect.TypeOf((*MockEthClient)(nil).ListDeletedEthAccounts), ctx, storeName, limit, page)
 }
 
+// ListEthAccountsWithCursor mocks base method
+func (m *MockEthClient) ListEthAccountsWithCursor(ctx context.Context, storeName string, opts *client.CursorOptions) ([]string, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEthAccountsWithCursor", ctx, storeName, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEthAccountsWithCursor indicates an expected call of ListEthAccountsWithCursor
+func (mr *MockEthClientMockRecorder) ListEthAccountsWithCursor(ctx, storeName, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEthAccountsWithCursor", reflect.TypeOf((*MockEthClient)(nil).ListEthAccountsWithCursor), ctx, storeName, opts)
+}
+
 // DeleteEthAccount mocks base method
 func (m *MockEthClient) DeleteEthAccount(ctx context.Context, storeName, address string) error {
 	m.ctrl.T.Helper()
@@ -554,6 +677,74 @@ func (mr *MockEthClientMockRecorder) RestoreEthAccount(ctx, storeName, address i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEthAccount", reflect.TypeOf((*MockEthClient)(nil).RestoreEthAccount), ctx, storeName, address)
 }
 
+// MockStoresClient is a mock of StoresClient interface
+type MockStoresClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoresClientMockRecorder
+}
+
+// MockStoresClientMockRecorder is the mock recorder for MockStoresClient
+type MockStoresClientMockRecorder struct {
+	mock *MockStoresClient
+}
+
+// NewMockStoresClient creates a new mock instance
+func NewMockStoresClient(ctrl *gomock.Controller) *MockStoresClient {
+	mock := &MockStoresClient{ctrl: ctrl}
+	mock.recorder = &MockStoresClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStoresClient) EXPECT() *MockStoresClientMockRecorder {
+	return m.recorder
+}
+
+// MigrateStore mocks base method
+func (m *MockStoresClient) MigrateStore(ctx context.Context, storeName string, request *types0.MigrateStoreRequest) (*types0.MigrateStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateStore", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.MigrateStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateStore indicates an expected call of MigrateStore
+func (mr *MockStoresClientMockRecorder) MigrateStore(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateStore", reflect.TypeOf((*MockStoresClient)(nil).MigrateStore), ctx, storeName, request)
+}
+
+// BackupStore mocks base method
+func (m *MockStoresClient) BackupStore(ctx context.Context, storeName string, request *types0.BackupStoreRequest) (*types0.BackupStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupStore", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.BackupStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupStore indicates an expected call of BackupStore
+func (mr *MockStoresClientMockRecorder) BackupStore(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupStore", reflect.TypeOf((*MockStoresClient)(nil).BackupStore), ctx, storeName, request)
+}
+
+// RestoreStore mocks base method
+func (m *MockStoresClient) RestoreStore(ctx context.Context, storeName string, request *types0.RestoreStoreRequest) (*types0.RestoreStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreStore", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.RestoreStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreStore indicates an expected call of RestoreStore
+func (mr *MockStoresClientMockRecorder) RestoreStore(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreStore", reflect.TypeOf((*MockStoresClient)(nil).RestoreStore), ctx, storeName, request)
+}
+
 // MockUtilsClient is a mock of UtilsClient interface
 type MockUtilsClient struct {
 	ctrl     *gomock.Controller
@@ -687,6 +878,22 @@ func (mr *MockAliasClientMockRecorder) GetAlias(ctx, registry, aliasKey interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlias", reflect.TypeOf((*MockAliasClient)(nil).GetAlias), ctx, registry, aliasKey)
 }
 
+// ListAliases mocks base method
+func (m *MockAliasClient) ListAliases(ctx context.Context, registry string, opts *client.CursorOptions) ([]*types.AliasResponse, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAliases", ctx, registry, opts)
+	ret0, _ := ret[0].([]*types.AliasResponse)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAliases indicates an expected call of ListAliases
+func (mr *MockAliasClientMockRecorder) ListAliases(ctx, registry, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockAliasClient)(nil).ListAliases), ctx, registry, opts)
+}
+
 // UpdateAlias mocks base method
 func (m *MockAliasClient) UpdateAlias(ctx context.Context, registry, aliasKey string, req *types.AliasRequest) (*types.AliasResponse, error) {
 	m.ctrl.T.Helper()
@@ -864,6 +1071,21 @@ func (mr *MockKeyManagerClientMockRecorder) SetSecret(ctx, storeName, id, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockKeyManagerClient)(nil).SetSecret), ctx, storeName, id, request)
 }
 
+// GenerateSecret mocks base method
+func (m *MockKeyManagerClient) GenerateSecret(ctx context.Context, storeName, id string, request *types0.GenerateSecretRequest) (*types0.SecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret", ctx, storeName, id, request)
+	ret0, _ := ret[0].(*types0.SecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret
+func (mr *MockKeyManagerClientMockRecorder) GenerateSecret(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockKeyManagerClient)(nil).GenerateSecret), ctx, storeName, id, request)
+}
+
 // GetSecret mocks base method
 func (m *MockKeyManagerClient) GetSecret(ctx context.Context, storeName, id, version string) (*types0.SecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -936,6 +1158,49 @@ func (mr *MockKeyManagerClientMockRecorder) DestroySecret(ctx, storeName, id int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockKeyManagerClient)(nil).DestroySecret), ctx, storeName, id)
 }
 
+// ListSecretVersions mocks base method
+func (m *MockKeyManagerClient) ListSecretVersions(ctx context.Context, storeName, id string) ([]*types0.SecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretVersions", ctx, storeName, id)
+	ret0, _ := ret[0].([]*types0.SecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretVersions indicates an expected call of ListSecretVersions
+func (mr *MockKeyManagerClientMockRecorder) ListSecretVersions(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretVersions", reflect.TypeOf((*MockKeyManagerClient)(nil).ListSecretVersions), ctx, storeName, id)
+}
+
+// RestoreSecretVersion mocks base method
+func (m *MockKeyManagerClient) RestoreSecretVersion(ctx context.Context, storeName, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSecretVersion", ctx, storeName, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreSecretVersion indicates an expected call of RestoreSecretVersion
+func (mr *MockKeyManagerClientMockRecorder) RestoreSecretVersion(ctx, storeName, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecretVersion", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreSecretVersion), ctx, storeName, id, version)
+}
+
+// DestroySecretVersion mocks base method
+func (m *MockKeyManagerClient) DestroySecretVersion(ctx context.Context, storeName, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySecretVersion", ctx, storeName, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroySecretVersion indicates an expected call of DestroySecretVersion
+func (mr *MockKeyManagerClientMockRecorder) DestroySecretVersion(ctx, storeName, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecretVersion", reflect.TypeOf((*MockKeyManagerClient)(nil).DestroySecretVersion), ctx, storeName, id, version)
+}
+
 // ListSecrets mocks base method
 func (m *MockKeyManagerClient) ListSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1011,6 +1276,21 @@ func (mr *MockKeyManagerClientMockRecorder) SignKey(ctx, storeName, id, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeyManagerClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// RotateKey mocks base method
+func (m *MockKeyManagerClient) RotateKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, storeName, id)
+	ret0, _ := ret[0].(*types0.KeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey
+func (mr *MockKeyManagerClientMockRecorder) RotateKey(ctx, storeName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockKeyManagerClient)(nil).RotateKey), ctx, storeName, id)
+}
+
 // GetKey mocks base method
 func (m *MockKeyManagerClient) GetKey(ctx context.Context, storeName, id string) (*types0.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1320,6 +1600,51 @@ func (mr *MockKeyManagerClientMockRecorder) RestoreEthAccount(ctx, storeName, ad
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreEthAccount), ctx, storeName, address)
 }
 
+// MigrateStore mocks base method
+func (m *MockKeyManagerClient) MigrateStore(ctx context.Context, storeName string, request *types0.MigrateStoreRequest) (*types0.MigrateStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateStore", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.MigrateStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateStore indicates an expected call of MigrateStore
+func (mr *MockKeyManagerClientMockRecorder) MigrateStore(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateStore", reflect.TypeOf((*MockKeyManagerClient)(nil).MigrateStore), ctx, storeName, request)
+}
+
+// BackupStore mocks base method
+func (m *MockKeyManagerClient) BackupStore(ctx context.Context, storeName string, request *types0.BackupStoreRequest) (*types0.BackupStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupStore", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.BackupStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupStore indicates an expected call of BackupStore
+func (mr *MockKeyManagerClientMockRecorder) BackupStore(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupStore", reflect.TypeOf((*MockKeyManagerClient)(nil).BackupStore), ctx, storeName, request)
+}
+
+// RestoreStore mocks base method
+func (m *MockKeyManagerClient) RestoreStore(ctx context.Context, storeName string, request *types0.RestoreStoreRequest) (*types0.RestoreStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreStore", ctx, storeName, request)
+	ret0, _ := ret[0].(*types0.RestoreStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreStore indicates an expected call of RestoreStore
+func (mr *MockKeyManagerClientMockRecorder) RestoreStore(ctx, storeName, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreStore", reflect.TypeOf((*MockKeyManagerClient)(nil).RestoreStore), ctx, storeName, request)
+}
+
 // VerifyKeySignature mocks base method
 func (m *MockKeyManagerClient) VerifyKeySignature(ctx context.Context, request *types1.VerifyKeySignatureRequest) error {
 	m.ctrl.T.Helper()
@@ -1499,3 +1824,67 @@ func (mr *MockKeyManagerClientMockRecorder) Call(ctx, nodeID, method interface{}
 	varargs := append([]interface{}{ctx, nodeID, method}, args...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockKeyManagerClient)(nil).Call), varargs...)
 }
+
+// ListSecretsWithCursor mocks base method
+func (m *MockKeyManagerClient) ListSecretsWithCursor(ctx context.Context, storeName string, opts *client.CursorOptions) ([]string, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretsWithCursor", ctx, storeName, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSecretsWithCursor indicates an expected call of ListSecretsWithCursor
+func (mr *MockKeyManagerClientMockRecorder) ListSecretsWithCursor(ctx, storeName, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretsWithCursor", reflect.TypeOf((*MockKeyManagerClient)(nil).ListSecretsWithCursor), ctx, storeName, opts)
+}
+
+// ListKeysWithCursor mocks base method
+func (m *MockKeyManagerClient) ListKeysWithCursor(ctx context.Context, storeName string, opts *client.CursorOptions) ([]string, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeysWithCursor", ctx, storeName, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListKeysWithCursor indicates an expected call of ListKeysWithCursor
+func (mr *MockKeyManagerClientMockRecorder) ListKeysWithCursor(ctx, storeName, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeysWithCursor", reflect.TypeOf((*MockKeyManagerClient)(nil).ListKeysWithCursor), ctx, storeName, opts)
+}
+
+// ListEthAccountsWithCursor mocks base method
+func (m *MockKeyManagerClient) ListEthAccountsWithCursor(ctx context.Context, storeName string, opts *client.CursorOptions) ([]string, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEthAccountsWithCursor", ctx, storeName, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEthAccountsWithCursor indicates an expected call of ListEthAccountsWithCursor
+func (mr *MockKeyManagerClientMockRecorder) ListEthAccountsWithCursor(ctx, storeName, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEthAccountsWithCursor", reflect.TypeOf((*MockKeyManagerClient)(nil).ListEthAccountsWithCursor), ctx, storeName, opts)
+}
+
+// ListAliases mocks base method
+func (m *MockKeyManagerClient) ListAliases(ctx context.Context, registry string, opts *client.CursorOptions) ([]*types.AliasResponse, *http.PagePagingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAliases", ctx, registry, opts)
+	ret0, _ := ret[0].([]*types.AliasResponse)
+	ret1, _ := ret[1].(*http.PagePagingResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAliases indicates an expected call of ListAliases
+func (mr *MockKeyManagerClientMockRecorder) ListAliases(ctx, registry, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockKeyManagerClient)(nil).ListAliases), ctx, registry, opts)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 )
 
@@ -26,6 +27,23 @@ func (c *HTTPClient) SetSecret(ctx context.Context, storeName, id string, req *t
 	return secret, nil
 }
 
+func (c *HTTPClient) GenerateSecret(ctx context.Context, storeName, id string, req *types.GenerateSecretRequest) (*types.SecretResponse, error) {
+	secret := &types.SecretResponse{}
+	reqURL := fmt.Sprintf("%s/%s/%s/generate", withURLStore(c.config.URL, storeName), secretsPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
 func (c *HTTPClient) GetSecret(ctx context.Context, storeName, id, version string) (*types.SecretResponse, error) {
 	secret := &types.SecretResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), secretsPath, id)
@@ -96,6 +114,55 @@ func (c *HTTPClient) DestroySecret(ctx context.Context, storeName, id string) er
 	return nil
 }
 
+func (c *HTTPClient) ListSecretVersions(ctx context.Context, storeName, id string) ([]*types.SecretResponse, error) {
+	var secrets []*types.SecretResponse
+	reqURL := fmt.Sprintf("%s/%s/%s/versions", withURLStore(c.config.URL, storeName), secretsPath, id)
+	response, err := getRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, &secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets, nil
+}
+
+func (c *HTTPClient) RestoreSecretVersion(ctx context.Context, storeName, id, version string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/versions/%s/restore", withURLStore(c.config.URL, storeName), secretsPath, id, version)
+	response, err := putRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, new(string))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *HTTPClient) DestroySecretVersion(ctx context.Context, storeName, id, version string) error {
+	reqURL := fmt.Sprintf("%s/%s/%s/versions/%s/destroy", withURLStore(c.config.URL, storeName), secretsPath, id, version)
+	response, err := deleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, new(string))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *HTTPClient) GetDeletedSecret(ctx context.Context, storeName, id string) (*types.SecretResponse, error) {
 	secret := &types.SecretResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s?deleted=true", withURLStore(c.config.URL, storeName), secretsPath, id)
@@ -120,3 +187,13 @@ func (c *HTTPClient) ListSecrets(ctx context.Context, storeName string, limit, p
 func (c *HTTPClient) ListDeletedSecrets(ctx context.Context, storeName string, limit, page uint64) ([]string, error) {
 	return listRequest(ctx, c.client, fmt.Sprintf("%s/%s", withURLStore(c.config.URL, storeName), secretsPath), true, limit, page)
 }
+
+func (c *HTTPClient) ListSecretsWithCursor(ctx context.Context, storeName string, opts *CursorOptions) ([]string, *http2.PagePagingResponse, error) {
+	var data []string
+	paging, err := cursorListRequest(ctx, c.client, fmt.Sprintf("%s/%s", withURLStore(c.config.URL, storeName), secretsPath), opts, &data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, paging, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+)
+
+func (c *HTTPClient) MigrateStore(ctx context.Context, storeName string, req *types.MigrateStoreRequest) (*types.MigrateStoreResponse, error) {
+	result := &types.MigrateStoreResponse{}
+	reqURL := fmt.Sprintf("%s/migrate", withURLStore(c.config.URL, storeName))
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *HTTPClient) BackupStore(ctx context.Context, storeName string, req *types.BackupStoreRequest) (*types.BackupStoreResponse, error) {
+	result := &types.BackupStoreResponse{}
+	reqURL := fmt.Sprintf("%s/backup", withURLStore(c.config.URL, storeName))
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *HTTPClient) RestoreStore(ctx context.Context, storeName string, req *types.RestoreStoreRequest) (*types.RestoreStoreResponse, error) {
+	result := &types.RestoreStoreResponse{}
+	reqURL := fmt.Sprintf("%s/restore", withURLStore(c.config.URL, storeName))
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package aes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	key, err := NewKey()
+	require.NoError(t, err)
+
+	aead, err := NewGCM(key)
+	require.NoError(t, err)
+
+	t.Run("should encrypt and decrypt successfully", func(t *testing.T) {
+		ciphertext, err := Seal(aead, []byte("my-secret"), []byte("my-id"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(ciphertext), "my-secret")
+
+		plaintext, err := Open(aead, ciphertext, []byte("my-id"))
+		require.NoError(t, err)
+		assert.Equal(t, "my-secret", string(plaintext))
+	})
+
+	t.Run("should fail to decrypt with different additional data", func(t *testing.T) {
+		ciphertext, err := Seal(aead, []byte("my-secret"), []byte("my-id"))
+		require.NoError(t, err)
+
+		_, err = Open(aead, ciphertext, []byte("other-id"))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to decrypt with a different key", func(t *testing.T) {
+		ciphertext, err := Seal(aead, []byte("my-secret"), nil)
+		require.NoError(t, err)
+
+		otherKey, _ := NewKey()
+		otherAEAD, _ := NewGCM(otherKey)
+		_, err = Open(otherAEAD, ciphertext, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create cipher with invalid key size", func(t *testing.T) {
+		_, err := NewGCM([]byte("short"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,203 @@
+package archive
+
+import (
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	version = 1
+
+	// PassphraseEncryption wraps the content key under a key derived from a passphrase with scrypt
+	PassphraseEncryption = "scrypt-aes-256-gcm"
+	// RecipientEncryption wraps the content key for the secp256k1 public key of a recipient with ECIES
+	RecipientEncryption = "ecies-secp256k1"
+
+	saltSize = 32
+	scryptN  = 1 << 15
+	scryptR  = 8
+	scryptP  = 1
+)
+
+// archive is an encrypted document, its content is encrypted with a random AES-256-GCM key which is itself wrapped
+// either under a passphrase or for a recipient
+type archive struct {
+	Version    int    `json:"version"`
+	Encryption string `json:"encryption"`
+	Salt       []byte `json:"salt,omitempty"`
+	WrappedKey []byte `json:"wrappedKey"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// SealWithPassphrase encrypts the plaintext into an archive that can only be opened with the passphrase
+func SealWithPassphrase(plaintext []byte, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase must not be empty")
+	}
+
+	salt := make([]byte, saltSize)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	kek, err := passphraseKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(plaintext, PassphraseEncryption, salt, func(contentKey []byte) ([]byte, error) {
+		return aes.Seal(kek, contentKey, salt)
+	})
+}
+
+// SealForRecipient encrypts the plaintext into an archive that can only be opened with the private key matching the
+// given secp256k1 public key, in compressed or uncompressed form
+func SealForRecipient(plaintext, publicKey []byte) ([]byte, error) {
+	pubKey, err := parsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(plaintext, RecipientEncryption, nil, func(contentKey []byte) ([]byte, error) {
+		return ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pubKey), contentKey, nil, nil)
+	})
+}
+
+// OpenWithPassphrase decrypts an archive sealed with SealWithPassphrase
+func OpenWithPassphrase(data []byte, passphrase string) ([]byte, error) {
+	a, err := parse(data, PassphraseEncryption)
+	if err != nil {
+		return nil, err
+	}
+
+	kek, err := passphraseKey(passphrase, a.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	contentKey, err := aes.Open(kek, a.WrappedKey, a.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid passphrase")
+	}
+
+	return open(a, contentKey)
+}
+
+// OpenAsRecipient decrypts an archive sealed with SealForRecipient using the secp256k1 private key of the recipient
+func OpenAsRecipient(data, privateKey []byte) ([]byte, error) {
+	a, err := parse(data, RecipientEncryption)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secp256k1 private key. %s", err.Error())
+	}
+
+	contentKey, err := ecies.ImportECDSA(privKey).Decrypt(a.WrappedKey, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("archive is not encrypted for this private key")
+	}
+
+	return open(a, contentKey)
+}
+
+func seal(plaintext []byte, encryption string, salt []byte, wrap func(contentKey []byte) ([]byte, error)) ([]byte, error) {
+	contentKey, err := aes.NewKey()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := aes.NewGCM(contentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := aes.Seal(aead, plaintext, []byte(encryption))
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := wrap(contentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&archive{
+		Version:    version,
+		Encryption: encryption,
+		Salt:       salt,
+		WrappedKey: wrappedKey,
+		Ciphertext: ciphertext,
+	})
+}
+
+func open(a *archive, contentKey []byte) ([]byte, error) {
+	aead, err := aes.NewGCM(contentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aes.Open(aead, a.Ciphertext, []byte(a.Encryption))
+	if err != nil {
+		return nil, fmt.Errorf("archive is corrupted")
+	}
+
+	return plaintext, nil
+}
+
+// parse parses an archive and checks that it is encrypted with the expected method
+func parse(data []byte, encryption string) (*archive, error) {
+	a := &archive{}
+	err := json.Unmarshal(data, a)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive format")
+	}
+
+	if a.Version != version {
+		return nil, fmt.Errorf("unsupported archive version %d", a.Version)
+	}
+
+	switch {
+	case a.Encryption != PassphraseEncryption && a.Encryption != RecipientEncryption:
+		return nil, fmt.Errorf("unsupported archive encryption %s", a.Encryption)
+	case a.Encryption != encryption:
+		return nil, fmt.Errorf("archive is encrypted with %s", a.Encryption)
+	}
+
+	return a, nil
+}
+
+func passphraseKey(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, aes.KeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return aes.NewGCM(key)
+}
+
+func parsePublicKey(publicKey []byte) (*ecdsa.PublicKey, error) {
+	var pubKey *ecdsa.PublicKey
+	var err error
+	if len(publicKey) == 33 {
+		pubKey, err = crypto.DecompressPubkey(publicKey)
+	} else {
+		pubKey, err = crypto.UnmarshalPubkey(publicKey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid secp256k1 public key. %s", err.Error())
+	}
+
+	return pubKey, nil
+}
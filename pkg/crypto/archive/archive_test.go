@@ -0,0 +1,82 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassphrase(t *testing.T) {
+	t.Run("should seal and open successfully", func(t *testing.T) {
+		data, err := SealWithPassphrase([]byte("my-backup"), "my-passphrase")
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "my-backup")
+
+		plaintext, err := OpenWithPassphrase(data, "my-passphrase")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("my-backup"), plaintext)
+	})
+
+	t.Run("should fail to open with another passphrase", func(t *testing.T) {
+		data, err := SealWithPassphrase([]byte("my-backup"), "my-passphrase")
+		require.NoError(t, err)
+
+		_, err = OpenWithPassphrase(data, "other-passphrase")
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to seal with an empty passphrase", func(t *testing.T) {
+		_, err := SealWithPassphrase([]byte("my-backup"), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestRecipient(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	t.Run("should seal and open successfully", func(t *testing.T) {
+		data, err := SealForRecipient([]byte("my-backup"), crypto.FromECDSAPub(&privKey.PublicKey))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "my-backup")
+
+		plaintext, err := OpenAsRecipient(data, crypto.FromECDSA(privKey))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("my-backup"), plaintext)
+	})
+
+	t.Run("should seal successfully for a compressed public key", func(t *testing.T) {
+		data, err := SealForRecipient([]byte("my-backup"), crypto.CompressPubkey(&privKey.PublicKey))
+		require.NoError(t, err)
+
+		plaintext, err := OpenAsRecipient(data, crypto.FromECDSA(privKey))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("my-backup"), plaintext)
+	})
+
+	t.Run("should fail to open with another private key", func(t *testing.T) {
+		otherKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		data, err := SealForRecipient([]byte("my-backup"), crypto.FromECDSAPub(&privKey.PublicKey))
+		require.NoError(t, err)
+
+		_, err = OpenAsRecipient(data, crypto.FromECDSA(otherKey))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to open an archive sealed with a passphrase", func(t *testing.T) {
+		data, err := SealWithPassphrase([]byte("my-backup"), "my-passphrase")
+		require.NoError(t, err)
+
+		_, err = OpenAsRecipient(data, crypto.FromECDSA(privKey))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to seal for an invalid public key", func(t *testing.T) {
+		_, err := SealForRecipient([]byte("my-backup"), []byte("invalid"))
+		assert.Error(t, err)
+	})
+}
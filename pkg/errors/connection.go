@@ -17,6 +17,7 @@ const (
 	InvalidParameter = "IR500"
 	Forbidden        = "IR600"
 	TooManyRequest   = "IR700"
+	KeyUsage         = "IR800"
 )
 
 func TooManyRequestError(format string, a ...interface{}) *Error {
@@ -27,6 +28,15 @@ func IsTooManyRequestError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), TooManyRequest)
 }
 
+// KeyUsageError is raised when a key or account cannot be used for an operation (disabled, expired or operation not allowed)
+func KeyUsageError(format string, a ...interface{}) *Error {
+	return Errorf(KeyUsage, format, a...)
+}
+
+func IsKeyUsageError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), KeyUsage)
+}
+
 // HashicorpVaultError is raised when failing to perform on Hashicorp Vault
 func HashicorpVaultError(format string, a ...interface{}) *Error {
 	return Errorf(HashicorpVault, format, a...)
@@ -0,0 +1,98 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"
+	StateOpen     State = "open"
+	StateHalfOpen State = "half-open"
+)
+
+// CircuitBreaker stops sending requests to a failing dependency after a number of consecutive failures.
+// Once open, a single trial request is let through after a timeout to decide whether to close it again.
+type CircuitBreaker struct {
+	mux       sync.Mutex
+	threshold int
+	timeout   time.Duration
+	state     State
+	failures  int
+	openedAt  time.Time
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates a circuit breaker, a zero threshold creates a breaker that never opens
+func NewCircuitBreaker(threshold int, timeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		timeout:   timeout,
+		state:     StateClosed,
+		now:       time.Now,
+	}
+}
+
+// Allow indicates whether a request can be sent
+func (b *CircuitBreaker) Allow() bool {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.timeout {
+			return false
+		}
+
+		b.state = StateHalfOpen
+		return true
+	case StateHalfOpen:
+		// Only the trial request is allowed until its outcome is known
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *CircuitBreaker) Success() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.failures = 0
+	b.state = StateClosed
+}
+
+func (b *CircuitBreaker) Failure() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.threshold {
+		b.state = StateOpen
+		b.openedAt = b.now()
+	}
+}
+
+// Abandon releases the trial request of a half-open breaker when its outcome is unknown, letting the next request try again
+func (b *CircuitBreaker) Abandon() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if b.state == StateHalfOpen {
+		b.state = StateOpen
+		b.openedAt = b.now().Add(-b.timeout)
+	}
+}
+
+func (b *CircuitBreaker) State() State {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	return b.state
+}
@@ -0,0 +1,62 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	t.Run("should stay closed below the failure threshold", func(t *testing.T) {
+		breaker.Failure()
+
+		assert.Equal(t, StateClosed, breaker.State())
+		assert.True(t, breaker.Allow())
+	})
+
+	t.Run("should open when reaching the failure threshold", func(t *testing.T) {
+		breaker.Failure()
+
+		assert.Equal(t, StateOpen, breaker.State())
+		assert.False(t, breaker.Allow())
+	})
+
+	t.Run("should let a single trial request through after the timeout", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		assert.True(t, breaker.Allow())
+		assert.Equal(t, StateHalfOpen, breaker.State())
+		assert.False(t, breaker.Allow())
+	})
+
+	t.Run("should open again if the trial request fails", func(t *testing.T) {
+		breaker.Failure()
+
+		assert.Equal(t, StateOpen, breaker.State())
+		assert.False(t, breaker.Allow())
+	})
+
+	t.Run("should close if the trial request succeeds", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		assert.True(t, breaker.Allow())
+
+		breaker.Success()
+
+		assert.Equal(t, StateClosed, breaker.State())
+		assert.True(t, breaker.Allow())
+	})
+
+	t.Run("should never open with a zero threshold", func(t *testing.T) {
+		disabled := NewCircuitBreaker(0, time.Minute)
+		for i := 0; i < 10; i++ {
+			disabled.Failure()
+		}
+
+		assert.Equal(t, StateClosed, disabled.State())
+	})
+}
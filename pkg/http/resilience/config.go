@@ -0,0 +1,49 @@
+package resilience
+
+import (
+	"time"
+)
+
+const (
+	defaultMinBackOff     = 100 * time.Millisecond
+	defaultMaxBackOff     = 10 * time.Second
+	defaultBreakerTimeout = 30 * time.Second
+)
+
+// Config of the rate limiting, retry and circuit breaking policies applied to the requests of a client
+type Config struct {
+	// RateLimit is the maximum number of requests per second (if zero then no limit)
+	RateLimit float64
+	// BurstLimit is the maximum number of requests sent at once when the rate limit allows it
+	BurstLimit int
+	// MaxRetries is the number of retries of throttled or failed requests (if zero then no retry)
+	MaxRetries int
+	// MinBackOff is the initial waiting time between retries, increased exponentially with jitter
+	MinBackOff time.Duration
+	// MaxBackOff is the maximum waiting time between retries
+	MaxBackOff time.Duration
+	// BreakerThreshold is the number of consecutive failures opening the circuit breaker (if zero then no circuit breaking)
+	BreakerThreshold int
+	// BreakerTimeout is the time the circuit breaker stays open before letting a request through again
+	BreakerTimeout time.Duration
+}
+
+func (cfg *Config) SetDefault() *Config {
+	if cfg.BurstLimit <= 0 {
+		cfg.BurstLimit = 1
+	}
+
+	if cfg.MinBackOff == 0 {
+		cfg.MinBackOff = defaultMinBackOff
+	}
+
+	if cfg.MaxBackOff == 0 {
+		cfg.MaxBackOff = defaultMaxBackOff
+	}
+
+	if cfg.BreakerTimeout == 0 {
+		cfg.BreakerTimeout = defaultBreakerTimeout
+	}
+
+	return cfg
+}
@@ -0,0 +1,196 @@
+package resilience
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"golang.org/x/time/rate"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open, request was not sent")
+
+// Transport is a http.RoundTripper applying rate limiting, retries with jittered exponential back-off
+// and circuit breaking to the requests sent through it
+type Transport struct {
+	next    http.RoundTripper
+	cfg     *Config
+	limiter *rate.Limiter
+	breaker *CircuitBreaker
+}
+
+var _ http.RoundTripper = &Transport{}
+
+func NewTransport(next http.RoundTripper, cfg *Config) *Transport {
+	t := &Transport{
+		next:    next,
+		cfg:     cfg,
+		breaker: NewCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerTimeout),
+	}
+
+	if cfg.RateLimit > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), cfg.BurstLimit)
+	}
+
+	return t
+}
+
+func (t *Transport) CircuitBreaker() *CircuitBreaker {
+	return t.breaker
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	if !t.breaker.Allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	recorded := false
+	defer func() {
+		if !recorded {
+			t.breaker.Abandon()
+		}
+	}()
+
+	req, err = bufferBody(req, t.cfg.MaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	bo := t.newBackOff()
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptReq, err := rewind(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = t.next.RoundTrip(attemptReq)
+		if !isRetryable(resp, err) {
+			t.record(resp, err)
+			recorded = true
+			return resp, err
+		}
+
+		wait := bo.NextBackOff()
+		if attempt >= t.cfg.MaxRetries || wait == backoff.Stop || !canRewind(req) {
+			t.record(resp, err)
+			recorded = true
+			return resp, err
+		}
+
+		if retryAfter, ok := parseRetryAfter(resp); ok {
+			wait = retryAfter
+		}
+		drain(resp)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *Transport) newBackOff() backoff.BackOff {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = t.cfg.MinBackOff
+	bo.MaxInterval = t.cfg.MaxBackOff
+	// Retries are bounded by their number rather than by their total duration
+	bo.MaxElapsedTime = 0
+	bo.Reset()
+
+	return bo
+}
+
+// record reports the outcome of a request to the circuit breaker, only errors from the dependency count as failures
+func (t *Transport) record(resp *http.Response, err error) {
+	if err != nil || resp.StatusCode >= http.StatusInternalServerError {
+		t.breaker.Failure()
+		return
+	}
+
+	t.breaker.Success()
+}
+
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// canRewind indicates whether the request body can be sent again
+func canRewind(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// bufferBody reads a body that cannot be sent again into memory so that requests built without GetBody, such as the
+// JSON requests of autorest, can still be retried
+func bufferBody(req *http.Request, maxRetries int) (*http.Request, error) {
+	if maxRetries == 0 || canRewind(req) {
+		return req, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	bufferedReq := req.Clone(req.Context())
+	bufferedReq.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	bufferedReq.Body, _ = bufferedReq.GetBody()
+	return bufferedReq, nil
+}
+
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+	return attemptReq, nil
+}
+
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
+
+func drain(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
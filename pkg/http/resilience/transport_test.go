@@ -0,0 +1,111 @@
+package resilience
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func respond(statusCodes ...int) (http.RoundTripper, *int) {
+	calls := 0
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(statusCodes[calls])
+		calls++
+		return rec.Result(), nil
+	}), &calls
+}
+
+func TestTransport(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "https://vault.azure.net/keys/my-key", nil)
+
+	t.Run("should retry throttled and failed requests until success", func(t *testing.T) {
+		next, calls := respond(http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK)
+		transport := NewTransport(next, (&Config{MaxRetries: 3, MinBackOff: time.Millisecond}).SetDefault())
+
+		resp, err := transport.RoundTrip(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("should retry requests whose body cannot be rewound", func(t *testing.T) {
+		var bodies []string
+		next := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+
+			rec := httptest.NewRecorder()
+			if len(bodies) == 1 {
+				rec.WriteHeader(http.StatusServiceUnavailable)
+			}
+			return rec.Result(), nil
+		})
+		transport := NewTransport(next, (&Config{MaxRetries: 3, MinBackOff: time.Millisecond}).SetDefault())
+
+		// Like the JSON requests of autorest, the body is set without GetBody
+		postReq, err := http.NewRequest(http.MethodPost, "https://vault.azure.net/keys/my-key/sign", ioutil.NopCloser(strings.NewReader(`{"alg":"ES256K"}`)))
+		require.NoError(t, err)
+		require.Nil(t, postReq.GetBody)
+
+		resp, err := transport.RoundTrip(postReq)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{`{"alg":"ES256K"}`, `{"alg":"ES256K"}`}, bodies)
+	})
+
+	t.Run("should not retry client errors", func(t *testing.T) {
+		next, calls := respond(http.StatusNotFound)
+		transport := NewTransport(next, (&Config{MaxRetries: 3, MinBackOff: time.Millisecond}).SetDefault())
+
+		resp, err := transport.RoundTrip(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("should return the last response when retries are exhausted", func(t *testing.T) {
+		next, calls := respond(http.StatusTooManyRequests, http.StatusTooManyRequests)
+		transport := NewTransport(next, (&Config{MaxRetries: 1, MinBackOff: time.Millisecond}).SetDefault())
+
+		resp, err := transport.RoundTrip(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("should fail fast once the circuit breaker is open", func(t *testing.T) {
+		calls := 0
+		next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			calls++
+			return nil, fmt.Errorf("connection refused")
+		})
+		transport := NewTransport(next, (&Config{BreakerThreshold: 2}).SetDefault())
+
+		_, _ = transport.RoundTrip(req)
+		_, _ = transport.RoundTrip(req)
+		_, err := transport.RoundTrip(req)
+
+		assert.Equal(t, ErrCircuitOpen, err)
+		assert.Equal(t, StateOpen, transport.CircuitBreaker().State())
+		assert.Equal(t, 2, calls)
+	})
+}
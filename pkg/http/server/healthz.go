@@ -12,14 +12,19 @@ type HealthzHandler struct {
 	mux       sync.RWMutex
 	liveness  map[string]CheckFunc
 	readiness map[string]CheckFunc
+	infos     map[string]InfoFunc
 }
 
 type CheckFunc func(context.Context) error
 
+// InfoFunc reports the state of components of a service, keyed by component name, without affecting the health status
+type InfoFunc func(context.Context) map[string]string
+
 func NewHealthzHandler() *HealthzHandler {
 	h := &HealthzHandler{
 		liveness:  make(map[string]CheckFunc),
 		readiness: make(map[string]CheckFunc),
+		infos:     make(map[string]InfoFunc),
 	}
 	h.Handle("/live", http.HandlerFunc(h.LiveEndpoint))
 	h.Handle("/ready", http.HandlerFunc(h.ReadyEndpoint))
@@ -27,11 +32,18 @@ func NewHealthzHandler() *HealthzHandler {
 }
 
 func (s *HealthzHandler) LiveEndpoint(w http.ResponseWriter, r *http.Request) {
-	s.handle(w, r, s.liveness)
+	s.handle(w, r, nil, s.liveness)
 }
 
 func (s *HealthzHandler) ReadyEndpoint(w http.ResponseWriter, r *http.Request) {
-	s.handle(w, r, s.readiness, s.liveness)
+	s.handle(w, r, s.infos, s.readiness, s.liveness)
+}
+
+// AddReadinessInfo adds the states reported by info to the readiness output as "<name>/<component>" entries
+func (s *HealthzHandler) AddReadinessInfo(name string, info InfoFunc) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.infos[name] = info
 }
 
 func (s *HealthzHandler) AddLivenessCheck(name string, check CheckFunc) {
@@ -63,7 +75,18 @@ func (s *HealthzHandler) collectChecks(ctx context.Context, checks map[string]Ch
 	}
 }
 
-func (s *HealthzHandler) handle(w http.ResponseWriter, r *http.Request, checks ...map[string]CheckFunc) {
+func (s *HealthzHandler) collectInfos(ctx context.Context, infos map[string]InfoFunc, resultsOut map[string]string) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	for name, info := range infos {
+		for component, state := range info(ctx) {
+			resultsOut[name+"/"+component] = state
+		}
+	}
+}
+
+func (s *HealthzHandler) handle(w http.ResponseWriter, r *http.Request, infos map[string]InfoFunc, checks ...map[string]CheckFunc) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "not allowed", http.StatusMethodNotAllowed)
 		return
@@ -74,6 +97,7 @@ func (s *HealthzHandler) handle(w http.ResponseWriter, r *http.Request, checks .
 	for _, checks := range checks {
 		s.collectChecks(r.Context(), checks, checkResults, &status)
 	}
+	s.collectInfos(r.Context(), infos, checkResults)
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(status)
@@ -108,3 +108,25 @@ func parseResponseBody(body io.ReadCloser, res interface{}) error {
 	dec.DisallowUnknownFields()
 	return dec.Decode(res)
 }
+
+func TestReadinessInfo(t *testing.T) {
+	handler := NewHealthzHandler()
+	handler.AddReadinessCheck(servOneID, func(_ context.Context) error { return nil })
+	handler.AddReadinessInfo("vaults", func(_ context.Context) map[string]string {
+		return map[string]string{"akv-europe": "circuit breaker open"}
+	})
+
+	rw := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "http://test.com", nil)
+	handler.ReadyEndpoint(rw, req)
+
+	result := rw.Result()
+	defer result.Body.Close()
+	res := map[string]string{}
+	err := parseResponseBody(result.Body, &res)
+	require.NoError(t, err)
+
+	assert.Equal(t, result.StatusCode, 200)
+	assert.Equal(t, "OK", res[servOneID])
+	assert.Equal(t, "circuit breaker open", res["vaults/akv-europe"])
+}
@@ -5,6 +5,13 @@ import (
 )
 
 func VerifyCertificateAuthority(certs []*x509.Certificate, serverName string, rootCAs *x509.CertPool, skipVerify bool) error {
+	_, err := VerifyCertificateChains(certs, serverName, rootCAs, skipVerify)
+	return err
+}
+
+// VerifyCertificateChains verifies the leaf certificate (first element of certs) and returns its verified chains,
+// each chain starting with the leaf and ending with a certificate of rootCAs
+func VerifyCertificateChains(certs []*x509.Certificate, serverName string, rootCAs *x509.CertPool, skipVerify bool) ([][]*x509.Certificate, error) {
 	opts := x509.VerifyOptions{
 		Intermediates: x509.NewCertPool(),
 		Roots:         rootCAs,
@@ -21,7 +28,5 @@ func VerifyCertificateAuthority(certs []*x509.Certificate, serverName string, ro
 		opts.Intermediates.AddCert(cert)
 	}
 
-	_, err := certs[0].Verify(opts)
-
-	return err
+	return certs[0].Verify(opts)
 }
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 
 	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
 
@@ -9,6 +10,7 @@ import (
 	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/consensys/quorum-key-manager/src/aliases"
 	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	"github.com/consensys/quorum-key-manager/src/entities"
 	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/gorilla/mux"
 )
@@ -24,6 +26,7 @@ func NewAliasHandler(aliasService aliases.Aliases) *AliasHandler {
 func (h *AliasHandler) Register(r *mux.Router) {
 	aliasRouter := r.PathPrefix("/registries/{registryName}/aliases").Subrouter()
 
+	aliasRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	aliasRouter.Methods(http.MethodPost).Path("/{key}").HandlerFunc(h.create)
 	aliasRouter.Methods(http.MethodGet).Path("/{key}").HandlerFunc(h.get)
 	aliasRouter.Methods(http.MethodPatch).Path("/{key}").HandlerFunc(h.update)
@@ -91,6 +94,61 @@ func (h *AliasHandler) get(rw http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// @Summary      List aliases
+// @Description  List the aliases of a dedicated alias registry sorted by key, paginated by cursor
+// @Tags         Aliases
+// @Produce      json
+// @Param        registryName  path      string                   true   "registry identifier"
+// @Param        limit         query     int                      false  "page size"
+// @Param        cursor        query     string                   false  "cursor of the page, returned as previousCursor or nextCursor of the paging"
+// @Param        total         query     bool                     false  "return the total count of aliases"
+// @Success      200           {object}  infrahttp.PageResponse   "List of aliases"
+// @Failure      400           {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      404           {object}  infrahttp.ErrorResponse  "Registry not found"
+// @Failure      500           {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /registries/{registryName}/aliases [get]
+func (h *AliasHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userInfo := auth.UserInfoFromContext(ctx)
+
+	limit, cursor, withTotal, err := getCursorPaging(r)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	aliases, err := h.aliases.List(ctx, getRegistry(r), cursor, limit, userInfo)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	paging := infrahttp.PagePagingResponse{}
+	if withTotal {
+		total, err := h.aliases.Count(ctx, getRegistry(r), userInfo)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+		paging.Total = &total
+	}
+
+	paging.PreviousCursor, paging.NextCursor = entities.PageCursors(cursor, len(aliases), limit, func(i int) *entities.Cursor {
+		return &entities.Cursor{ID: aliases[i].Key}
+	})
+
+	resp := []*types.AliasResponse{}
+	for _, alias := range aliases {
+		resp = append(resp, types.NewAliasResponse(alias))
+	}
+
+	err = infrahttp.WriteSearchPagingResponse(rw, r, resp, paging)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Update an alias
 // @Description  Update an alias by key from a dedicated alias registry
 // @Tags         Aliases
@@ -153,3 +211,32 @@ func (h *AliasHandler) delete(rw http.ResponseWriter, r *http.Request) {
 func getKey(r *http.Request) string {
 	return mux.Vars(r)["key"]
 }
+
+func getCursorPaging(r *http.Request) (limit uint64, cursor *entities.Cursor, withTotal bool, err error) {
+	query := r.URL.Query()
+
+	strLimit := query.Get("limit")
+	if strLimit == "" {
+		strLimit = infrahttp.DefaultPageSize
+	}
+	limit, err = strconv.ParseUint(strLimit, 10, 64)
+	if err != nil {
+		return 0, nil, false, errors.InvalidFormatError("invalid limit value")
+	}
+
+	if strCursor := query.Get("cursor"); strCursor != "" {
+		cursor, err = entities.DecodeCursor(strCursor)
+		if err != nil {
+			return 0, nil, false, err
+		}
+	}
+
+	if strTotal := query.Get("total"); strTotal != "" {
+		withTotal, err = strconv.ParseBool(strTotal)
+		if err != nil {
+			return 0, nil, false, errors.InvalidFormatError("invalid total value")
+		}
+	}
+
+	return limit, cursor, withTotal, nil
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	"github.com/consensys/quorum-key-manager/src/aliases/mock"
+	authapi "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/entities/testutils"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type aliasesHandlerTestSuite struct {
+	suite.Suite
+
+	ctrl    *gomock.Controller
+	router  *mux.Router
+	aliases *mock.MockAliases
+	ctx     context.Context
+}
+
+func TestAliasHandler(t *testing.T) {
+	s := new(aliasesHandlerTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *aliasesHandlerTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+
+	s.aliases = mock.NewMockAliases(s.ctrl)
+
+	s.ctx = authapi.WithUserInfo(context.Background(), reqUserInfo)
+
+	s.router = mux.NewRouter()
+	NewAliasHandler(s.aliases).Register(s.router)
+}
+
+func (s *aliasesHandlerTestSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+func (s *aliasesHandlerTestSuite) TestList() {
+	registry := "my-registry"
+
+	s.Run("should list the first page of aliases with the next cursor successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/registries/my-registry/aliases?limit=2&total=true", nil).WithContext(s.ctx)
+
+		aliasOne := testutils2.FakeAlias(registry, "alias-1", entities.AliasKindString, "value")
+		aliasTwo := testutils2.FakeAlias(registry, "alias-2", entities.AliasKindString, "value")
+		s.aliases.EXPECT().List(gomock.Any(), registry, nil, uint64(2), reqUserInfo).Return([]*entities.Alias{aliasOne, aliasTwo}, nil)
+		s.aliases.EXPECT().Count(gomock.Any(), registry, reqUserInfo).Return(uint64(3), nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		resp := &struct {
+			Data   []*types.AliasResponse   `json:"data"`
+			Paging http2.PagePagingResponse `json:"paging"`
+		}{}
+		err := json.Unmarshal(rw.Body.Bytes(), resp)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+		assert.Len(s.T(), resp.Data, 2)
+		assert.Equal(s.T(), uint64(3), *resp.Paging.Total)
+		assert.Empty(s.T(), resp.Paging.PreviousCursor)
+
+		next, err := entities.DecodeCursor(resp.Paging.NextCursor)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), "alias-2", next.ID)
+		assert.False(s.T(), next.Backward)
+	})
+
+	s.Run("should list the last page of aliases from a cursor successfully", func() {
+		cursor := &entities.Cursor{ID: "alias-2"}
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/registries/my-registry/aliases?limit=2&cursor="+cursor.Encode(), nil).WithContext(s.ctx)
+
+		aliasThree := testutils2.FakeAlias(registry, "alias-3", entities.AliasKindString, "value")
+		s.aliases.EXPECT().List(gomock.Any(), registry, cursor, uint64(2), reqUserInfo).Return([]*entities.Alias{aliasThree}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		resp := &struct {
+			Paging http2.PagePagingResponse `json:"paging"`
+		}{}
+		err := json.Unmarshal(rw.Body.Bytes(), resp)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+		assert.Empty(s.T(), resp.Paging.NextCursor)
+		assert.Nil(s.T(), resp.Paging.Total)
+
+		previous, err := entities.DecodeCursor(resp.Paging.PreviousCursor)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), &entities.Cursor{ID: "alias-3", Backward: true}, previous)
+	})
+
+	s.Run("should fail with 400 if cursor is invalid", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/registries/my-registry/aliases?cursor=invalid", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with correct error code if use case fails", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/registries/my-registry/aliases", nil).WithContext(s.ctx)
+
+		s.aliases.EXPECT().List(gomock.Any(), registry, nil, uint64(100), reqUserInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
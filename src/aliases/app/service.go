@@ -12,7 +12,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 )
 
-func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, authService auth.Roles) *aliases.Aliases {
+func RegisterService(router *mux.Router, logger log.Logger, postgresClient postgres.Client, authService auth.Roles) (*aliases.Aliases, *registries.Registries) {
 	// Data layer
 	aliasRepository := db.NewAlias(postgresClient)
 	regisryRepository := db.NewRegistry(postgresClient)
@@ -25,5 +25,5 @@ func RegisterService(router *mux.Router, logger log.Logger, postgresClient postg
 	http.NewRegistryHandler(registryService).Register(router)
 	http.NewAliasHandler(aliasService).Register(router)
 
-	return aliasService
+	return aliasService, registryService
 }
@@ -13,6 +13,8 @@ type Registry interface {
 	Insert(ctx context.Context, registry *entities.AliasRegistry) (*entities.AliasRegistry, error)
 	// FindOne gets an alias registry
 	FindOne(ctx context.Context, name, tenant string) (*entities.AliasRegistry, error)
+	// List lists the alias registries accessible by the tenant
+	List(ctx context.Context, tenant string) ([]*entities.AliasRegistry, error)
 	// Delete deletes an alias registry
 	Delete(ctx context.Context, name, tenant string) error
 }
@@ -22,6 +24,10 @@ type Alias interface {
 	Insert(ctx context.Context, alias *entities.Alias) (*entities.Alias, error)
 	// FindOne gets an alias from the registry
 	FindOne(ctx context.Context, registry, key, tenant string) (*entities.Alias, error)
+	// List lists the aliases of the registry sorted by key, after or before the cursor
+	List(ctx context.Context, registry, tenant string, cursor *entities.Cursor, limit uint64) ([]*entities.Alias, error)
+	// Count counts the aliases of the registry
+	Count(ctx context.Context, registry, tenant string) (uint64, error)
 	// Update updates an alias in the registry
 	Update(ctx context.Context, alias *entities.Alias) (*entities.Alias, error)
 	// Delete deletes an alias from the registry
@@ -78,6 +78,21 @@ func (mr *MockRegistryMockRecorder) Delete(ctx, name, tenant interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRegistry)(nil).Delete), ctx, name, tenant)
 }
 
+// List mocks base method
+func (m *MockRegistry) List(ctx context.Context, tenant string) ([]*entities.AliasRegistry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, tenant)
+	ret0, _ := ret[0].([]*entities.AliasRegistry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockRegistryMockRecorder) List(ctx, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRegistry)(nil).List), ctx, tenant)
+}
+
 // MockAlias is a mock of Alias interface
 type MockAlias struct {
 	ctrl     *gomock.Controller
@@ -131,6 +146,36 @@ func (mr *MockAliasMockRecorder) FindOne(ctx, registry, key, tenant interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockAlias)(nil).FindOne), ctx, registry, key, tenant)
 }
 
+// List mocks base method
+func (m *MockAlias) List(ctx context.Context, registry, tenant string, cursor *entities.Cursor, limit uint64) ([]*entities.Alias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, registry, tenant, cursor, limit)
+	ret0, _ := ret[0].([]*entities.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockAliasMockRecorder) List(ctx, registry, tenant, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAlias)(nil).List), ctx, registry, tenant, cursor, limit)
+}
+
+// Count mocks base method
+func (m *MockAlias) Count(ctx context.Context, registry, tenant string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, registry, tenant)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockAliasMockRecorder) Count(ctx, registry, tenant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAlias)(nil).Count), ctx, registry, tenant)
+}
+
 // Update mocks base method
 func (m *MockAlias) Update(ctx context.Context, alias *entities.Alias) (*entities.Alias, error) {
 	m.ctrl.T.Helper()
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/consensys/quorum-key-manager/src/aliases/database"
@@ -48,6 +49,58 @@ func (r *Alias) FindOne(ctx context.Context, registry, key, tenant string) (*ent
 	return aliasModel.ToEntity(), nil
 }
 
+func (r *Alias) List(ctx context.Context, registry, tenant string, cursor *entities.Cursor, limit uint64) ([]*entities.Alias, error) {
+	conds, args := r.registryConditions(registry, tenant)
+
+	direction := "ASC"
+	if cursor != nil {
+		operator := ">"
+		if cursor.Backward {
+			operator, direction = "<", "DESC"
+		}
+		conds, args = append(conds, fmt.Sprintf("aliases.key %s ?", operator)), append(args, cursor.ID)
+	}
+
+	query := fmt.Sprintf("SELECT aliases.key, aliases.registry_name, aliases.value, aliases.created_at, aliases.updated_at "+
+		"FROM aliases JOIN registries ON registries.name = aliases.registry_name WHERE %s ORDER BY aliases.key %s",
+		strings.Join(conds, " AND "), direction)
+	if limit != 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, limit)
+	}
+
+	var aliasModels []*models.Alias
+	err := r.pgClient.Query(ctx, &aliasModels, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := []*entities.Alias{}
+	for _, aliasModel := range aliasModels {
+		aliases = append(aliases, aliasModel.ToEntity())
+	}
+
+	if cursor != nil && cursor.Backward {
+		for i, j := 0, len(aliases)-1; i < j; i, j = i+1, j-1 {
+			aliases[i], aliases[j] = aliases[j], aliases[i]
+		}
+	}
+
+	return aliases, nil
+}
+
+func (r *Alias) Count(ctx context.Context, registry, tenant string) (uint64, error) {
+	conds, args := r.registryConditions(registry, tenant)
+
+	var count uint64
+	query := fmt.Sprintf("SELECT count(*) FROM aliases JOIN registries ON registries.name = aliases.registry_name WHERE %s", strings.Join(conds, " AND "))
+	err := r.pgClient.Query(ctx, &count, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (r *Alias) Update(ctx context.Context, alias *entities.Alias) (*entities.Alias, error) {
 	aliasModel := models.NewAlias(alias)
 	aliasModel.UpdatedAt = time.Now()
@@ -69,3 +122,12 @@ func (r *Alias) Delete(ctx context.Context, registry, key string) error {
 
 	return nil
 }
+
+func (r *Alias) registryConditions(registry, tenant string) ([]string, []interface{}) {
+	conds, args := []string{"aliases.registry_name = ?"}, []interface{}{registry}
+	if tenant != "" {
+		conds, args = append(conds, "? = ANY(registries.allowed_tenants)"), append(args, tenant)
+	}
+
+	return conds, args
+}
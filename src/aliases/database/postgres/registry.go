@@ -43,6 +43,27 @@ func (r *Registry) FindOne(ctx context.Context, name, tenant string) (*entities.
 	return registryModel.ToEntity(), nil
 }
 
+func (r *Registry) List(ctx context.Context, tenant string) ([]*entities.AliasRegistry, error) {
+	var registryModels []*models.Registry
+
+	query, params := "TRUE", []interface{}{}
+	if tenant != "" {
+		query, params = "? = ANY(allowed_tenants)", []interface{}{tenant}
+	}
+
+	err := r.pgClient.SelectWhere(ctx, &registryModels, query, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	registries := []*entities.AliasRegistry{}
+	for _, registryModel := range registryModels {
+		registries = append(registries, registryModel.ToEntity())
+	}
+
+	return registries, nil
+}
+
 func (r *Registry) Delete(ctx context.Context, name, tenant string) error {
 	err := r.pgClient.DeleteWhere(ctx, &models.Registry{Name: name}, r.whereTenant(tenant), name)
 	if err != nil {
@@ -79,6 +79,21 @@ func (mr *MockRegistriesMockRecorder) Delete(ctx, name, userInfo interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRegistries)(nil).Delete), ctx, name, userInfo)
 }
 
+// List mocks base method
+func (m *MockRegistries) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities0.AliasRegistry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userInfo)
+	ret0, _ := ret[0].([]*entities0.AliasRegistry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockRegistriesMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRegistries)(nil).List), ctx, userInfo)
+}
+
 // MockAliases is a mock of Aliases interface
 type MockAliases struct {
 	ctrl     *gomock.Controller
@@ -132,6 +147,36 @@ func (mr *MockAliasesMockRecorder) Get(ctx, registry, key, userInfo interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAliases)(nil).Get), ctx, registry, key, userInfo)
 }
 
+// List mocks base method
+func (m *MockAliases) List(ctx context.Context, registry string, cursor *entities0.Cursor, limit uint64, userInfo *entities.UserInfo) ([]*entities0.Alias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, registry, cursor, limit, userInfo)
+	ret0, _ := ret[0].([]*entities0.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockAliasesMockRecorder) List(ctx, registry, cursor, limit, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAliases)(nil).List), ctx, registry, cursor, limit, userInfo)
+}
+
+// Count mocks base method
+func (m *MockAliases) Count(ctx context.Context, registry string, userInfo *entities.UserInfo) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, registry, userInfo)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockAliasesMockRecorder) Count(ctx, registry, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAliases)(nil).Count), ctx, registry, userInfo)
+}
+
 // Update mocks base method
 func (m *MockAliases) Update(ctx context.Context, registry, key, kind string, value interface{}, userInfo *entities.UserInfo) (*entities0.Alias, error) {
 	m.ctrl.T.Helper()
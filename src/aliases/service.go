@@ -15,6 +15,8 @@ type Registries interface {
 	Create(ctx context.Context, name string, allowedTenants []string, userInfo *auth.UserInfo) (*entities.AliasRegistry, error)
 	// Get gets an alias registry
 	Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.AliasRegistry, error)
+	// List lists the alias registries accessible by the user
+	List(ctx context.Context, userInfo *auth.UserInfo) ([]*entities.AliasRegistry, error)
 	// Delete deletes an alias registry, with all the aliases it contains
 	Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error
 }
@@ -25,6 +27,10 @@ type Aliases interface {
 	Create(ctx context.Context, registry, key, kind string, value interface{}, userInfo *auth.UserInfo) (*entities.Alias, error)
 	// Get gets an alias from the registry
 	Get(ctx context.Context, registry string, key string, userInfo *auth.UserInfo) (*entities.Alias, error)
+	// List lists the aliases of the registry sorted by key, after or before the cursor
+	List(ctx context.Context, registry string, cursor *entities.Cursor, limit uint64, userInfo *auth.UserInfo) ([]*entities.Alias, error)
+	// Count counts the aliases of the registry
+	Count(ctx context.Context, registry string, userInfo *auth.UserInfo) (uint64, error)
 	// Update updates an alias in the registry
 	Update(ctx context.Context, registry, key, kind string, value interface{}, userInfo *auth.UserInfo) (*entities.Alias, error)
 	// Delete deletes an alias from the registry
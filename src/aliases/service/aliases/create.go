@@ -13,7 +13,7 @@ import (
 func (s *Aliases) Create(ctx context.Context, registry, key, kind string, value interface{}, userInfo *auth.UserInfo) (*entities.Alias, error) {
 	logger := s.logger.With("registry", registry, "key", key, "type", kind)
 
-	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
 	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionWrite, Resource: auth.ResourceAlias})
 	if err != nil {
 		return nil, err
@@ -12,7 +12,7 @@ import (
 func (s *Aliases) Delete(ctx context.Context, registry, key string, userInfo *auth.UserInfo) error {
 	logger := s.logger.With("registry", registry, "key", key)
 
-	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
 	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionDelete, Resource: auth.ResourceAlias})
 	if err != nil {
 		return err
@@ -13,7 +13,7 @@ import (
 func (s *Aliases) Get(ctx context.Context, registry, key string, userInfo *auth.UserInfo) (*entities.Alias, error) {
 	logger := s.logger.With("registry", registry, "key", key)
 
-	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
 	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceAlias})
 	if err != nil {
 		return nil, err
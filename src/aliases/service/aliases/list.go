@@ -0,0 +1,61 @@
+package aliases
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+func (s *Aliases) List(ctx context.Context, registry string, cursor *entities.Cursor, limit uint64, userInfo *auth.UserInfo) ([]*entities.Alias, error) {
+	logger := s.logger.With("registry", registry)
+
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.aliasDB.List(ctx, registry, userInfo.Tenant, cursor, limit)
+	if err != nil {
+		errMessage := "failed to list aliases"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// An empty first page may be due to a registry not found or not accessible by the tenant
+	if len(aliases) == 0 && cursor == nil {
+		_, err = s.registryDB.FindOne(ctx, registry, userInfo.Tenant)
+		if err != nil {
+			errMessage := "failed to get alias registry"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+	}
+
+	logger.Debug("aliases listed successfully")
+	return aliases, nil
+}
+
+func (s *Aliases) Count(ctx context.Context, registry string, userInfo *auth.UserInfo) (uint64, error) {
+	logger := s.logger.With("registry", registry)
+
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceAlias})
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := s.aliasDB.Count(ctx, registry, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to count aliases"
+		logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("aliases counted successfully")
+	return count, nil
+}
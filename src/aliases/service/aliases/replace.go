@@ -11,7 +11,7 @@ import (
 )
 
 func (s *Aliases) Replace(ctx context.Context, addrs []string, userInfo *auth.UserInfo) ([]string, error) {
-	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
 	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceAlias})
 	if err != nil {
 		return nil, err
@@ -12,7 +12,7 @@ import (
 func (s *Registries) Delete(ctx context.Context, name string, userInfo *auth.UserInfo) error {
 	logger := s.logger.With("name", name)
 
-	resolver := authorizator.New(s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
 	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionDelete, Resource: auth.ResourceAlias})
 	if err != nil {
 		return err
@@ -0,0 +1,29 @@
+package registries
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+func (s *Registries) List(ctx context.Context, userInfo *auth.UserInfo) ([]*entities.AliasRegistry, error) {
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
+	err := resolver.CheckPermission(&auth.Operation{Action: auth.ActionRead, Resource: auth.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	registries, err := s.db.List(ctx, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to list registries"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	s.logger.Debug("alias registries listed successfully")
+	return registries, nil
+}
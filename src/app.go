@@ -3,17 +3,22 @@ package src
 import (
 	"context"
 	"crypto/x509"
+	"fmt"
 
 	"github.com/consensys/quorum-key-manager/pkg/app"
 	aliasapp "github.com/consensys/quorum-key-manager/src/aliases/app"
 	authapp "github.com/consensys/quorum-key-manager/src/auth/app"
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/api-key/csv"
+	"github.com/consensys/quorum-key-manager/src/infra/jobs"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt"
+	"github.com/consensys/quorum-key-manager/src/infra/jwt/introspection"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt/jose"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	infratls "github.com/consensys/quorum-key-manager/src/infra/tls"
 	tls "github.com/consensys/quorum-key-manager/src/infra/tls/filesystem"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/revocation"
 	nodesapp "github.com/consensys/quorum-key-manager/src/nodes/app"
 	storesapp "github.com/consensys/quorum-key-manager/src/stores/app"
 	utilsapp "github.com/consensys/quorum-key-manager/src/utils/app"
@@ -27,16 +32,27 @@ func New(ctx context.Context, cfg *Config, logger log.Logger) (*app.App, error)
 		return nil, err
 	}
 
-	var jwtValidator jwt.Validator
+	var jwtValidators map[string]jwt.Validator
+	var tokenIntrospector jwt.Validator
+	var introspectionValidator *introspection.Validator
 	var apikeyClaims map[string]*authtypes.UserClaims
 	var rootCAs *x509.CertPool
-	if cfg.OIDC != nil {
-		jwtValidator, err = getJWTValidator(cfg.OIDC, logger)
+	var revocationChecker infratls.RevocationChecker
+	if len(cfg.OIDC) > 0 {
+		jwtValidators, err = getJWTValidators(cfg.OIDC, logger)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if cfg.Introspection != nil {
+		introspectionValidator, err = getTokenIntrospector(cfg.Introspection, logger)
+		if err != nil {
+			return nil, err
+		}
+		tokenIntrospector = introspectionValidator
+	}
+
 	if cfg.APIKey != nil {
 		apikeyClaims, err = getAPIKeys(ctx, cfg.APIKey, logger)
 		if err != nil {
@@ -51,26 +67,75 @@ func New(ctx context.Context, cfg *Config, logger log.Logger) (*app.App, error)
 		}
 	}
 
+	var crlChecker *revocation.Checker
+	if rootCAs != nil && cfg.TLSRevocation != nil {
+		crlChecker, err = getRevocationChecker(ctx, cfg.TLSRevocation, logger)
+		if err != nil {
+			return nil, err
+		}
+		revocationChecker = crlChecker
+	}
+
 	// Register Services
 	a := app.New(&app.Config{HTTP: cfg.HTTP}, logger.WithComponent("app"))
 	router := a.Router()
 
-	authService, err := authapp.RegisterService(a, logger.WithComponent("auth"), jwtValidator, apikeyClaims, rootCAs)
+	authService, err := authapp.RegisterService(a, logger.WithComponent("auth"), jwtValidators, tokenIntrospector, apikeyClaims, rootCAs, revocationChecker, cfg.TLSIdentityMapping, pgClient)
 	if err != nil {
 		return nil, err
 	}
 
-	aliasService := aliasapp.RegisterService(router, logger.WithComponent("aliases"), pgClient, authService)
-	vaultsService := vaultsapp.RegisterService(logger.WithComponent("vaults"), authService)
+	aliasService, registriesService := aliasapp.RegisterService(router, logger.WithComponent("aliases"), pgClient, authService)
+	vaultsService := vaultsapp.RegisterService(logger.WithComponent("vaults"), pgClient, authService)
 	storesService := storesapp.RegisterService(router, logger.WithComponent("stores"), pgClient, authService, vaultsService)
 	nodesService := nodesapp.RegisterService(router, logger.WithComponent("nodes"), authService, storesService, aliasService)
 	_ = utilsapp.RegisterService(router, logger.WithComponent("utilities"))
+	authapp.RegisterAccessService(router, logger.WithComponent("auth"), authService, storesService, nodesService, registriesService)
 
 	err = initialize(ctx, cfg.Manifest, authService, vaultsService, storesService, nodesService)
 	if err != nil {
 		return nil, err
 	}
 
+	if introspectionValidator != nil {
+		err = a.RegisterService(jobs.New("introspection-cache-sweep", introspection.SweepInterval, introspectionValidator.Sweep, logger.WithComponent("auth")))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// CRLs are reloaded in the background so that TLS authenticated requests never wait for them to be fetched
+	if crlChecker != nil && len(cfg.TLSRevocation.CRLs) > 0 && cfg.TLSRevocation.CRLRefreshInterval > 0 {
+		err = a.RegisterService(jobs.New("crl-refresh", cfg.TLSRevocation.CRLRefreshInterval, crlChecker.RefreshCRLs, logger.WithComponent("tls-revocation")))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.KeyRotationInterval > 0 {
+		err = a.RegisterService(jobs.New("key-rotation", cfg.KeyRotationInterval, storesService.RotateKeys, logger.WithComponent("stores")))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.PurgeInterval > 0 {
+		purgeLogger := logger.WithComponent("stores")
+		// Every purged item is recorded in the purged_items table by the connector
+		purge := func(ctx context.Context) error {
+			purgedItems, err := storesService.PurgeDeleted(ctx, false)
+			purgeLogger.Info("deleted items purged", "purged_items", len(purgedItems))
+			return err
+		}
+
+		err = a.RegisterService(jobs.New("purge", cfg.PurgeInterval, purge, purgeLogger))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	a.AddReadinessInfo("vaults", vaultsService.CircuitBreakers)
+
 	return a, nil
 }
 
@@ -90,15 +155,34 @@ func getAPIKeys(ctx context.Context, cfg *csv.Config, logger log.Logger) (map[st
 	return apikeyClaims, nil
 }
 
-func getJWTValidator(cfg *jose.Config, logger log.Logger) (*jose.Validator, error) {
-	jwtValidator, err := jose.New(cfg)
+func getJWTValidators(cfgs []*jose.Config, logger log.Logger) (map[string]jwt.Validator, error) {
+	jwtValidators := make(map[string]jwt.Validator, len(cfgs))
+	for _, cfg := range cfgs {
+		if _, ok := jwtValidators[cfg.IssuerURL]; ok {
+			return nil, fmt.Errorf("OIDC issuer %q is configured more than once", cfg.IssuerURL)
+		}
+
+		jwtValidator, err := jose.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		jwtValidators[cfg.IssuerURL] = jwtValidator
+		logger.Info("JWT authentication enabled", "issuer", cfg.IssuerURL, "algorithms", cfg.Algorithms)
+	}
+
+	return jwtValidators, nil
+}
+
+func getTokenIntrospector(cfg *introspection.Config, logger log.Logger) (*introspection.Validator, error) {
+	tokenIntrospector, err := introspection.New(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	logger.Info("JWT authentication enabled")
+	logger.Info("OAuth2 token introspection enabled", "url", cfg.URL)
 
-	return jwtValidator, nil
+	return tokenIntrospector, nil
 }
 
 func getRootCAs(ctx context.Context, cfg *tls.Config, logger log.Logger) (*x509.CertPool, error) {
@@ -116,3 +200,14 @@ func getRootCAs(ctx context.Context, cfg *tls.Config, logger log.Logger) (*x509.
 
 	return rootCAs, nil
 }
+
+func getRevocationChecker(ctx context.Context, cfg *revocation.Config, logger log.Logger) (*revocation.Checker, error) {
+	revocationChecker, err := revocation.New(ctx, cfg, logger.WithComponent("tls-revocation"))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("TLS certificate revocation checking enabled", "crls", len(cfg.CRLs), "ocsp", cfg.OCSP, "fail_open", cfg.FailOpen)
+
+	return revocationChecker, nil
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/api/types"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/gorilla/mux"
+)
+
+type AccessHandler struct {
+	access auth.Access
+}
+
+func NewAccessHandler(access auth.Access) *AccessHandler {
+	return &AccessHandler{access: access}
+}
+
+func (h *AccessHandler) Register(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/me").HandlerFunc(h.me)
+	router.Methods(http.MethodPost).Path("/authz/check").HandlerFunc(h.check)
+}
+
+// @Summary      Gets the authenticated user
+// @Description  Gets the authenticated user with its resolved permissions, and the stores, nodes and alias registries it can access
+// @Tags         Authorization
+// @Produce      json
+// @Success      200  {object}  types.MeResponse         "Authenticated user"
+// @Failure      401  {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /me [get]
+func (h *AccessHandler) me(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userAccess, err := h.access.Me(ctx, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewMeResponse(userAccess))
+}
+
+// @Summary      Checks an operation
+// @Description  Explains whether the authenticated user is allowed to perform an operation, on a store when set
+// @Tags         Authorization
+// @Accept       json
+// @Produce      json
+// @Param        request  body      types.CheckAccessRequest   true  "Operation to check"
+// @Success      200      {object}  types.CheckAccessResponse  "Authorization decision and its reasons"
+// @Failure      400      {object}  infrahttp.ErrorResponse    "Invalid request format"
+// @Failure      422      {object}  infrahttp.ErrorResponse    "Unknown operation"
+// @Failure      500      {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /authz/check [post]
+func (h *AccessHandler) check(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	checkReq := &types.CheckAccessRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, checkReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	op := &entities.Operation{Action: entities.OpAction(checkReq.Action), Resource: entities.OpResource(checkReq.Resource)}
+	decision, err := h.access.Check(ctx, op, checkReq.Store, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewCheckAccessResponse(decision))
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	stderrors "errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/api/types"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/gorilla/mux"
+)
+
+type APIKeyHandler struct {
+	apiKeys auth.APIKeys
+}
+
+func NewAPIKeyHandler(apiKeys auth.APIKeys) *APIKeyHandler {
+	return &APIKeyHandler{apiKeys: apiKeys}
+}
+
+func (h *APIKeyHandler) Register(router *mux.Router) {
+	apiKeyRouter := router.PathPrefix("/api-keys").Subrouter()
+
+	apiKeyRouter.Methods(http.MethodPost).Path("").HandlerFunc(h.create)
+	apiKeyRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	apiKeyRouter.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.get)
+	apiKeyRouter.Methods(http.MethodPost).Path("/{id}/rotate").HandlerFunc(h.rotate)
+	apiKeyRouter.Methods(http.MethodDelete).Path("/{id}").HandlerFunc(h.delete)
+}
+
+// @Summary      Creates an API key
+// @Description  Creates an API key, its value is only returned in this response
+// @Tags         API keys
+// @Accept       json
+// @Produce      json
+// @Param        request  body      types.CreateAPIKeyRequest  true  "API key creation request"
+// @Success      200      {object}  types.APIKeyResponse       "API key data with its value"
+// @Failure      400      {object}  infrahttp.ErrorResponse    "Invalid request format"
+// @Failure      403      {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      500      {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /api-keys [post]
+func (h *APIKeyHandler) create(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	createReq := &types.CreateAPIKeyRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	apiKey, value, err := h.apiKeys.Create(
+		ctx,
+		createReq.Name,
+		createReq.Tenant,
+		createReq.Roles,
+		createReq.Permissions,
+		expiry(createReq.ExpiresAt),
+		UserInfoFromContext(ctx),
+	)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewAPIKeyResponse(apiKey, value))
+}
+
+// @Summary      Lists API keys
+// @Description  Lists the API keys of the user tenant
+// @Tags         API keys
+// @Produce      json
+// @Success      200  {array}   types.APIKeyResponse     "List of API keys"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api-keys [get]
+func (h *APIKeyHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	apiKeys, err := h.apiKeys.List(ctx, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	resp := []*types.APIKeyResponse{}
+	for _, apiKey := range apiKeys {
+		resp = append(resp, types.NewAPIKeyResponse(apiKey, ""))
+	}
+
+	_ = infrahttp.WriteJSON(rw, resp)
+}
+
+// @Summary      Gets an API key
+// @Description  Gets an API key, its value is never returned
+// @Tags         API keys
+// @Produce      json
+// @Param        id   path      string                   true  "API key identifier"
+// @Success      200  {object}  types.APIKeyResponse     "API key data"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "API key not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api-keys/{id} [get]
+func (h *APIKeyHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	apiKey, err := h.apiKeys.Get(ctx, mux.Vars(r)["id"], UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewAPIKeyResponse(apiKey, ""))
+}
+
+// @Summary      Rotates an API key
+// @Description  Replaces the value of an API key, the previous value is revoked immediately
+// @Tags         API keys
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                     true   "API key identifier"
+// @Param        request  body      types.RotateAPIKeyRequest  false  "API key rotation request"
+// @Success      200      {object}  types.APIKeyResponse       "API key data with its new value"
+// @Failure      403      {object}  infrahttp.ErrorResponse    "API key grants permissions the user does not have"
+// @Failure      404      {object}  infrahttp.ErrorResponse    "API key not found"
+// @Failure      500      {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /api-keys/{id}/rotate [post]
+func (h *APIKeyHandler) rotate(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rotateReq := &types.RotateAPIKeyRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, rotateReq)
+	if err != nil && !stderrors.Is(err, io.EOF) {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	apiKey, value, err := h.apiKeys.Rotate(ctx, mux.Vars(r)["id"], expiry(rotateReq.ExpiresAt), UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewAPIKeyResponse(apiKey, value))
+}
+
+// @Summary      Revokes an API key
+// @Description  Deletes an API key, requests using it are rejected immediately
+// @Tags         API keys
+// @Param        id   path  string  true  "API key identifier"
+// @Success      204  "Deleted successfully"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "API key not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /api-keys/{id} [delete]
+func (h *APIKeyHandler) delete(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.apiKeys.Delete(ctx, mux.Vars(r)["id"], UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func expiry(expiresAt *time.Time) time.Time {
+	if expiresAt == nil {
+		return time.Time{}
+	}
+
+	return *expiresAt
+}
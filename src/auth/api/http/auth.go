@@ -1,9 +1,12 @@
 package http
 
 import (
+	"context"
 	"encoding/base64"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth"
@@ -48,7 +51,7 @@ func (m *Auth) Middleware(next http.Handler) http.Handler {
 					return
 				}
 
-				next.ServeHTTP(rw, r.WithContext(WithUserInfo(ctx, userInfo)))
+				next.ServeHTTP(rw, r.WithContext(withRequestInfo(WithUserInfo(ctx, userInfo), r, userInfo)))
 				return
 			case BasicSchema:
 				apiKey, err := base64.StdEncoding.DecodeString(authValue)
@@ -63,7 +66,7 @@ func (m *Auth) Middleware(next http.Handler) http.Handler {
 					return
 				}
 
-				next.ServeHTTP(rw, r.WithContext(WithUserInfo(ctx, userInfo)))
+				next.ServeHTTP(rw, r.WithContext(withRequestInfo(WithUserInfo(ctx, userInfo), r, userInfo)))
 				return
 			default:
 				httpinfra.WriteHTTPErrorResponse(rw, errors.InvalidFormatError("unsupported authorization schema %s", authSchema))
@@ -79,11 +82,28 @@ func (m *Auth) Middleware(next http.Handler) http.Handler {
 				return
 			}
 
-			next.ServeHTTP(rw, r.WithContext(WithUserInfo(ctx, userInfo)))
+			next.ServeHTTP(rw, r.WithContext(withRequestInfo(WithUserInfo(ctx, userInfo), r, userInfo)))
 			return
 		}
 
 		// Anonymous user if no authentication method has succeeded
-		next.ServeHTTP(rw, r.WithContext(WithUserInfo(ctx, entities.NewAnonymousUser())))
+		anonymous := entities.NewAnonymousUser()
+		next.ServeHTTP(rw, r.WithContext(withRequestInfo(WithUserInfo(ctx, anonymous), r, anonymous)))
 	})
 }
+
+// withRequestInfo attaches the request attributes evaluated by conditional permissions
+func withRequestInfo(ctx context.Context, r *http.Request, userInfo *entities.UserInfo) context.Context {
+	reqInfo := &entities.RequestInfo{
+		AuthMode: userInfo.AuthMode,
+		Time:     time.Now(),
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	reqInfo.SourceIP = net.ParseIP(host)
+
+	return entities.WithRequestInfo(ctx, reqInfo)
+}
@@ -0,0 +1,164 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/api/types"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/gorilla/mux"
+)
+
+type GrantsHandler struct {
+	grants auth.Grants
+}
+
+func NewGrantsHandler(grants auth.Grants) *GrantsHandler {
+	return &GrantsHandler{grants: grants}
+}
+
+func (h *GrantsHandler) Register(router *mux.Router) {
+	grantRouter := router.PathPrefix("/grants").Subrouter()
+
+	grantRouter.Methods(http.MethodPost).Path("").HandlerFunc(h.request)
+	grantRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	grantRouter.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.get)
+	grantRouter.Methods(http.MethodPost).Path("/{id}/approve").HandlerFunc(h.approve)
+	grantRouter.Methods(http.MethodPost).Path("/{id}/reject").HandlerFunc(h.reject)
+	grantRouter.Methods(http.MethodDelete).Path("/{id}").HandlerFunc(h.revoke)
+}
+
+// @Summary      Requests a role temporarily
+// @Description  Requests a role for a duration, the role is granted once an approver accepts the request
+// @Tags         Grants
+// @Accept       json
+// @Produce      json
+// @Param        request  body      types.RequestGrantRequest  true  "Grant request"
+// @Success      200      {object}  types.GrantResponse        "Pending grant"
+// @Failure      400      {object}  infrahttp.ErrorResponse    "Invalid request format"
+// @Failure      403      {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      422      {object}  infrahttp.ErrorResponse    "Unknown role or invalid duration"
+// @Failure      500      {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /grants [post]
+func (h *GrantsHandler) request(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grantReq := &types.RequestGrantRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, grantReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	grant, err := h.grants.Request(ctx, grantReq.Role, grantReq.Reason, grantReq.Duration.Duration, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewGrantResponse(grant))
+}
+
+// @Summary      Lists grants
+// @Description  Lists the grants of the user, or all the grants of the user tenant for users with the approve:grants permission
+// @Tags         Grants
+// @Produce      json
+// @Success      200  {array}   types.GrantResponse      "List of grants, most recent first"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /grants [get]
+func (h *GrantsHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grants, err := h.grants.List(ctx, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	resp := []*types.GrantResponse{}
+	for _, grant := range grants {
+		resp = append(resp, types.NewGrantResponse(grant))
+	}
+
+	_ = infrahttp.WriteJSON(rw, resp)
+}
+
+// @Summary      Gets a grant
+// @Tags         Grants
+// @Produce      json
+// @Param        id   path      string                   true  "Grant identifier"
+// @Success      200  {object}  types.GrantResponse      "Grant data"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Grant not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /grants/{id} [get]
+func (h *GrantsHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grant, err := h.grants.Get(ctx, mux.Vars(r)["id"], UserInfoFromContext(ctx))
+	h.writeGrant(rw, grant, err)
+}
+
+// @Summary      Approves a grant
+// @Description  Approves a pending grant of another user, the role is granted for the requested duration from now
+// @Tags         Grants
+// @Produce      json
+// @Param        id   path      string                   true  "Grant identifier"
+// @Success      200  {object}  types.GrantResponse      "Approved grant"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Grant not found"
+// @Failure      422  {object}  infrahttp.ErrorResponse  "Grant is not pending"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /grants/{id}/approve [post]
+func (h *GrantsHandler) approve(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grant, err := h.grants.Approve(ctx, mux.Vars(r)["id"], UserInfoFromContext(ctx))
+	h.writeGrant(rw, grant, err)
+}
+
+// @Summary      Rejects a grant
+// @Tags         Grants
+// @Produce      json
+// @Param        id   path      string                   true  "Grant identifier"
+// @Success      200  {object}  types.GrantResponse      "Rejected grant"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Grant not found"
+// @Failure      422  {object}  infrahttp.ErrorResponse  "Grant is not pending"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /grants/{id}/reject [post]
+func (h *GrantsHandler) reject(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grant, err := h.grants.Reject(ctx, mux.Vars(r)["id"], UserInfoFromContext(ctx))
+	h.writeGrant(rw, grant, err)
+}
+
+// @Summary      Revokes a grant
+// @Description  Revokes a pending or active grant, the role is removed from the user immediately
+// @Tags         Grants
+// @Produce      json
+// @Param        id   path      string                   true  "Grant identifier"
+// @Success      200  {object}  types.GrantResponse      "Revoked grant"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Grant not found"
+// @Failure      422  {object}  infrahttp.ErrorResponse  "Grant is neither pending nor active"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /grants/{id} [delete]
+func (h *GrantsHandler) revoke(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	grant, err := h.grants.Revoke(ctx, mux.Vars(r)["id"], UserInfoFromContext(ctx))
+	h.writeGrant(rw, grant, err)
+}
+
+func (h *GrantsHandler) writeGrant(rw http.ResponseWriter, grant *entities.Grant, err error) {
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewGrantResponse(grant))
+}
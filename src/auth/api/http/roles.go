@@ -0,0 +1,138 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/api/types"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/gorilla/mux"
+)
+
+type RolesHandler struct {
+	roles auth.Roles
+}
+
+func NewRolesHandler(roles auth.Roles) *RolesHandler {
+	return &RolesHandler{roles: roles}
+}
+
+func (h *RolesHandler) Register(router *mux.Router) {
+	rolesRouter := router.PathPrefix("/roles").Subrouter()
+
+	rolesRouter.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
+	rolesRouter.Methods(http.MethodPost).Path("/{roleName}").HandlerFunc(h.create)
+	rolesRouter.Methods(http.MethodGet).Path("/{roleName}").HandlerFunc(h.get)
+	rolesRouter.Methods(http.MethodDelete).Path("/{roleName}").HandlerFunc(h.delete)
+}
+
+// @Summary      Creates a role
+// @Description  Creates a role, scoped to the user tenant for users with a tenant. Roles can extend other roles but cannot grant more permissions than the user has
+// @Tags         Roles
+// @Accept       json
+// @Produce      json
+// @Param        roleName  path      string                   true  "role name"
+// @Param        request   body      types.CreateRoleRequest  true  "Role creation request"
+// @Success      200       {object}  types.RoleResponse       "Role data"
+// @Failure      400       {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      409       {object}  infrahttp.ErrorResponse  "Role already exists"
+// @Failure      422       {object}  infrahttp.ErrorResponse  "Role extends itself"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [post]
+func (h *RolesHandler) create(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userInfo := UserInfoFromContext(ctx)
+	roleName := mux.Vars(r)["roleName"]
+
+	createReq := &types.CreateRoleRequest{}
+	err := jsonutils.UnmarshalBody(r.Body, createReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	err = h.roles.Create(ctx, roleName, createReq.Tenant, createReq.Extends, createReq.Permissions, userInfo)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	role, err := h.roles.Get(ctx, roleName, userInfo)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewRoleResponse(role))
+}
+
+// @Summary      Lists roles
+// @Description  Lists the global roles and the roles of the user tenant
+// @Tags         Roles
+// @Produce      json
+// @Success      200  {array}   types.RoleResponse       "List of roles"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles [get]
+func (h *RolesHandler) list(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	roles, err := h.roles.List(ctx, UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	resp := []*types.RoleResponse{}
+	for _, role := range roles {
+		resp = append(resp, types.NewRoleResponse(role))
+	}
+
+	_ = infrahttp.WriteJSON(rw, resp)
+}
+
+// @Summary      Gets a role
+// @Description  Gets a role of the user tenant, or a global role
+// @Tags         Roles
+// @Produce      json
+// @Param        roleName  path      string                   true  "role name"
+// @Success      200       {object}  types.RoleResponse       "Role data"
+// @Failure      403       {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404       {object}  infrahttp.ErrorResponse  "Role not found"
+// @Failure      500       {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [get]
+func (h *RolesHandler) get(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	role, err := h.roles.Get(ctx, mux.Vars(r)["roleName"], UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = infrahttp.WriteJSON(rw, types.NewRoleResponse(role))
+}
+
+// @Summary      Deletes a role
+// @Description  Deletes a role of the user tenant, or a global role for users without tenant
+// @Tags         Roles
+// @Param        roleName  path  string  true  "role name"
+// @Success      204  "Deleted successfully"
+// @Failure      403  {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404  {object}  infrahttp.ErrorResponse  "Role not found"
+// @Failure      500  {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /roles/{roleName} [delete]
+func (h *RolesHandler) delete(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.roles.Delete(ctx, mux.Vars(r)["roleName"], UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
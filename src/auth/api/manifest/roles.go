@@ -42,7 +42,7 @@ func (h *RolesHandler) Create(ctx context.Context, name string, specs interface{
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.roles.Create(ctx, name, createReq.Permissions, h.userInfo)
+	err = h.roles.Create(ctx, name, createReq.Tenant, createReq.Extends, createReq.Permissions, h.userInfo)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+type MeResponse struct {
+	AuthMode        string                `json:"authMode,omitempty" example:"jwt"`
+	Tenant          string                `json:"tenant,omitempty" example:"tenant1"`
+	Username        string                `json:"username,omitempty" example:"alice"`
+	Roles           []string              `json:"roles" example:"signer"`
+	Permissions     []entities.Permission `json:"permissions" example:"sign:keys"`
+	Stores          []*StoreAccess        `json:"stores"`
+	Nodes           []string              `json:"nodes" example:"besu-node"`
+	AliasRegistries []string              `json:"aliasRegistries" example:"my-registry"`
+}
+
+type StoreAccess struct {
+	Name string `json:"name" example:"my-key-store"`
+	Type string `json:"type" example:"key"`
+}
+
+type CheckAccessRequest struct {
+	Action   string `json:"action" validate:"required" example:"sign"`
+	Resource string `json:"resource" validate:"required" example:"keys"`
+	Store    string `json:"store,omitempty" example:"my-key-store"`
+}
+
+type CheckAccessResponse struct {
+	Allowed    bool                `json:"allowed" example:"false"`
+	Permission entities.Permission `json:"permission" example:"sign:keys"`
+	Reasons    []string            `json:"reasons" example:"permission sign:keys is granted neither to the user nor to its roles"`
+}
+
+func NewMeResponse(userAccess *entities.UserAccess) *MeResponse {
+	resp := &MeResponse{
+		AuthMode:        userAccess.UserInfo.AuthMode,
+		Tenant:          userAccess.UserInfo.Tenant,
+		Username:        userAccess.UserInfo.Username,
+		Roles:           userAccess.UserInfo.Roles,
+		Permissions:     userAccess.Permissions,
+		Stores:          []*StoreAccess{},
+		Nodes:           userAccess.Nodes,
+		AliasRegistries: userAccess.AliasRegistries,
+	}
+
+	if resp.Roles == nil {
+		resp.Roles = []string{}
+	}
+
+	for _, store := range userAccess.Stores {
+		resp.Stores = append(resp.Stores, &StoreAccess{Name: store.Name, Type: store.Type})
+	}
+
+	return resp
+}
+
+func NewCheckAccessResponse(decision *entities.AccessDecision) *CheckAccessResponse {
+	return &CheckAccessResponse{
+		Allowed:    decision.Allowed,
+		Permission: decision.Permission,
+		Reasons:    decision.Reasons,
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+type CreateAPIKeyRequest struct {
+	Name        string                `json:"name" validate:"required" example:"ci-signer"`
+	Tenant      string                `json:"tenant,omitempty" example:"tenant1"`
+	Roles       []string              `json:"roles,omitempty" example:"signer"`
+	Permissions []entities.Permission `json:"permissions,omitempty" example:"sign:keys"`
+	ExpiresAt   *time.Time            `json:"expiresAt,omitempty" example:"2023-07-09T12:35:42.115395Z"`
+}
+
+type RotateAPIKeyRequest struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" example:"2023-07-09T12:35:42.115395Z"`
+}
+
+type APIKeyResponse struct {
+	ID          string                `json:"id" example:"9f3c3cbd0f5c4b8e5f1c2a7b6d4e8f10"`
+	Name        string                `json:"name" example:"ci-signer"`
+	Key         string                `json:"key,omitempty" example:"u8Xq3ZpVj2k7H1nWc5sR9tYb0aLm4dFe6gQiOoPyTz8"`
+	Owner       string                `json:"owner,omitempty" example:"alice"`
+	Tenant      string                `json:"tenant,omitempty" example:"tenant1"`
+	Roles       []string              `json:"roles" example:"signer"`
+	Permissions []entities.Permission `json:"permissions" example:"sign:keys"`
+	ExpiresAt   *time.Time            `json:"expiresAt,omitempty" example:"2023-07-09T12:35:42.115395Z"`
+	LastUsedAt  *time.Time            `json:"lastUsedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	CreatedAt   time.Time             `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt   time.Time             `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+// NewAPIKeyResponse formats an API key, the value is only set on creation and rotation
+func NewAPIKeyResponse(apiKey *entities.APIKey, value string) *APIKeyResponse {
+	resp := &APIKeyResponse{
+		ID:          apiKey.ID,
+		Name:        apiKey.Name,
+		Key:         value,
+		Owner:       apiKey.Owner,
+		Tenant:      apiKey.Tenant,
+		Roles:       apiKey.Roles,
+		Permissions: apiKey.Permissions,
+		CreatedAt:   apiKey.CreatedAt,
+		UpdatedAt:   apiKey.UpdatedAt,
+	}
+
+	if resp.Roles == nil {
+		resp.Roles = []string{}
+	}
+	if resp.Permissions == nil {
+		resp.Permissions = []entities.Permission{}
+	}
+	if !apiKey.ExpiresAt.IsZero() {
+		resp.ExpiresAt = &apiKey.ExpiresAt
+	}
+	if !apiKey.LastUsedAt.IsZero() {
+		resp.LastUsedAt = &apiKey.LastUsedAt
+	}
+
+	return resp
+}
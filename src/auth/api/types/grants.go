@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+type RequestGrantRequest struct {
+	Role     string        `json:"role" validate:"required" example:"operator"`
+	Reason   string        `json:"reason" validate:"required" example:"INC-1234 compromised signing key"`
+	Duration json.Duration `json:"duration" example:"1h" swaggertype:"string"`
+}
+
+type GrantResponse struct {
+	ID         string        `json:"id" example:"3b8f0c6a1d2e4f5a6b7c8d9e0f1a2b3c"`
+	Tenant     string        `json:"tenant,omitempty" example:"tenant1"`
+	Username   string        `json:"username" example:"alice"`
+	AuthMode   string        `json:"authMode" example:"jwt"`
+	Issuer     string        `json:"issuer,omitempty" example:"https://idp.example.com/"`
+	Role       string        `json:"role" example:"operator"`
+	Reason     string        `json:"reason" example:"INC-1234 compromised signing key"`
+	Duration   json.Duration `json:"duration" example:"1h0m0s" swaggertype:"string"`
+	Status     string        `json:"status" example:"approved"`
+	Approver   string        `json:"approver,omitempty" example:"bob"`
+	ApprovedAt *time.Time    `json:"approvedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	ExpiresAt  *time.Time    `json:"expiresAt,omitempty" example:"2020-07-09T13:35:42.115395Z"`
+	CreatedAt  time.Time     `json:"createdAt" example:"2020-07-09T12:30:42.115395Z"`
+	UpdatedAt  time.Time     `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func NewGrantResponse(grant *entities.Grant) *GrantResponse {
+	resp := &GrantResponse{
+		ID:        grant.ID,
+		Tenant:    grant.Tenant,
+		Username:  grant.Username,
+		AuthMode:  grant.AuthMode,
+		Issuer:    grant.Issuer,
+		Role:      grant.Role,
+		Reason:    grant.Reason,
+		Duration:  json.Duration{Duration: grant.Duration},
+		Status:    grant.CurrentStatus(),
+		Approver:  grant.Approver,
+		CreatedAt: grant.CreatedAt,
+		UpdatedAt: grant.UpdatedAt,
+	}
+
+	if !grant.ApprovedAt.IsZero() {
+		resp.ApprovedAt = &grant.ApprovedAt
+	}
+	if !grant.ExpiresAt.IsZero() {
+		resp.ExpiresAt = &grant.ExpiresAt
+	}
+
+	return resp
+}
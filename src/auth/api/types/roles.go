@@ -3,5 +3,29 @@ package types
 import "github.com/consensys/quorum-key-manager/src/auth/entities"
 
 type CreateRoleRequest struct {
+	Tenant      string                `json:"tenant,omitempty" yaml:"tenant,omitempty" example:"tenant1"`
+	Extends     []string              `json:"extends,omitempty" yaml:"extends,omitempty" example:"signer"`
 	Permissions []entities.Permission `json:"permissions" yaml:"permissions" validate:"required" example:"*:*"`
 }
+
+type RoleResponse struct {
+	Name        string                `json:"name" example:"payments-admin"`
+	Tenant      string                `json:"tenant,omitempty" example:"tenant1"`
+	Extends     []string              `json:"extends" example:"signer"`
+	Permissions []entities.Permission `json:"permissions" example:"*:keys"`
+}
+
+func NewRoleResponse(role *entities.Role) *RoleResponse {
+	resp := &RoleResponse{
+		Name:        role.Name,
+		Tenant:      role.Tenant,
+		Extends:     role.Extends,
+		Permissions: role.Permissions,
+	}
+
+	if resp.Extends == nil {
+		resp.Extends = []string{}
+	}
+
+	return resp
+}
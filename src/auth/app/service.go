@@ -4,28 +4,48 @@ import (
 	"crypto/x509"
 
 	"github.com/consensys/quorum-key-manager/pkg/app"
+	"github.com/consensys/quorum-key-manager/src/aliases"
+	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/auth/api/http"
+	db "github.com/consensys/quorum-key-manager/src/auth/database/postgres"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/access"
+	"github.com/consensys/quorum-key-manager/src/auth/service/apikeys"
 	"github.com/consensys/quorum-key-manager/src/auth/service/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/service/grants"
 	"github.com/consensys/quorum-key-manager/src/auth/service/roles"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/infra/tls"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/identity"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/gorilla/mux"
 	"github.com/justinas/alice"
 )
 
 func RegisterService(
 	a *app.App,
 	logger log.Logger,
-	jwtValidator jwt.Validator,
+	jwtValidators map[string]jwt.Validator,
+	tokenIntrospector jwt.Validator,
 	apikeyClaims map[string]*entities.UserClaims,
 	rootCAs *x509.CertPool,
+	revocationChecker tls.RevocationChecker,
+	tlsMapping *identity.Mapping,
+	postgresClient postgres.Client,
 ) (*roles.Roles, error) {
+	// Data layer
+	apiKeysRepository := db.NewAPIKeys(postgresClient)
+	grantsRepository := db.NewGrants(postgresClient)
+
 	// Business layer
 	// TODO: Create authorizator service here
 
 	var authmid alice.Constructor
-	if jwtValidator != nil || apikeyClaims != nil || rootCAs != nil {
-		autheServ := authenticator.New(jwtValidator, apikeyClaims, rootCAs, logger)
+	if len(jwtValidators) > 0 || tokenIntrospector != nil || apikeyClaims != nil || rootCAs != nil {
+		autheServ := authenticator.New(jwtValidators, tokenIntrospector, apikeyClaims, apiKeysRepository, rootCAs, revocationChecker, tlsMapping, logger)
 		authmid = http.NewAuth(autheServ).Middleware
 		logger.Info("authentication middleware is enabled")
 	} else {
@@ -33,7 +53,9 @@ func RegisterService(
 		logger.Warn("authentication is disabled")
 	}
 
-	rolesService := roles.New(logger)
+	rolesService := roles.New(grantsRepository, logger)
+	apiKeysService := apikeys.New(apiKeysRepository, rolesService, logger)
+	grantsService := grants.New(grantsRepository, rolesService, logger)
 
 	// Service layer
 	httpMid := alice.New(
@@ -45,5 +67,23 @@ func RegisterService(
 		return nil, err
 	}
 
+	http.NewAPIKeyHandler(apiKeysService).Register(a.Router())
+	http.NewRolesHandler(rolesService).Register(a.Router())
+	http.NewGrantsHandler(grantsService).Register(a.Router())
+
 	return rolesService, nil
 }
+
+// RegisterAccessService registers the endpoints explaining user authorizations, they depend on the services of all domains
+func RegisterAccessService(
+	router *mux.Router,
+	logger log.Logger,
+	rolesService auth.Roles,
+	storesService stores.Stores,
+	nodesService nodes.Nodes,
+	registriesService aliases.Registries,
+) {
+	accessService := access.New(rolesService, storesService, nodesService, registriesService, logger)
+
+	http.NewAccessHandler(accessService).Register(router)
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type APIKeys interface {
+	// Insert inserts an API key
+	Insert(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error)
+	// FindOne gets an API key, an empty tenant matches every API key
+	FindOne(ctx context.Context, id, tenant string) (*entities.APIKey, error)
+	// FindByHash gets an API key by the hash of its value
+	FindByHash(ctx context.Context, hash string) (*entities.APIKey, error)
+	// List lists the API keys of a tenant, an empty tenant matches every API key
+	List(ctx context.Context, tenant string) ([]*entities.APIKey, error)
+	// Update updates the hash and expiry of an API key
+	Update(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error)
+	// UpdateLastUsed records the last time an API key authenticated a request
+	UpdateLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error
+	// Delete deletes an API key
+	Delete(ctx context.Context, id, tenant string) error
+}
+
+type Grants interface {
+	// Insert inserts a grant
+	Insert(ctx context.Context, grant *entities.Grant) (*entities.Grant, error)
+	// FindOne gets a grant, an empty tenant matches every grant
+	FindOne(ctx context.Context, id, tenant string) (*entities.Grant, error)
+	// List lists the grants of a tenant, of a user when set. An empty tenant matches every grant
+	List(ctx context.Context, tenant, username string) ([]*entities.Grant, error)
+	// ListActive lists the approved grants of a user which have not expired at the given time, requested with the same
+	// authentication mode and issuer
+	ListActive(ctx context.Context, tenant, username, authMode, issuer string, at time.Time) ([]*entities.Grant, error)
+	// Update updates the status, approval and expiry of a grant, only if its status is still the expected one
+	Update(ctx context.Context, grant *entities.Grant, expectedStatus string) (*entities.Grant, error)
+}
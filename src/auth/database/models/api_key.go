@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+type APIKey struct {
+	tableName struct{} `pg:"api_keys"` // nolint:unused,structcheck // reason
+
+	ID          string `pg:",pk"`
+	Name        string
+	Hash        string
+	Owner       string
+	Tenant      string
+	Roles       []string `pg:",array"`
+	Permissions []string `pg:",array"`
+	ExpiresAt   time.Time
+	LastUsedAt  time.Time
+	CreatedAt   time.Time `pg:"default:now()"`
+	UpdatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewAPIKey(apiKey *entities.APIKey) *APIKey {
+	permissions := []string{}
+	for _, p := range apiKey.Permissions {
+		permissions = append(permissions, string(p))
+	}
+
+	return &APIKey{
+		ID:          apiKey.ID,
+		Name:        apiKey.Name,
+		Hash:        apiKey.Hash,
+		Owner:       apiKey.Owner,
+		Tenant:      apiKey.Tenant,
+		Roles:       apiKey.Roles,
+		Permissions: permissions,
+		ExpiresAt:   apiKey.ExpiresAt,
+		LastUsedAt:  apiKey.LastUsedAt,
+		CreatedAt:   apiKey.CreatedAt,
+		UpdatedAt:   apiKey.UpdatedAt,
+	}
+}
+
+func (k *APIKey) ToEntity() *entities.APIKey {
+	permissions := []entities.Permission{}
+	for _, p := range k.Permissions {
+		permissions = append(permissions, entities.Permission(p))
+	}
+
+	return &entities.APIKey{
+		ID:          k.ID,
+		Name:        k.Name,
+		Hash:        k.Hash,
+		Owner:       k.Owner,
+		Tenant:      k.Tenant,
+		Roles:       k.Roles,
+		Permissions: permissions,
+		ExpiresAt:   k.ExpiresAt,
+		LastUsedAt:  k.LastUsedAt,
+		CreatedAt:   k.CreatedAt,
+		UpdatedAt:   k.UpdatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+type Grant struct {
+	tableName struct{} `pg:"grants"` // nolint:unused,structcheck // reason
+
+	ID         string `pg:",pk"`
+	Tenant     string
+	Username   string
+	AuthMode   string
+	Issuer     string
+	Role       string
+	Reason     string
+	Duration   time.Duration
+	Status     string
+	Approver   string
+	ApprovedAt time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time `pg:"default:now()"`
+	UpdatedAt  time.Time `pg:"default:now()"`
+}
+
+func NewGrant(grant *entities.Grant) *Grant {
+	return &Grant{
+		ID:         grant.ID,
+		Tenant:     grant.Tenant,
+		Username:   grant.Username,
+		AuthMode:   grant.AuthMode,
+		Issuer:     grant.Issuer,
+		Role:       grant.Role,
+		Reason:     grant.Reason,
+		Duration:   grant.Duration,
+		Status:     grant.Status,
+		Approver:   grant.Approver,
+		ApprovedAt: grant.ApprovedAt,
+		ExpiresAt:  grant.ExpiresAt,
+		CreatedAt:  grant.CreatedAt,
+		UpdatedAt:  grant.UpdatedAt,
+	}
+}
+
+func (g *Grant) ToEntity() *entities.Grant {
+	return &entities.Grant{
+		ID:         g.ID,
+		Tenant:     g.Tenant,
+		Username:   g.Username,
+		AuthMode:   g.AuthMode,
+		Issuer:     g.Issuer,
+		Role:       g.Role,
+		Reason:     g.Reason,
+		Duration:   g.Duration,
+		Status:     g.Status,
+		Approver:   g.Approver,
+		ApprovedAt: g.ApprovedAt,
+		ExpiresAt:  g.ExpiresAt,
+		CreatedAt:  g.CreatedAt,
+		UpdatedAt:  g.UpdatedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/database"
+	"github.com/consensys/quorum-key-manager/src/auth/database/models"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+)
+
+type APIKeys struct {
+	pgClient postgres.Client
+}
+
+var _ database.APIKeys = &APIKeys{}
+
+func NewAPIKeys(pgClient postgres.Client) *APIKeys {
+	return &APIKeys{pgClient: pgClient}
+}
+
+func (r *APIKeys) Insert(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+	apiKeyModel := models.NewAPIKey(apiKey)
+	apiKeyModel.CreatedAt = time.Now()
+	apiKeyModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.Insert(ctx, apiKeyModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyModel.ToEntity(), nil
+}
+
+func (r *APIKeys) FindOne(ctx context.Context, id, tenant string) (*entities.APIKey, error) {
+	apiKeyModel := &models.APIKey{}
+
+	where, params := whereTenant("id = ?", tenant, id)
+	err := r.pgClient.SelectWhere(ctx, apiKeyModel, where, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyModel.ToEntity(), nil
+}
+
+func (r *APIKeys) FindByHash(ctx context.Context, hash string) (*entities.APIKey, error) {
+	apiKeyModel := &models.APIKey{}
+
+	err := r.pgClient.SelectWhere(ctx, apiKeyModel, "hash = ?", []string{}, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeyModel.ToEntity(), nil
+}
+
+func (r *APIKeys) List(ctx context.Context, tenant string) ([]*entities.APIKey, error) {
+	var apiKeyModels []*models.APIKey
+
+	where, params := whereTenant("TRUE", tenant)
+	err := r.pgClient.SelectWhere(ctx, &apiKeyModels, where, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := []*entities.APIKey{}
+	for _, apiKeyModel := range apiKeyModels {
+		apiKeys = append(apiKeys, apiKeyModel.ToEntity())
+	}
+
+	return apiKeys, nil
+}
+
+func (r *APIKeys) Update(ctx context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+	apiKeyModel := &models.APIKey{
+		Hash:      apiKey.Hash,
+		ExpiresAt: apiKey.ExpiresAt,
+		UpdatedAt: time.Now(),
+	}
+
+	err := r.pgClient.UpdateWhere(ctx, apiKeyModel, "id = ?", apiKey.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindOne(ctx, apiKey.ID, "")
+}
+
+func (r *APIKeys) UpdateLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error {
+	return r.pgClient.UpdateWhere(ctx, &models.APIKey{LastUsedAt: lastUsedAt}, "id = ?", id)
+}
+
+func (r *APIKeys) Delete(ctx context.Context, id, tenant string) error {
+	where, params := whereTenant("id = ?", tenant, id)
+	return r.pgClient.DeleteWhere(ctx, &models.APIKey{}, where, params...)
+}
+
+func whereTenant(query, tenant string, params ...interface{}) (string, []interface{}) {
+	if tenant != "" {
+		return query + " AND tenant = ?", append(params, tenant)
+	}
+
+	return query, params
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/database"
+	"github.com/consensys/quorum-key-manager/src/auth/database/models"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+)
+
+type Grants struct {
+	pgClient postgres.Client
+}
+
+var _ database.Grants = &Grants{}
+
+func NewGrants(pgClient postgres.Client) *Grants {
+	return &Grants{pgClient: pgClient}
+}
+
+func (r *Grants) Insert(ctx context.Context, grant *entities.Grant) (*entities.Grant, error) {
+	grantModel := models.NewGrant(grant)
+	grantModel.CreatedAt = time.Now()
+	grantModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.Insert(ctx, grantModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return grantModel.ToEntity(), nil
+}
+
+func (r *Grants) FindOne(ctx context.Context, id, tenant string) (*entities.Grant, error) {
+	grantModel := &models.Grant{}
+
+	where, params := whereTenant("id = ?", tenant, id)
+	err := r.pgClient.SelectWhere(ctx, grantModel, where, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return grantModel.ToEntity(), nil
+}
+
+func (r *Grants) List(ctx context.Context, tenant, username string) ([]*entities.Grant, error) {
+	var grantModels []*models.Grant
+
+	query, params := "TRUE", []interface{}{}
+	if username != "" {
+		query, params = "username = ?", append(params, username)
+	}
+
+	where, params := whereTenant(query, tenant, params...)
+	err := r.pgClient.SelectWhere(ctx, &grantModels, where, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGrants(grantModels), nil
+}
+
+func (r *Grants) ListActive(ctx context.Context, tenant, username, authMode, issuer string, at time.Time) ([]*entities.Grant, error) {
+	var grantModels []*models.Grant
+
+	// Users without tenant only match grants without tenant
+	where := "username = ? AND auth_mode = ? AND COALESCE(issuer, '') = ? AND status = ? AND expires_at > ?"
+	params := []interface{}{username, authMode, issuer, entities.GrantApproved, at}
+	if tenant != "" {
+		where, params = where+" AND tenant = ?", append(params, tenant)
+	} else {
+		where += " AND tenant IS NULL"
+	}
+
+	err := r.pgClient.SelectWhere(ctx, &grantModels, where, []string{}, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGrants(grantModels), nil
+}
+
+func (r *Grants) Update(ctx context.Context, grant *entities.Grant, expectedStatus string) (*entities.Grant, error) {
+	grantModel := &models.Grant{
+		Status:     grant.Status,
+		Approver:   grant.Approver,
+		ApprovedAt: grant.ApprovedAt,
+		ExpiresAt:  grant.ExpiresAt,
+		UpdatedAt:  time.Now(),
+	}
+
+	err := r.pgClient.UpdateWhere(ctx, grantModel, "id = ? AND status = ?", grant.ID, expectedStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindOne(ctx, grant.ID, "")
+}
+
+func toGrants(grantModels []*models.Grant) []*entities.Grant {
+	grants := []*entities.Grant{}
+	for _, grantModel := range grantModels {
+		grants = append(grants, grantModel.ToEntity())
+	}
+
+	return grants
+}
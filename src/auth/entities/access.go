@@ -0,0 +1,23 @@
+package entities
+
+// UserAccess describes what an authenticated user can access
+type UserAccess struct {
+	UserInfo *UserInfo
+	// Permissions are the permissions of the user, resolved from its roles
+	Permissions     []Permission
+	Stores          []*StoreAccess
+	Nodes           []string
+	AliasRegistries []string
+}
+
+type StoreAccess struct {
+	Name string
+	Type string
+}
+
+// AccessDecision explains whether an operation is allowed
+type AccessDecision struct {
+	Allowed    bool
+	Permission Permission
+	Reasons    []string
+}
@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// APIKey is an API key managed in database, only the SHA-256 hash of the key is stored
+type APIKey struct {
+	ID          string
+	Name        string
+	Hash        string
+	Owner       string
+	Tenant      string
+	Roles       []string
+	Permissions []Permission
+	ExpiresAt   time.Time
+	LastUsedAt  time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (k *APIKey) IsExpired() bool {
+	return !k.ExpiresAt.IsZero() && !time.Now().Before(k.ExpiresAt)
+}
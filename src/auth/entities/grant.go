@@ -0,0 +1,43 @@
+package entities
+
+import "time"
+
+const (
+	GrantPending  = "pending"
+	GrantApproved = "approved"
+	GrantRejected = "rejected"
+	GrantRevoked  = "revoked"
+	GrantExpired  = "expired"
+)
+
+// Grant is a temporary membership of a role requested by a user, effective once approved and until it expires
+type Grant struct {
+	ID       string
+	Tenant   string
+	Username string
+	// AuthMode and Issuer the user was authenticated with when requesting the grant, the grant only applies to them
+	AuthMode   string
+	Issuer     string
+	Role       string
+	Reason     string
+	Duration   time.Duration
+	Status     string
+	Approver   string
+	ApprovedAt time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (g *Grant) IsActive() bool {
+	return g.Status == GrantApproved && time.Now().Before(g.ExpiresAt)
+}
+
+// CurrentStatus returns the status of the grant, approved grants past their expiry are expired
+func (g *Grant) CurrentStatus() string {
+	if g.Status == GrantApproved && !g.IsActive() {
+		return GrantExpired
+	}
+
+	return g.Status
+}
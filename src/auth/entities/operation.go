@@ -10,6 +10,9 @@ var ActionEncrypt OpAction = "encrypt"
 var ActionDelete OpAction = "delete"
 var ActionDestroy OpAction = "destroy"
 var ActionProxy OpAction = "proxy"
+var ActionApprove OpAction = "approve"
+var ActionMigrate OpAction = "migrate"
+var ActionBackup OpAction = "backup"
 
 var ResourceKey OpResource = "keys"
 var ResourceSecret OpResource = "secrets"
@@ -17,6 +20,9 @@ var ResourceEthAccount OpResource = "ethereum"
 var ResourceStore OpResource = "stores"
 var ResourceNode OpResource = "nodes"
 var ResourceAlias OpResource = "aliases"
+var ResourceAPIKey OpResource = "api-keys"
+var ResourceRole OpResource = "roles"
+var ResourceGrant OpResource = "grants"
 
 type Operation struct {
 	Action   OpAction
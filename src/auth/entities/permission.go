@@ -32,6 +32,20 @@ const ReadAlias Permission = "read:aliases"
 const WriteAlias Permission = "write:aliases"
 const DeleteAlias Permission = "delete:aliases"
 
+const ReadAPIKey Permission = "read:api-keys"
+const WriteAPIKey Permission = "write:api-keys"
+const DeleteAPIKey Permission = "delete:api-keys"
+
+const ReadRole Permission = "read:roles"
+const WriteRole Permission = "write:roles"
+const DeleteRole Permission = "delete:roles"
+
+const ApproveGrant Permission = "approve:grants"
+
+const MigrateStore Permission = "migrate:stores"
+const BackupStore Permission = "backup:stores"
+
+// ListPermissions returns the permissions wildcards expand to
 func ListPermissions() []Permission {
 	return []Permission{
 		ReadSecret,
@@ -57,6 +71,22 @@ func ListPermissions() []Permission {
 	}
 }
 
+// ListAdminPermissions returns the administrative permissions, wildcards never expand to them so that they must be
+// granted explicitly
+func ListAdminPermissions() []Permission {
+	return []Permission{
+		ReadAPIKey,
+		WriteAPIKey,
+		DeleteAPIKey,
+		ReadRole,
+		WriteRole,
+		DeleteRole,
+		ApproveGrant,
+		MigrateStore,
+		BackupStore,
+	}
+}
+
 func ListWildcardPermission(p string) []Permission {
 	all := ListPermissions()
 	parts := strings.Split(p, ":")
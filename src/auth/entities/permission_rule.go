@@ -0,0 +1,347 @@
+package entities
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DenyPrefix marks a permission as a deny rule, e.g. "!destroy:*"
+const DenyPrefix = "!"
+
+// ConditionsSeparator separates a permission from its conditions, e.g. "destroy:keys?auth_mode=tls"
+const ConditionsSeparator = "?"
+
+const (
+	CIDRCondition     = "cidr"
+	TimeCondition     = "time"
+	TimezoneCondition = "tz"
+	AuthModeCondition = "auth_mode"
+)
+
+// PermissionRule is a parsed permission of the form "[!]action:resource[?conditions]"
+type PermissionRule struct {
+	Deny       bool
+	Action     string
+	Resource   string
+	Conditions *PermissionConditions
+}
+
+// PermissionConditions restrict when a rule applies, all conditions set must be satisfied
+type PermissionConditions struct {
+	CIDRs      []*net.IPNet
+	TimeWindow *TimeWindow
+	AuthModes  []string
+}
+
+// TimeWindow is a daily window in minutes since midnight, windows with From > To span midnight
+type TimeWindow struct {
+	From     int
+	To       int
+	Location *time.Location
+}
+
+// IsPermissionRule indicates whether the permission is a deny rule or has conditions
+func IsPermissionRule(p Permission) bool {
+	return strings.HasPrefix(string(p), DenyPrefix) || strings.Contains(string(p), ConditionsSeparator)
+}
+
+func ParsePermissionRule(p Permission) (*PermissionRule, error) {
+	rule := &PermissionRule{}
+
+	value := string(p)
+	if strings.HasPrefix(value, DenyPrefix) {
+		rule.Deny = true
+		value = strings.TrimPrefix(value, DenyPrefix)
+	}
+
+	if idx := strings.Index(value, ConditionsSeparator); idx >= 0 {
+		conditions, err := parseConditions(value[idx+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid conditions in permission %s: %v", p, err)
+		}
+		rule.Conditions = conditions
+		value = value[:idx]
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid permission %s, expected format [!]action:resource[?conditions]", p)
+	}
+	rule.Action, rule.Resource = parts[0], parts[1]
+
+	return rule, nil
+}
+
+// Permission returns the permission without deny prefix nor conditions
+func (r *PermissionRule) Permission() Permission {
+	return Permission(fmt.Sprintf("%s:%s", r.Action, r.Resource))
+}
+
+func (r *PermissionRule) Matches(action OpAction, resource OpResource) bool {
+	return (r.Action == "*" || r.Action == string(action)) && (r.Resource == "*" || r.Resource == string(resource))
+}
+
+// Unconditional indicates whether the rule applies to every request
+func (r *PermissionRule) Unconditional() bool {
+	return r.Conditions == nil
+}
+
+// Satisfied indicates whether the request meets all the conditions of the rule.
+// Unknown request attributes never satisfy a condition
+func (c *PermissionConditions) Satisfied(req *RequestInfo) bool {
+	return c.satisfied(req, false)
+}
+
+// MaySatisfy indicates whether the request could meet all the conditions of the rule.
+// Unknown request attributes always satisfy a condition, which is the safe evaluation for deny rules
+func (c *PermissionConditions) MaySatisfy(req *RequestInfo) bool {
+	return c.satisfied(req, true)
+}
+
+func (c *PermissionConditions) satisfied(req *RequestInfo, unknownSatisfies bool) bool {
+	if c == nil {
+		return true
+	}
+	if req == nil {
+		req = &RequestInfo{}
+	}
+
+	if len(c.CIDRs) > 0 {
+		if req.SourceIP == nil {
+			if !unknownSatisfies {
+				return false
+			}
+		} else if !containsIP(c.CIDRs, req.SourceIP) {
+			return false
+		}
+	}
+
+	if c.TimeWindow != nil {
+		now := req.Time
+		if now.IsZero() {
+			now = time.Now()
+		}
+		if !c.TimeWindow.Contains(now) {
+			return false
+		}
+	}
+
+	if len(c.AuthModes) > 0 {
+		if req.AuthMode == "" {
+			return unknownSatisfies
+		}
+		for _, mode := range c.AuthModes {
+			if mode == req.AuthMode {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func (w *TimeWindow) Contains(t time.Time) bool {
+	t = t.In(w.Location)
+	minutes := t.Hour()*60 + t.Minute()
+	if w.From <= w.To {
+		return minutes >= w.From && minutes < w.To
+	}
+
+	return minutes >= w.From || minutes < w.To
+}
+
+func parseConditions(raw string) (*PermissionConditions, error) {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := &PermissionConditions{}
+	location := time.UTC
+	for key, vals := range values {
+		for _, val := range vals {
+			switch key {
+			case CIDRCondition:
+				for _, cidr := range strings.Split(val, ",") {
+					_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+					if err != nil {
+						return nil, err
+					}
+					conditions.CIDRs = append(conditions.CIDRs, ipNet)
+				}
+			case TimeCondition:
+				if conditions.TimeWindow != nil {
+					return nil, fmt.Errorf("time condition set more than once")
+				}
+				conditions.TimeWindow, err = parseTimeWindow(val)
+				if err != nil {
+					return nil, err
+				}
+			case TimezoneCondition:
+				location, err = time.LoadLocation(val)
+				if err != nil {
+					return nil, err
+				}
+			case AuthModeCondition:
+				for _, mode := range strings.Split(val, ",") {
+					conditions.AuthModes = append(conditions.AuthModes, strings.TrimSpace(mode))
+				}
+			default:
+				return nil, fmt.Errorf("unknown condition %s", key)
+			}
+		}
+	}
+
+	if conditions.TimeWindow != nil {
+		conditions.TimeWindow.Location = location
+	}
+
+	return conditions, nil
+}
+
+func parseTimeWindow(val string) (*TimeWindow, error) {
+	bounds := strings.Split(val, "-")
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("invalid time window %s, expected format HH:MM-HH:MM", val)
+	}
+
+	from, err := parseMinutes(bounds[0])
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseMinutes(bounds[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimeWindow{From: from, To: to}, nil
+}
+
+func parseMinutes(val string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(val))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, expected format HH:MM", val)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func containsIP(cidrs []*net.IPNet, ip net.IP) bool {
+	for _, cidr := range cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyDenyRules removes the permissions matched by unconditional deny rules, rules are kept for the authorizator
+func ApplyDenyRules(permissions []Permission) []Permission {
+	denyRules := parseRules(permissions, true)
+	if len(denyRules) == 0 {
+		return permissions
+	}
+
+	var filtered []Permission
+	for _, p := range permissions {
+		if !IsPermissionRule(p) && deniedBy(denyRules, p) {
+			continue
+		}
+		filtered = append(filtered, p)
+	}
+
+	return filtered
+}
+
+// UngrantedPermission returns the first requested permission which is not held. Deny rules can always be granted,
+// conditional permissions require their unconditional counterpart or the exact same rule. Permissions held
+// but matched by any deny rule are not considered held
+func UngrantedPermission(held, requested []Permission) (Permission, bool) {
+	denyRules := parseRules(held, false)
+	heldSet := map[Permission]bool{}
+	for _, p := range held {
+		if IsPermissionRule(p) || !deniedBy(denyRules, p) {
+			heldSet[p] = true
+		}
+	}
+
+	for _, p := range requested {
+		if !IsPermissionRule(p) {
+			if !strings.Contains(string(p), "*") && !heldSet[p] {
+				return p, true
+			}
+			continue
+		}
+
+		rule, err := ParsePermissionRule(p)
+		if err != nil {
+			return p, true
+		}
+		if rule.Deny || heldSet[p] {
+			continue
+		}
+
+		base := []Permission{rule.Permission()}
+		if strings.Contains(string(rule.Permission()), "*") {
+			base = ListWildcardPermission(string(rule.Permission()))
+		}
+		for _, bp := range base {
+			if !heldSet[bp] {
+				return p, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// ValidatePermissions checks the syntax of deny rules and conditional permissions
+func ValidatePermissions(permissions []Permission) error {
+	for _, p := range permissions {
+		if !IsPermissionRule(p) {
+			continue
+		}
+		if _, err := ParsePermissionRule(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseRules returns the valid deny rules, unconditional ones only if requested
+func parseRules(permissions []Permission, unconditionalOnly bool) []*PermissionRule {
+	var rules []*PermissionRule
+	for _, p := range permissions {
+		if !strings.HasPrefix(string(p), DenyPrefix) {
+			continue
+		}
+		rule, err := ParsePermissionRule(p)
+		if err != nil || (unconditionalOnly && !rule.Unconditional()) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func deniedBy(denyRules []*PermissionRule, p Permission) bool {
+	parts := strings.Split(string(p), ":")
+	if len(parts) != 2 {
+		return false
+	}
+
+	for _, rule := range denyRules {
+		if rule.Matches(OpAction(parts[0]), OpResource(parts[1])) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePermissionRule(t *testing.T) {
+	rule, err := ParsePermissionRule("!destroy:*")
+	require.NoError(t, err)
+	assert.True(t, rule.Deny)
+	assert.True(t, rule.Unconditional())
+	assert.True(t, rule.Matches(ActionDestroy, ResourceKey))
+	assert.False(t, rule.Matches(ActionSign, ResourceKey))
+
+	rule, err = ParsePermissionRule("sign:keys?cidr=10.0.0.0/8,192.168.0.0/16&time=22:00-06:00&tz=Europe/Paris&auth_mode=tls")
+	require.NoError(t, err)
+	assert.False(t, rule.Deny)
+	assert.Equal(t, SignKey, rule.Permission())
+	assert.Len(t, rule.Conditions.CIDRs, 2)
+	assert.Equal(t, []string{"tls"}, rule.Conditions.AuthModes)
+	assert.Equal(t, 22*60, rule.Conditions.TimeWindow.From)
+	assert.Equal(t, "Europe/Paris", rule.Conditions.TimeWindow.Location.String())
+
+	for _, invalid := range []Permission{"destroy", "!:keys", "sign:keys?cidr=10.0.0.0", "sign:keys?time=25:00-06:00", "sign:keys?unknown=1"} {
+		_, err = ParsePermissionRule(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestPermissionConditions(t *testing.T) {
+	rule, err := ParsePermissionRule("destroy:keys?cidr=10.0.0.0/8&time=22:00-06:00&auth_mode=tls")
+	require.NoError(t, err)
+
+	night := time.Date(2021, 1, 1, 23, 30, 0, 0, time.UTC)
+	day := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, rule.Conditions.Satisfied(&RequestInfo{SourceIP: net.ParseIP("10.1.2.3"), AuthMode: "tls", Time: night}))
+	assert.False(t, rule.Conditions.Satisfied(&RequestInfo{SourceIP: net.ParseIP("10.1.2.3"), AuthMode: "tls", Time: day}))
+	assert.False(t, rule.Conditions.Satisfied(&RequestInfo{SourceIP: net.ParseIP("11.1.2.3"), AuthMode: "tls", Time: night}))
+	assert.False(t, rule.Conditions.Satisfied(&RequestInfo{SourceIP: net.ParseIP("10.1.2.3"), AuthMode: "jwt", Time: night}))
+
+	// Unknown request attributes only satisfy conditions of deny rules
+	assert.False(t, rule.Conditions.Satisfied(&RequestInfo{Time: night}))
+	assert.True(t, rule.Conditions.MaySatisfy(&RequestInfo{Time: night}))
+	assert.False(t, rule.Conditions.MaySatisfy(&RequestInfo{Time: day}))
+}
+
+func TestApplyDenyRules(t *testing.T) {
+	permissions := ApplyDenyRules([]Permission{ReadKey, DestroyKey, DestroySecret, SignKey, "!destroy:*", "!sign:keys?auth_mode=jwt"})
+
+	assert.Equal(t, []Permission{ReadKey, SignKey, "!destroy:*", "!sign:keys?auth_mode=jwt"}, permissions)
+}
+
+func TestUngrantedPermission(t *testing.T) {
+	held := []Permission{ReadKey, SignKey, DestroyKey, "!destroy:keys?cidr=10.0.0.0/8"}
+
+	_, ok := UngrantedPermission(held, []Permission{ReadKey, "sign:keys?auth_mode=tls", "!read:*", "read:*"})
+	assert.False(t, ok)
+
+	p, ok := UngrantedPermission(held, []Permission{"*:keys?auth_mode=tls"})
+	assert.True(t, ok)
+	assert.Equal(t, Permission("*:keys?auth_mode=tls"), p)
+
+	p, ok = UngrantedPermission(held, []Permission{DestroyKey})
+	assert.True(t, ok)
+	assert.Equal(t, DestroyKey, p)
+}
@@ -13,6 +13,9 @@ func TestListWildcardPermission(t *testing.T) {
 	list = ListWildcardPermission("read:*")
 	assert.Equal(t, list, []Permission{ReadSecret, ReadKey, ReadEth, ReadAlias})
 
+	list = ListWildcardPermission("*:api-keys")
+	assert.Empty(t, list)
+
 	list = ListWildcardPermission("*:ethereum")
 	assert.Equal(t, list, []Permission{ReadEth, WriteEth, DeleteEth, DestroyEth, SignEth, EncryptEth})
 }
@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// RequestInfo holds the attributes of a request evaluated by permission conditions
+type RequestInfo struct {
+	SourceIP net.IP
+	AuthMode string
+	Time     time.Time
+}
+
+type requestInfoKey struct{}
+
+func RequestInfoFromContext(ctx context.Context) *RequestInfo {
+	if ctx == nil {
+		return nil
+	}
+	if reqInfo, ok := ctx.Value(requestInfoKey{}).(*RequestInfo); ok {
+		return reqInfo
+	}
+	return nil
+}
+
+func WithRequestInfo(ctx context.Context, reqInfo *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, reqInfo)
+}
@@ -1,7 +1,11 @@
 package entities
 
 type Role struct {
-	Name        string
+	Name string
+	// Tenant scopes the role to the users of a tenant, roles without tenant are global
+	Tenant string
+	// Extends lists the roles whose permissions are inherited, they are looked up in the role tenant then globally
+	Extends     []string
 	Permissions []Permission
 }
 
@@ -1,5 +1,7 @@
 package entities
 
 type RoleSpecs struct {
+	Tenant      string       `json:"tenant"`
+	Extends     []string     `json:"extends"`
 	Permissions []Permission `json:"permission"`
 }
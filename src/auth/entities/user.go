@@ -1,16 +1,29 @@
 package entities
 
+// Authentication modes recorded in UserInfo.AuthMode
+const (
+	APIKeyAuthMode = "apikey"
+	JWTAuthMode    = "jwt"
+	TLSAuthMode    = "tls"
+)
+
 // UserClaims represent raw claims extracted from an authentication method
 type UserClaims struct {
 	Tenant      string
 	Permissions []string
 	Roles       []string
+
+	// Username is optional, when empty it is extracted from the tenant with the format "tenant|username"
+	Username string
 }
 
 type UserInfo struct {
-	// AuthMode records the mode that succeeded to Authenticate the request ('tls', 'api-key', 'oidc' or '')
+	// AuthMode records the mode that succeeded to Authenticate the request (APIKeyAuthMode, JWTAuthMode, TLSAuthMode or '')
 	AuthMode string
 
+	// Issuer of the token that authenticated the user in jwt mode, empty for opaque tokens and other modes
+	Issuer string
+
 	// Tenant belonged by the user
 	Tenant string
 
@@ -26,7 +39,7 @@ type UserInfo struct {
 
 func NewWildcardUser() *UserInfo {
 	return &UserInfo{
-		Permissions: ListPermissions(),
+		Permissions: append(ListPermissions(), ListAdminPermissions()...),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	entities "github.com/consensys/quorum-key-manager/src/auth/entities"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockAuthenticator is a mock of Authenticator interface
@@ -159,17 +160,31 @@ func (m *MockRoles) EXPECT() *MockRolesMockRecorder {
 }
 
 // Create mocks base method
-func (m *MockRoles) Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) error {
+func (m *MockRoles) Create(ctx context.Context, name, tenant string, extends []string, permissions []entities.Permission, userInfo *entities.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, name, permissions, userInfo)
+	ret := m.ctrl.Call(m, "Create", ctx, name, tenant, extends, permissions, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create
-func (mr *MockRolesMockRecorder) Create(ctx, name, permissions, userInfo interface{}) *gomock.Call {
+func (mr *MockRolesMockRecorder) Create(ctx, name, tenant, extends, permissions, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoles)(nil).Create), ctx, name, permissions, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoles)(nil).Create), ctx, name, tenant, extends, permissions, userInfo)
+}
+
+// Delete mocks base method
+func (m *MockRoles) Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRolesMockRecorder) Delete(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoles)(nil).Delete), ctx, name, userInfo)
 }
 
 // Get mocks base method
@@ -188,10 +203,10 @@ func (mr *MockRolesMockRecorder) Get(ctx, name, userInfo interface{}) *gomock.Ca
 }
 
 // List mocks base method
-func (m *MockRoles) List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error) {
+func (m *MockRoles) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.Role, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", ctx, userInfo)
-	ret0, _ := ret[0].([]string)
+	ret0, _ := ret[0].([]*entities.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -202,6 +217,21 @@ func (mr *MockRolesMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoles)(nil).List), ctx, userInfo)
 }
 
+// RolePermissions mocks base method
+func (m *MockRoles) RolePermissions(ctx context.Context, tenant string, roles []string) ([]entities.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RolePermissions", ctx, tenant, roles)
+	ret0, _ := ret[0].([]entities.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RolePermissions indicates an expected call of RolePermissions
+func (mr *MockRolesMockRecorder) RolePermissions(ctx, tenant, roles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolePermissions", reflect.TypeOf((*MockRoles)(nil).RolePermissions), ctx, tenant, roles)
+}
+
 // UserPermissions mocks base method
 func (m *MockRoles) UserPermissions(ctx context.Context, userInfo *entities.UserInfo) []entities.Permission {
 	m.ctrl.T.Helper()
@@ -215,3 +245,282 @@ func (mr *MockRolesMockRecorder) UserPermissions(ctx, userInfo interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserPermissions", reflect.TypeOf((*MockRoles)(nil).UserPermissions), ctx, userInfo)
 }
+
+// GrantedRoles mocks base method
+func (m *MockRoles) GrantedRoles(ctx context.Context, userInfo *entities.UserInfo) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantedRoles", ctx, userInfo)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GrantedRoles indicates an expected call of GrantedRoles
+func (mr *MockRolesMockRecorder) GrantedRoles(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantedRoles", reflect.TypeOf((*MockRoles)(nil).GrantedRoles), ctx, userInfo)
+}
+
+// MockAPIKeys is a mock of APIKeys interface
+type MockAPIKeys struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeysMockRecorder
+}
+
+// MockAPIKeysMockRecorder is the mock recorder for MockAPIKeys
+type MockAPIKeysMockRecorder struct {
+	mock *MockAPIKeys
+}
+
+// NewMockAPIKeys creates a new mock instance
+func NewMockAPIKeys(ctrl *gomock.Controller) *MockAPIKeys {
+	mock := &MockAPIKeys{ctrl: ctrl}
+	mock.recorder = &MockAPIKeysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAPIKeys) EXPECT() *MockAPIKeysMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockAPIKeys) Create(ctx context.Context, name, tenant string, roles []string, permissions []entities.Permission, expiresAt time.Time, userInfo *entities.UserInfo) (*entities.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name, tenant, roles, permissions, expiresAt, userInfo)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create
+func (mr *MockAPIKeysMockRecorder) Create(ctx, name, tenant, roles, permissions, expiresAt, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeys)(nil).Create), ctx, name, tenant, roles, permissions, expiresAt, userInfo)
+}
+
+// Delete mocks base method
+func (m *MockAPIKeys) Delete(ctx context.Context, id string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockAPIKeysMockRecorder) Delete(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAPIKeys)(nil).Delete), ctx, id, userInfo)
+}
+
+// Get mocks base method
+func (m *MockAPIKeys) Get(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockAPIKeysMockRecorder) Get(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAPIKeys)(nil).Get), ctx, id, userInfo)
+}
+
+// List mocks base method
+func (m *MockAPIKeys) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userInfo)
+	ret0, _ := ret[0].([]*entities.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockAPIKeysMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeys)(nil).List), ctx, userInfo)
+}
+
+// Rotate mocks base method
+func (m *MockAPIKeys) Rotate(ctx context.Context, id string, expiresAt time.Time, userInfo *entities.UserInfo) (*entities.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, expiresAt, userInfo)
+	ret0, _ := ret[0].(*entities.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Rotate indicates an expected call of Rotate
+func (mr *MockAPIKeysMockRecorder) Rotate(ctx, id, expiresAt, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockAPIKeys)(nil).Rotate), ctx, id, expiresAt, userInfo)
+}
+
+// MockGrants is a mock of Grants interface
+type MockGrants struct {
+	ctrl     *gomock.Controller
+	recorder *MockGrantsMockRecorder
+}
+
+// MockGrantsMockRecorder is the mock recorder for MockGrants
+type MockGrantsMockRecorder struct {
+	mock *MockGrants
+}
+
+// NewMockGrants creates a new mock instance
+func NewMockGrants(ctrl *gomock.Controller) *MockGrants {
+	mock := &MockGrants{ctrl: ctrl}
+	mock.recorder = &MockGrantsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGrants) EXPECT() *MockGrantsMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method
+func (m *MockGrants) Approve(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve
+func (mr *MockGrantsMockRecorder) Approve(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockGrants)(nil).Approve), ctx, id, userInfo)
+}
+
+// Get mocks base method
+func (m *MockGrants) Get(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockGrantsMockRecorder) Get(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGrants)(nil).Get), ctx, id, userInfo)
+}
+
+// List mocks base method
+func (m *MockGrants) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, userInfo)
+	ret0, _ := ret[0].([]*entities.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockGrantsMockRecorder) List(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGrants)(nil).List), ctx, userInfo)
+}
+
+// Reject mocks base method
+func (m *MockGrants) Reject(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject
+func (mr *MockGrantsMockRecorder) Reject(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockGrants)(nil).Reject), ctx, id, userInfo)
+}
+
+// Request mocks base method
+func (m *MockGrants) Request(ctx context.Context, role, reason string, duration time.Duration, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, role, reason, duration, userInfo)
+	ret0, _ := ret[0].(*entities.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Request indicates an expected call of Request
+func (mr *MockGrantsMockRecorder) Request(ctx, role, reason, duration, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockGrants)(nil).Request), ctx, role, reason, duration, userInfo)
+}
+
+// Revoke mocks base method
+func (m *MockGrants) Revoke(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, userInfo)
+	ret0, _ := ret[0].(*entities.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke
+func (mr *MockGrantsMockRecorder) Revoke(ctx, id, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockGrants)(nil).Revoke), ctx, id, userInfo)
+}
+
+// MockAccess is a mock of Access interface
+type MockAccess struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessMockRecorder
+}
+
+// MockAccessMockRecorder is the mock recorder for MockAccess
+type MockAccessMockRecorder struct {
+	mock *MockAccess
+}
+
+// NewMockAccess creates a new mock instance
+func NewMockAccess(ctrl *gomock.Controller) *MockAccess {
+	mock := &MockAccess{ctrl: ctrl}
+	mock.recorder = &MockAccessMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAccess) EXPECT() *MockAccessMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method
+func (m *MockAccess) Check(ctx context.Context, op *entities.Operation, storeName string, userInfo *entities.UserInfo) (*entities.AccessDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, op, storeName, userInfo)
+	ret0, _ := ret[0].(*entities.AccessDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check
+func (mr *MockAccessMockRecorder) Check(ctx, op, storeName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockAccess)(nil).Check), ctx, op, storeName, userInfo)
+}
+
+// Me mocks base method
+func (m *MockAccess) Me(ctx context.Context, userInfo *entities.UserInfo) (*entities.UserAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Me", ctx, userInfo)
+	ret0, _ := ret[0].(*entities.UserAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Me indicates an expected call of Me
+func (mr *MockAccessMockRecorder) Me(ctx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Me", reflect.TypeOf((*MockAccess)(nil).Me), ctx, userInfo)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"crypto/tls"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 )
@@ -24,8 +25,56 @@ type Authorizator interface {
 
 // Roles allows managing permissions and roles
 type Roles interface {
-	Create(ctx context.Context, name string, permissions []entities.Permission, userInfo *entities.UserInfo) error
+	// Create creates a role, scoped to a tenant when set. Roles extending, directly or not, themselves are rejected
+	Create(ctx context.Context, name, tenant string, extends []string, permissions []entities.Permission, userInfo *entities.UserInfo) error
+	// Get gets a role of the user tenant, or a global role
 	Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities.Role, error)
-	List(ctx context.Context, userInfo *entities.UserInfo) ([]string, error)
+	// List lists the global roles and the roles of the user tenant
+	List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.Role, error)
+	// Delete deletes a role of the user tenant, or a global role for users without tenant
+	Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error
+	// RolePermissions returns the permissions of roles, including inherited ones, as seen from a tenant
+	RolePermissions(ctx context.Context, tenant string, roles []string) ([]entities.Permission, error)
+	// UserPermissions returns the permissions of a user and of its roles, including inherited ones
 	UserPermissions(ctx context.Context, userInfo *entities.UserInfo) []entities.Permission
+	// GrantedRoles returns the roles currently granted to the user through approved grants
+	GrantedRoles(ctx context.Context, userInfo *entities.UserInfo) []string
+}
+
+// APIKeys allows managing API keys stored in database
+type APIKeys interface {
+	// Create creates an API key and returns it along with its value, which cannot be retrieved afterwards
+	Create(ctx context.Context, name, tenant string, roles []string, permissions []entities.Permission, expiresAt time.Time, userInfo *entities.UserInfo) (*entities.APIKey, string, error)
+	// Get gets an API key
+	Get(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.APIKey, error)
+	// List lists the API keys of the user tenant
+	List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.APIKey, error)
+	// Rotate replaces the value of an API key and returns the new value, the previous value is revoked immediately
+	Rotate(ctx context.Context, id string, expiresAt time.Time, userInfo *entities.UserInfo) (*entities.APIKey, string, error)
+	// Delete revokes an API key
+	Delete(ctx context.Context, id string, userInfo *entities.UserInfo) error
+}
+
+// Grants allows managing roles temporarily granted to users
+type Grants interface {
+	// Request requests a role for a duration, the grant is effective once approved
+	Request(ctx context.Context, role, reason string, duration time.Duration, userInfo *entities.UserInfo) (*entities.Grant, error)
+	// Get gets a grant of the user, or any grant of the user tenant for approvers
+	Get(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error)
+	// List lists the grants of the user, or all the grants of the user tenant for approvers
+	List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.Grant, error)
+	// Approve approves a pending grant requested by another user, it expires after its requested duration
+	Approve(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error)
+	// Reject rejects a pending grant
+	Reject(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error)
+	// Revoke revokes a pending or approved grant, by its requester or an approver
+	Revoke(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error)
+}
+
+// Access explains the authorizations of users
+type Access interface {
+	// Me returns the resolved permissions of the user and the stores, nodes and alias registries it can access
+	Me(ctx context.Context, userInfo *entities.UserInfo) (*entities.UserAccess, error)
+	// Check explains whether the user is allowed to perform the operation, on the store when set
+	Check(ctx context.Context, op *entities.Operation, storeName string, userInfo *entities.UserInfo) (*entities.AccessDecision, error)
 }
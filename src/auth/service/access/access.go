@@ -0,0 +1,61 @@
+package access
+
+import (
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/src/aliases"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	storeentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+var storeTypes = []string{storeentities.SecretStoreType, storeentities.KeyStoreType, storeentities.EthereumStoreType}
+
+// storeTypeByResource indicates the type of store operations on a resource apply to
+var storeTypeByResource = map[entities.OpResource]string{
+	entities.ResourceSecret:     storeentities.SecretStoreType,
+	entities.ResourceKey:        storeentities.KeyStoreType,
+	entities.ResourceEthAccount: storeentities.EthereumStoreType,
+}
+
+type Access struct {
+	roles      auth.Roles
+	stores     stores.Stores
+	nodes      nodes.Nodes
+	registries aliases.Registries
+	logger     log.Logger
+}
+
+var _ auth.Access = &Access{}
+
+func New(rolesService auth.Roles, storesService stores.Stores, nodesService nodes.Nodes, registriesService aliases.Registries, logger log.Logger) *Access {
+	return &Access{
+		roles:      rolesService,
+		stores:     storesService,
+		nodes:      nodesService,
+		registries: registriesService,
+		logger:     logger,
+	}
+}
+
+// grants indicates whether the permissions include the permission, directly or through a wildcard
+func grants(permissions []entities.Permission, permission entities.Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+
+		if strings.Contains(string(p), "*") && !entities.IsPermissionRule(p) {
+			for _, expanded := range entities.ListWildcardPermission(string(p)) {
+				if expanded == permission {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,167 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasmock "github.com/consensys/quorum-key-manager/src/aliases/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	aliasentities "github.com/consensys/quorum-key-manager/src/entities"
+	testutils "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	nodesmock "github.com/consensys/quorum-key-manager/src/nodes/mock"
+	storesmock "github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRoles := mock.NewMockRoles(ctrl)
+	mockStores := storesmock.NewMockStores(ctrl)
+	mockNodes := nodesmock.NewMockNodes(ctrl)
+	mockRegistries := aliasmock.NewMockRegistries(ctrl)
+	service := New(mockRoles, mockStores, mockNodes, mockRegistries, testutils.NewMockLogger(ctrl))
+
+	userInfo := &entities.UserInfo{
+		AuthMode:    "jwt",
+		Tenant:      "tenant1",
+		Username:    "alice",
+		Roles:       []string{"signer", "unknown"},
+		Permissions: []entities.Permission{"read:*"},
+	}
+
+	mockStores.EXPECT().List(gomock.Any(), "secret", userInfo).Return([]string{"secret-store"}, nil).AnyTimes()
+	mockStores.EXPECT().List(gomock.Any(), "key", userInfo).Return([]string{"key-store"}, nil).AnyTimes()
+	mockStores.EXPECT().List(gomock.Any(), "ethereum", userInfo).Return([]string{}, nil).AnyTimes()
+
+	t.Run("should return the user access", func(t *testing.T) {
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"sign:keys", "read:*", "read:keys", "sign:keys"})
+		mockNodes.EXPECT().List(gomock.Any(), userInfo).Return([]string{"besu"}, nil)
+		mockRegistries.EXPECT().List(gomock.Any(), userInfo).Return([]*aliasentities.AliasRegistry{{Name: "registry"}}, nil)
+
+		userAccess, err := service.Me(ctx, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, userInfo, userAccess.UserInfo)
+		assert.Equal(t, []entities.Permission{"read:keys", "sign:keys"}, userAccess.Permissions)
+		assert.Equal(t, []*entities.StoreAccess{{Name: "key-store", Type: "key"}, {Name: "secret-store", Type: "secret"}}, userAccess.Stores)
+		assert.Equal(t, []string{"besu"}, userAccess.Nodes)
+		assert.Equal(t, []string{"registry"}, userAccess.AliasRegistries)
+	})
+
+	t.Run("should return no alias registry if the user cannot read aliases", func(t *testing.T) {
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{})
+		mockNodes.EXPECT().List(gomock.Any(), userInfo).Return(nil, nil)
+		mockRegistries.EXPECT().List(gomock.Any(), userInfo).Return(nil, errors.ForbiddenError("error"))
+
+		userAccess, err := service.Me(ctx, userInfo)
+
+		require.NoError(t, err)
+		assert.Empty(t, userAccess.AliasRegistries)
+	})
+
+	t.Run("should allow an operation granted by a role on an accessible store", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"signer"}).Return([]entities.Permission{"*:keys"}, nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"unknown"}).Return(nil, errors.NotFoundError("error"))
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"read:*", "sign:keys"})
+		mockRoles.EXPECT().GrantedRoles(gomock.Any(), userInfo).Return(nil)
+
+		decision, err := service.Check(ctx, &entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}, "key-store", userInfo)
+
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, entities.SignKey, decision.Permission)
+		assert.Equal(t, []string{
+			"permission sign:keys is granted by role signer",
+			"role unknown does not exist",
+			"store key-store is accessible by the user",
+		}, decision.Reasons)
+	})
+
+	t.Run("should deny an operation not granted to the user", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", gomock.Any()).Return([]entities.Permission{}, nil).Times(2)
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"read:*"})
+		mockRoles.EXPECT().GrantedRoles(gomock.Any(), userInfo).Return(nil)
+
+		decision, err := service.Check(ctx, &entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceSecret}, "", userInfo)
+
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Equal(t, []string{"permission destroy:secrets is granted neither to the user nor to its roles"}, decision.Reasons)
+	})
+
+	t.Run("should allow an operation granted by an approved grant", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"signer"}).Return([]entities.Permission{"sign:keys"}, nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"unknown"}).Return(nil, errors.NotFoundError("error"))
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"destroyer"}).Return([]entities.Permission{"destroy:keys"}, nil)
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"read:*", "sign:keys", "destroy:keys"})
+		mockRoles.EXPECT().GrantedRoles(gomock.Any(), userInfo).Return([]string{"destroyer"})
+
+		decision, err := service.Check(ctx, &entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceKey}, "", userInfo)
+
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, []string{
+			"role unknown does not exist",
+			"permission destroy:keys is granted by granted role destroyer",
+		}, decision.Reasons)
+	})
+
+	t.Run("should deny an operation on an inaccessible or mismatching store", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", gomock.Any()).Return([]entities.Permission{}, nil).Times(4)
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"read:*"}).Times(2)
+		mockRoles.EXPECT().GrantedRoles(gomock.Any(), userInfo).Return(nil).Times(2)
+
+		decision, err := service.Check(ctx, &entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}, "secret-store", userInfo)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Contains(t, decision.Reasons, "store secret-store is a secret store, operations on keys require a key store")
+
+		decision, err = service.Check(ctx, &entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}, "other-store", userInfo)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Contains(t, decision.Reasons, "store other-store does not exist or is not accessible by tenant tenant1")
+	})
+
+	t.Run("should deny an operation matched by a deny rule", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"signer"}).Return([]entities.Permission{"*:keys", "!sign:*"}, nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"unknown"}).Return(nil, errors.NotFoundError("error"))
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"read:*", "sign:keys", "!sign:*"})
+		mockRoles.EXPECT().GrantedRoles(gomock.Any(), userInfo).Return(nil)
+
+		decision, err := service.Check(ctx, &entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}, "", userInfo)
+
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Contains(t, decision.Reasons, "permission sign:keys is denied by rule !sign:*")
+	})
+
+	t.Run("should evaluate conditional permissions against the request", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", gomock.Any()).Return([]entities.Permission{}, nil).Times(4)
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return([]entities.Permission{"destroy:keys?auth_mode=tls"}).Times(2)
+		mockRoles.EXPECT().GrantedRoles(gomock.Any(), userInfo).Return(nil).Times(2)
+		op := &entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceKey}
+
+		decision, err := service.Check(entities.WithRequestInfo(ctx, &entities.RequestInfo{AuthMode: "tls"}), op, "", userInfo)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, []string{"permission destroy:keys is granted by conditional permission destroy:keys?auth_mode=tls"}, decision.Reasons)
+
+		decision, err = service.Check(entities.WithRequestInfo(ctx, &entities.RequestInfo{AuthMode: "jwt"}), op, "", userInfo)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Contains(t, decision.Reasons, "conditions of permission destroy:keys?auth_mode=tls are not met by this request")
+	})
+
+	t.Run("should fail with InvalidParameterError on unknown operations", func(t *testing.T) {
+		_, err := service.Check(ctx, &entities.Operation{Action: "fly", Resource: entities.ResourceKey}, "", userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -0,0 +1,147 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Access) Check(ctx context.Context, op *entities.Operation, storeName string, userInfo *entities.UserInfo) (*entities.AccessDecision, error) {
+	permission := entities.Permission(fmt.Sprintf("%s:%s", op.Action, op.Resource))
+	logger := s.logger.With("permission", permission, "store", storeName, "tenant", userInfo.Tenant, "username", userInfo.Username)
+
+	if !grants(append(entities.ListPermissions(), entities.ListAdminPermissions()...), permission) {
+		errMessage := fmt.Sprintf("unknown operation %s", permission)
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	decision := &entities.AccessDecision{Permission: permission, Reasons: []string{}}
+
+	// The decision is taken on the resolved permissions of the user, as the authorizator does, the permissions
+	// of its roles and grants are only inspected to explain it
+	userPermissions := s.roles.UserPermissions(ctx, userInfo)
+	decision.Allowed = grants(userPermissions, permission)
+
+	if grants(userInfo.Permissions, permission) {
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("permission %s is granted to the user", permission))
+	}
+
+	for _, role := range userInfo.Roles {
+		rolePermissions, err := s.roles.RolePermissions(ctx, userInfo.Tenant, []string{role})
+		if err != nil {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("role %s does not exist", role))
+			continue
+		}
+
+		if grants(rolePermissions, permission) {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("permission %s is granted by role %s", permission, role))
+		}
+	}
+
+	for _, role := range s.roles.GrantedRoles(ctx, userInfo) {
+		rolePermissions, err := s.roles.RolePermissions(ctx, userInfo.Tenant, []string{role})
+		if err != nil {
+			continue
+		}
+
+		if grants(rolePermissions, permission) {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("permission %s is granted by granted role %s", permission, role))
+		}
+	}
+
+	denied := s.checkRules(ctx, op, permission, userPermissions, decision)
+
+	if !decision.Allowed && !denied {
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("permission %s is granted neither to the user nor to its roles", permission))
+	}
+	if denied {
+		decision.Allowed = false
+	}
+
+	if storeName != "" {
+		reason, err := s.checkStore(ctx, op.Resource, storeName, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to check store access")
+			return nil, err
+		}
+
+		if reason != "" {
+			decision.Allowed = false
+			decision.Reasons = append(decision.Reasons, reason)
+		} else {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("store %s is accessible by the user", storeName))
+		}
+	}
+
+	logger.Debug("access checked successfully", "allowed", decision.Allowed)
+	return decision, nil
+}
+
+// checkRules evaluates deny rules and conditional permissions against the request, it returns whether the operation is denied
+func (s *Access) checkRules(ctx context.Context, op *entities.Operation, permission entities.Permission, userPermissions []entities.Permission, decision *entities.AccessDecision) bool {
+	reqInfo := entities.RequestInfoFromContext(ctx)
+
+	denied := false
+	for _, p := range userPermissions {
+		if !entities.IsPermissionRule(p) {
+			continue
+		}
+
+		rule, err := entities.ParsePermissionRule(p)
+		if err != nil || !rule.Matches(op.Action, op.Resource) {
+			continue
+		}
+
+		switch {
+		case rule.Deny && rule.Conditions.MaySatisfy(reqInfo):
+			denied = true
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("permission %s is denied by rule %s", permission, p))
+		case rule.Deny:
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("deny rule %s does not apply to this request", p))
+		case rule.Conditions.Satisfied(reqInfo):
+			decision.Allowed = true
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("permission %s is granted by conditional permission %s", permission, p))
+		default:
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("conditions of permission %s are not met by this request", p))
+		}
+	}
+
+	return denied
+}
+
+// checkStore returns the reason why the user cannot perform operations on the resource of the store, if any
+func (s *Access) checkStore(ctx context.Context, resource entities.OpResource, storeName string, userInfo *entities.UserInfo) (string, error) {
+	expectedType, ok := storeTypeByResource[resource]
+	if !ok {
+		return "", errors.InvalidParameterError("operations on %s do not apply to stores", resource)
+	}
+
+	for _, storeType := range storeTypes {
+		storeNames, err := s.stores.List(ctx, storeType, userInfo)
+		if err != nil {
+			return "", errors.FromError(err).SetMessage("failed to list stores")
+		}
+
+		for _, name := range storeNames {
+			if name != storeName {
+				continue
+			}
+
+			if storeType != expectedType {
+				return fmt.Sprintf("store %s is a %s store, operations on %s require a %s store", storeName, storeType, resource, expectedType), nil
+			}
+
+			return "", nil
+		}
+	}
+
+	// Stores not accessible by the tenant are reported as not found to avoid disclosing them
+	if userInfo.Tenant == "" {
+		return fmt.Sprintf("store %s does not exist or is restricted to tenants", storeName), nil
+	}
+
+	return fmt.Sprintf("store %s does not exist or is not accessible by tenant %s", storeName, userInfo.Tenant), nil
+}
@@ -0,0 +1,68 @@
+package access
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Access) Me(ctx context.Context, userInfo *entities.UserInfo) (*entities.UserAccess, error) {
+	logger := s.logger.With("tenant", userInfo.Tenant, "username", userInfo.Username)
+
+	userAccess := &entities.UserAccess{
+		UserInfo:        userInfo,
+		Permissions:     []entities.Permission{},
+		Stores:          []*entities.StoreAccess{},
+		Nodes:           []string{},
+		AliasRegistries: []string{},
+	}
+
+	// Wildcards are already expanded by the roles service, deny rules and conditional permissions are listed as is
+	seen := map[entities.Permission]bool{}
+	for _, p := range s.roles.UserPermissions(ctx, userInfo) {
+		if !seen[p] && (entities.IsPermissionRule(p) || !strings.Contains(string(p), "*")) {
+			seen[p] = true
+			userAccess.Permissions = append(userAccess.Permissions, p)
+		}
+	}
+	sort.Slice(userAccess.Permissions, func(i, j int) bool { return userAccess.Permissions[i] < userAccess.Permissions[j] })
+
+	for _, storeType := range storeTypes {
+		storeNames, err := s.stores.List(ctx, storeType, userInfo)
+		if err != nil {
+			errMessage := "failed to list stores"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+
+		for _, storeName := range storeNames {
+			userAccess.Stores = append(userAccess.Stores, &entities.StoreAccess{Name: storeName, Type: storeType})
+		}
+	}
+	sort.Slice(userAccess.Stores, func(i, j int) bool { return userAccess.Stores[i].Name < userAccess.Stores[j].Name })
+
+	nodeNames, err := s.nodes.List(ctx, userInfo)
+	if err != nil {
+		errMessage := "failed to list nodes"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+	userAccess.Nodes = append(userAccess.Nodes, nodeNames...)
+
+	// Users not allowed to read aliases do not access any registry
+	registries, err := s.registries.List(ctx, userInfo)
+	if err != nil && !errors.IsForbiddenError(err) {
+		errMessage := "failed to list alias registries"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+	for _, registry := range registries {
+		userAccess.AliasRegistries = append(userAccess.AliasRegistries, registry.Name)
+	}
+
+	logger.Debug("user access retrieved successfully")
+	return userAccess, nil
+}
@@ -0,0 +1,100 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/database"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+const (
+	apiKeyIDSize    = 16
+	apiKeyValueSize = 32
+)
+
+type APIKeys struct {
+	db     database.APIKeys
+	roles  auth.Roles
+	logger log.Logger
+}
+
+var _ auth.APIKeys = &APIKeys{}
+
+func New(db database.APIKeys, rolesService auth.Roles, logger log.Logger) *APIKeys {
+	return &APIKeys{
+		db:     db,
+		roles:  rolesService,
+		logger: logger,
+	}
+}
+
+// Hash returns the hash under which API keys are stored, the same format is used by the CSV API key file
+func Hash(value []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(value))
+}
+
+func newAPIKeyValue() (string, error) {
+	value := make([]byte, apiKeyValueSize)
+	if _, err := rand.Read(value); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(value), nil
+}
+
+func newAPIKeyID() (string, error) {
+	id := make([]byte, apiKeyIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+// checkGrant prevents users from creating API keys with more privileges than they have, roles are resolved in the API key tenant
+func (s *APIKeys) checkGrant(ctx context.Context, tenant string, roles []string, permissions []entities.Permission, userInfo *entities.UserInfo, logger log.Logger) error {
+	err := entities.ValidatePermissions(permissions)
+	if err != nil {
+		errMessage := "invalid API key permissions"
+		logger.WithError(err).Error(errMessage)
+		return errors.InvalidParameterError("%s: %v", errMessage, err)
+	}
+
+	rolePermissions, err := s.roles.RolePermissions(ctx, tenant, roles)
+	if err != nil {
+		errMessage := "API key roles do not exist"
+		logger.WithError(err).Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	requested := append(expandPermissions(permissions), rolePermissions...)
+	if p, ok := entities.UngrantedPermission(s.roles.UserPermissions(ctx, userInfo), requested); ok {
+		errMessage := "cannot grant permissions the user does not have"
+		logger.With("permission", p).Error(errMessage)
+		return errors.ForbiddenError(errMessage)
+	}
+
+	return nil
+}
+
+func expandPermissions(permissions []entities.Permission) []entities.Permission {
+	var expanded []entities.Permission
+	for _, p := range permissions {
+		if strings.Contains(string(p), "*") && !entities.IsPermissionRule(p) {
+			expanded = append(expanded, entities.ListWildcardPermission(string(p))...)
+		} else {
+			expanded = append(expanded, p)
+		}
+	}
+
+	return expanded
+}
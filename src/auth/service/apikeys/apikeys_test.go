@@ -0,0 +1,127 @@
+package apikeys
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	dbmock "github.com/consensys/quorum-key-manager/src/auth/database/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	testutils "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockDB := dbmock.NewMockAPIKeys(ctrl)
+	mockRoles := mock.NewMockRoles(ctrl)
+	service := New(mockDB, mockRoles, testutils.NewMockLogger(ctrl))
+
+	userInfo := &entities.UserInfo{
+		Tenant:      "tenant1",
+		Username:    "alice",
+		Permissions: []entities.Permission{entities.WriteAPIKey, entities.ReadAPIKey, entities.DeleteAPIKey, entities.SignKey, entities.ReadKey},
+	}
+	mockRoles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions).AnyTimes()
+
+	t.Run("should create an API key successfully", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"signer"}).Return([]entities.Permission{entities.SignKey}, nil)
+		mockDB.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, apiKey *entities.APIKey) (*entities.APIKey, error) {
+			assert.Equal(t, "tenant1", apiKey.Tenant)
+			assert.Equal(t, "alice", apiKey.Owner)
+			assert.Len(t, apiKey.Hash, 64)
+			assert.NotEmpty(t, apiKey.ID)
+			return apiKey, nil
+		})
+
+		apiKey, value, err := service.Create(ctx, "ci", "", []string{"signer"}, []entities.Permission{"read:keys"}, expiresAt, userInfo)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, value)
+		assert.Equal(t, Hash([]byte(value)), apiKey.Hash)
+	})
+
+	t.Run("should fail with ForbiddenError if permissions exceed the user permissions", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", nil).Return(nil, nil)
+
+		_, _, err := service.Create(ctx, "ci", "", nil, []entities.Permission{"*:keys"}, time.Time{}, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if role permissions exceed the user permissions", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"admin"}).Return(append([]entities.Permission{"*:*"}, entities.ListPermissions()...), nil)
+
+		_, _, err := service.Create(ctx, "ci", "", []string{"admin"}, nil, time.Time{}, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if tenant is not the user tenant", func(t *testing.T) {
+		_, _, err := service.Create(ctx, "ci", "tenant2", nil, nil, time.Time{}, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if a role does not exist", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"unknown"}).Return(nil, errors.NotFoundError("error"))
+
+		_, _, err := service.Create(ctx, "ci", "", []string{"unknown"}, nil, time.Time{}, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if expiry is in the past", func(t *testing.T) {
+		_, _, err := service.Create(ctx, "ci", "", nil, nil, time.Now().Add(-time.Hour), userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should rotate an API key successfully", func(t *testing.T) {
+		apiKey := &entities.APIKey{ID: "id", Hash: "previous-hash", Tenant: "tenant1", Roles: []string{"signer"}}
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(apiKey, nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"signer"}).Return([]entities.Permission{entities.SignKey}, nil)
+		mockDB.EXPECT().Update(gomock.Any(), apiKey).Return(apiKey, nil)
+
+		rotated, value, err := service.Rotate(ctx, "id", time.Time{}, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, Hash([]byte(value)), rotated.Hash)
+	})
+
+	t.Run("should fail to rotate with ForbiddenError if the key permissions exceed the user permissions", func(t *testing.T) {
+		apiKey := &entities.APIKey{ID: "id", Hash: "previous-hash", Tenant: "tenant1", Permissions: []entities.Permission{entities.DestroyKey}}
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(apiKey, nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", nil).Return(nil, nil)
+
+		_, _, err := service.Rotate(ctx, "id", time.Time{}, userInfo)
+
+		assert.True(t, errors.IsForbiddenError(err))
+		assert.Equal(t, "previous-hash", apiKey.Hash)
+	})
+
+	t.Run("should revoke an API key of the user tenant", func(t *testing.T) {
+		mockDB.EXPECT().Delete(gomock.Any(), "id", "tenant1").Return(nil)
+
+		err := service.Delete(ctx, "id", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with ForbiddenError if user cannot read API keys", func(t *testing.T) {
+		guest := &entities.UserInfo{Tenant: "tenant1"}
+		mockRoles.EXPECT().UserPermissions(gomock.Any(), guest).Return([]entities.Permission{})
+
+		_, err := service.List(ctx, guest)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
@@ -0,0 +1,80 @@
+package apikeys
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *APIKeys) Create(
+	ctx context.Context,
+	name, tenant string,
+	roles []string,
+	permissions []entities.Permission,
+	expiresAt time.Time,
+	userInfo *entities.UserInfo,
+) (*entities.APIKey, string, error) {
+	logger := s.logger.With("name", name, "tenant", tenant)
+
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if userInfo.Tenant != "" {
+		if tenant != "" && tenant != userInfo.Tenant {
+			errMessage := "cannot create API keys for another tenant"
+			logger.Error(errMessage)
+			return nil, "", errors.ForbiddenError(errMessage)
+		}
+		tenant = userInfo.Tenant
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		errMessage := "API key expiry must be in the future"
+		logger.Error(errMessage)
+		return nil, "", errors.InvalidParameterError(errMessage)
+	}
+
+	err = s.checkGrant(ctx, tenant, roles, permissions, userInfo, logger)
+	if err != nil {
+		return nil, "", err
+	}
+
+	id, err := newAPIKeyID()
+	if err != nil {
+		errMessage := "failed to generate API key identifier"
+		logger.WithError(err).Error(errMessage)
+		return nil, "", errors.DependencyFailureError(errMessage)
+	}
+
+	value, err := newAPIKeyValue()
+	if err != nil {
+		errMessage := "failed to generate API key"
+		logger.WithError(err).Error(errMessage)
+		return nil, "", errors.DependencyFailureError(errMessage)
+	}
+
+	apiKey, err := s.db.Insert(ctx, &entities.APIKey{
+		ID:          id,
+		Name:        name,
+		Hash:        Hash([]byte(value)),
+		Owner:       userInfo.Username,
+		Tenant:      tenant,
+		Roles:       roles,
+		Permissions: permissions,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		errMessage := "failed to create API key"
+		logger.WithError(err).Error(errMessage)
+		return nil, "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("API key created successfully", "id", apiKey.ID)
+	return apiKey, value, nil
+}
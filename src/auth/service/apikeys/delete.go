@@ -0,0 +1,29 @@
+package apikeys
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *APIKeys) Delete(ctx context.Context, id string, userInfo *entities.UserInfo) error {
+	logger := s.logger.With("id", id)
+
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return err
+	}
+
+	err = s.db.Delete(ctx, id, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to delete API key"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("API key revoked successfully")
+	return nil
+}
@@ -0,0 +1,29 @@
+package apikeys
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *APIKeys) Get(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.APIKey, error) {
+	logger := s.logger.With("id", id)
+
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := s.db.FindOne(ctx, id, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to get API key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Debug("API key retrieved successfully")
+	return apiKey, nil
+}
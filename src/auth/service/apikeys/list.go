@@ -0,0 +1,27 @@
+package apikeys
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *APIKeys) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.APIKey, error) {
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.db.List(ctx, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to list API keys"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	s.logger.Debug("API keys listed successfully")
+	return apiKeys, nil
+}
@@ -0,0 +1,61 @@
+package apikeys
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (s *APIKeys) Rotate(ctx context.Context, id string, expiresAt time.Time, userInfo *entities.UserInfo) (*entities.APIKey, string, error) {
+	logger := s.logger.With("id", id)
+
+	resolver := authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceAPIKey})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		errMessage := "API key expiry must be in the future"
+		logger.Error(errMessage)
+		return nil, "", errors.InvalidParameterError(errMessage)
+	}
+
+	apiKey, err := s.db.FindOne(ctx, id, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to get API key"
+		logger.WithError(err).Error(errMessage)
+		return nil, "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Rotating a key issues a new value carrying its roles and permissions, which the user must still hold
+	err = s.checkGrant(ctx, apiKey.Tenant, apiKey.Roles, apiKey.Permissions, userInfo, logger)
+	if err != nil {
+		return nil, "", err
+	}
+
+	value, err := newAPIKeyValue()
+	if err != nil {
+		errMessage := "failed to generate API key"
+		logger.WithError(err).Error(errMessage)
+		return nil, "", errors.DependencyFailureError(errMessage)
+	}
+
+	apiKey.Hash = Hash([]byte(value))
+	if !expiresAt.IsZero() {
+		apiKey.ExpiresAt = expiresAt
+	}
+
+	apiKey, err = s.db.Update(ctx, apiKey)
+	if err != nil {
+		errMessage := "failed to rotate API key"
+		logger.WithError(err).Error(errMessage)
+		return nil, "", errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("API key rotated successfully")
+	return apiKey, value, nil
+}
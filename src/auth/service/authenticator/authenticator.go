@@ -5,43 +5,68 @@ import (
 	"crypto/sha256"
 	tls2 "crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/tls"
 	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/database"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	infratls "github.com/consensys/quorum-key-manager/src/infra/tls"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/identity"
 )
 
-const (
-	APIKeyAuthMode = "apikey"
-	JWTAuthMode    = "jwt"
-	TLSAuthMode    = "tls"
-)
+const lastUsedPrecision = time.Minute
 
 type Authenticator struct {
-	logger       log.Logger
-	jwtValidator jwt.Validator
-	apiKeyClaims map[string]*entities.UserClaims
-	rootCAs      *x509.CertPool
+	logger            log.Logger
+	jwtValidators     map[string]jwt.Validator
+	tokenIntrospector jwt.Validator
+	apiKeyClaims      map[string]*entities.UserClaims
+	apiKeys           database.APIKeys
+	rootCAs           *x509.CertPool
+	revocationChecker infratls.RevocationChecker
+	tlsMapping        *identity.Mapping
 }
 
 var _ auth.Authenticator = &Authenticator{}
 
-func New(jwtValidator jwt.Validator, apiKeyClaims map[string]*entities.UserClaims, rootCAs *x509.CertPool, logger log.Logger) *Authenticator {
+// New creates an Authenticator, jwtValidators are indexed by the issuer ("iss" claim) of the tokens they validate.
+// Opaque bearer tokens are validated by the tokenIntrospector when set, signed tokens from an unknown issuer are rejected.
+// API keys are looked up in apiKeyClaims first, then in the apiKeys database when set.
+// Client certificates are checked against rootCAs, then against the revocationChecker when set, and mapped to
+// user claims by tlsMapping, the subject being used when not set
+func New(
+	jwtValidators map[string]jwt.Validator,
+	tokenIntrospector jwt.Validator,
+	apiKeyClaims map[string]*entities.UserClaims,
+	apiKeys database.APIKeys,
+	rootCAs *x509.CertPool,
+	revocationChecker infratls.RevocationChecker,
+	tlsMapping *identity.Mapping,
+	logger log.Logger,
+) *Authenticator {
 	return &Authenticator{
-		jwtValidator: jwtValidator,
-		apiKeyClaims: apiKeyClaims,
-		rootCAs:      rootCAs,
-		logger:       logger,
+		jwtValidators:     jwtValidators,
+		tokenIntrospector: tokenIntrospector,
+		apiKeyClaims:      apiKeyClaims,
+		apiKeys:           apiKeys,
+		rootCAs:           rootCAs,
+		revocationChecker: revocationChecker,
+		tlsMapping:        tlsMapping,
+		logger:            logger,
 	}
 }
 
 func (authen *Authenticator) AuthenticateJWT(ctx context.Context, token string) (*entities.UserInfo, error) {
-	if authen.jwtValidator == nil {
+	if len(authen.jwtValidators) == 0 && authen.tokenIntrospector == nil {
 		errMessage := "jwt authentication method is not enabled"
 		authen.logger.Error(errMessage)
 		return nil, errors.UnauthorizedError(errMessage)
@@ -49,25 +74,57 @@ func (authen *Authenticator) AuthenticateJWT(ctx context.Context, token string)
 
 	authen.logger.Debug("extracting user info from jwt token")
 
-	tokenClaims, err := authen.jwtValidator.ValidateToken(ctx, token)
+	jwtValidator, issuer, err := authen.tokenValidator(token)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenClaims, err := jwtValidator.ValidateToken(ctx, token)
 	if err != nil {
 		errMessage := "failed to validate jwt token"
-		authen.logger.WithError(err).Error(errMessage)
+		authen.logger.WithError(err).Error(errMessage, "issuer", issuer)
 		return nil, errors.UnauthorizedError(errMessage)
 	}
 
-	claims, err := authen.jwtValidator.ParseClaims(tokenClaims)
+	claims, err := jwtValidator.ParseClaims(tokenClaims)
 	if err != nil {
 		errMessage := "failed to parse jwt token claims"
 		authen.logger.WithError(err).Error(errMessage)
 		return nil, errors.UnauthorizedError(errMessage)
 	}
 
-	return authen.userInfoFromClaims(JWTAuthMode, claims), nil
+	userInfo := authen.userInfoFromClaims(entities.JWTAuthMode, claims)
+	userInfo.Issuer = issuer
+	return userInfo, nil
+}
+
+// tokenValidator selects the validator of a bearer token
+func (authen *Authenticator) tokenValidator(token string) (jwt.Validator, string, error) {
+	// The issuer is read before the signature is verified only to select the validator, which then checks it
+	issuer, err := tokenIssuer(token)
+	if err != nil {
+		if authen.tokenIntrospector != nil {
+			return authen.tokenIntrospector, "", nil
+		}
+
+		errMessage := "failed to read jwt token issuer"
+		authen.logger.WithError(err).Error(errMessage)
+		return nil, "", errors.UnauthorizedError(errMessage)
+	}
+
+	// Signed tokens are only trusted from the configured issuers, the introspection endpoint only validates opaque tokens
+	jwtValidator, ok := authen.jwtValidators[issuer]
+	if !ok {
+		errMessage := "jwt token issuer is not trusted"
+		authen.logger.Warn(errMessage, "issuer", issuer)
+		return nil, "", errors.UnauthorizedError(errMessage)
+	}
+
+	return jwtValidator, issuer, nil
 }
 
-func (authen *Authenticator) AuthenticateAPIKey(_ context.Context, apiKey []byte) (*entities.UserInfo, error) {
-	if authen.apiKeyClaims == nil {
+func (authen *Authenticator) AuthenticateAPIKey(ctx context.Context, apiKey []byte) (*entities.UserInfo, error) {
+	if authen.apiKeyClaims == nil && authen.apiKeys == nil {
 		errMessage := "api key authentication method is not enabled"
 		authen.logger.Error(errMessage)
 		return nil, errors.UnauthorizedError(errMessage)
@@ -76,18 +133,62 @@ func (authen *Authenticator) AuthenticateAPIKey(_ context.Context, apiKey []byte
 	authen.logger.Debug("extracting user info from api key")
 
 	apiKeySha256 := fmt.Sprintf("%x", sha256.Sum256(apiKey))
-	claims, ok := authen.apiKeyClaims[apiKeySha256]
-	if !ok {
-		errMessage := "invalid api key"
-		authen.logger.Warn(errMessage)
+	if claims, ok := authen.apiKeyClaims[apiKeySha256]; ok {
+		return authen.userInfoFromClaims(entities.APIKeyAuthMode, claims), nil
+	}
+
+	if authen.apiKeys != nil {
+		return authen.authenticateStoredAPIKey(ctx, apiKeySha256)
+	}
+
+	errMessage := "invalid api key"
+	authen.logger.Warn(errMessage)
+	return nil, errors.UnauthorizedError(errMessage)
+}
+
+func (authen *Authenticator) authenticateStoredAPIKey(ctx context.Context, apiKeySha256 string) (*entities.UserInfo, error) {
+	apiKey, err := authen.apiKeys.FindByHash(ctx, apiKeySha256)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			errMessage := "invalid api key"
+			authen.logger.Warn(errMessage)
+			return nil, errors.UnauthorizedError(errMessage)
+		}
+
+		errMessage := "failed to get api key"
+		authen.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if apiKey.IsExpired() {
+		errMessage := "api key has expired"
+		authen.logger.Warn(errMessage, "id", apiKey.ID)
 		return nil, errors.UnauthorizedError(errMessage)
 	}
 
-	return authen.userInfoFromClaims(APIKeyAuthMode, claims), nil
+	// Last usage is only recorded with a minute precision to avoid a database write on every request
+	now := time.Now()
+	if now.Sub(apiKey.LastUsedAt) > lastUsedPrecision {
+		if err = authen.apiKeys.UpdateLastUsed(ctx, apiKey.ID, now); err != nil {
+			authen.logger.WithError(err).Warn("failed to record api key usage", "id", apiKey.ID)
+		}
+	}
+
+	permissions := make([]string, len(apiKey.Permissions))
+	for i, p := range apiKey.Permissions {
+		permissions[i] = string(p)
+	}
+
+	return authen.userInfoFromClaims(entities.APIKeyAuthMode, &entities.UserClaims{
+		Tenant:      apiKey.Tenant,
+		Username:    apiKey.Owner,
+		Roles:       apiKey.Roles,
+		Permissions: permissions,
+	}), nil
 }
 
-// AuthenticateTLS checks rootCAs and retrieve user info
-func (authen Authenticator) AuthenticateTLS(_ context.Context, connState *tls2.ConnectionState) (*entities.UserInfo, error) {
+// AuthenticateTLS checks rootCAs and revocation status and retrieve user info
+func (authen Authenticator) AuthenticateTLS(ctx context.Context, connState *tls2.ConnectionState) (*entities.UserInfo, error) {
 	if authen.rootCAs == nil {
 		errMessage := "tls authentication method is not enabled"
 		authen.logger.Error(errMessage)
@@ -100,7 +201,7 @@ func (authen Authenticator) AuthenticateTLS(_ context.Context, connState *tls2.C
 		return nil, errors.UnauthorizedError(errMessage)
 	}
 
-	err := tls.VerifyCertificateAuthority(connState.PeerCertificates, connState.ServerName, authen.rootCAs, true)
+	chains, err := tls.VerifyCertificateChains(connState.PeerCertificates, connState.ServerName, authen.rootCAs, true)
 	if err != nil {
 		errMessage := "invalid tls certificate"
 		authen.logger.WithError(err).Warn(errMessage)
@@ -109,12 +210,32 @@ func (authen Authenticator) AuthenticateTLS(_ context.Context, connState *tls2.C
 
 	// first array element is the leaf
 	clientCert := connState.PeerCertificates[0]
-	claims := &entities.UserClaims{
-		Tenant:      clientCert.Subject.CommonName,
-		Permissions: clientCert.Subject.OrganizationalUnit,
-		Roles:       clientCert.Subject.Organization,
+
+	if authen.revocationChecker != nil {
+		err = authen.revocationChecker.Check(ctx, chains[0])
+		if stderrors.Is(err, infratls.ErrRevoked) {
+			errMessage := "revoked tls certificate"
+			authen.logger.WithError(err).Warn(errMessage, "serial", clientCert.SerialNumber.String(), "subject", clientCert.Subject.String())
+			return nil, errors.UnauthorizedError(errMessage)
+		}
+		if err != nil {
+			errMessage := "failed to check tls certificate revocation"
+			authen.logger.WithError(err).Error(errMessage, "serial", clientCert.SerialNumber.String())
+			return nil, errors.UnauthorizedError(errMessage)
+		}
+	}
+	if authen.tlsMapping == nil {
+		return authen.userInfoFromClaims(entities.TLSAuthMode, identity.DefaultMapping().Apply(clientCert)), nil
+	}
+
+	claims := authen.tlsMapping.Apply(clientCert)
+	if claims.Tenant == "" {
+		errMessage := "tls certificate does not map to any tenant"
+		authen.logger.Warn(errMessage, "serial", clientCert.SerialNumber.String(), "subject", clientCert.Subject.String())
+		return nil, errors.UnauthorizedError(errMessage)
 	}
-	return authen.userInfoFromClaims(TLSAuthMode, claims), nil
+
+	return authen.userInfoFromClaims(entities.TLSAuthMode, claims), nil
 }
 
 func (authen *Authenticator) userInfoFromClaims(authMode string, claims *entities.UserClaims) *entities.UserInfo {
@@ -127,13 +248,20 @@ func (authen *Authenticator) userInfoFromClaims(authMode string, claims *entitie
 	}
 	userInfo.Tenant = subject[0]
 
+	if claims.Username != "" {
+		userInfo.Username = claims.Username
+	}
+
 	for _, permission := range claims.Permissions {
 		if !strings.Contains(permission, ":") {
 			// Ignore invalid permissions
 			continue
 		}
 
-		if strings.Contains(permission, "*") {
+		// Deny rules and conditional permissions are evaluated by the authorizator
+		if entities.IsPermissionRule(entities.Permission(permission)) {
+			userInfo.Permissions = append(userInfo.Permissions, entities.Permission(permission))
+		} else if strings.Contains(permission, "*") {
 			userInfo.Permissions = append(userInfo.Permissions, entities.ListWildcardPermission(permission)...)
 		} else {
 			userInfo.Permissions = append(userInfo.Permissions, entities.Permission(permission))
@@ -144,3 +272,24 @@ func (authen *Authenticator) userInfoFromClaims(authMode string, claims *entitie
 
 	return userInfo
 }
+
+func tokenIssuer(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("token must have 3 parts, found %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", err
+	}
+
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return "", err
+	}
+
+	return claims.Issuer, nil
+}
@@ -5,15 +5,22 @@ import (
 	"crypto/sha256"
 	tls2 "crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	mock2 "github.com/consensys/quorum-key-manager/src/infra/log/mock"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	dbmock "github.com/consensys/quorum-key-manager/src/auth/database/mock"
 	"github.com/consensys/quorum-key-manager/src/auth/entities/testdata"
+	"github.com/consensys/quorum-key-manager/src/infra/jwt"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt/mock"
 	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	infratls "github.com/consensys/quorum-key-manager/src/infra/tls"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/identity"
+	tlsmock "github.com/consensys/quorum-key-manager/src/infra/tls/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/consensys/quorum-key-manager/pkg/tls/certificate"
@@ -28,9 +35,18 @@ const (
 	aliceAPIKey = "aliceAPIKey"
 )
 
+const (
+	workforceIssuer = "https://workforce.issuer.com/"
+	machineIssuer   = "https://machine.issuer.com/"
+)
+
 type authenticatorTestSuite struct {
 	suite.Suite
 	mockJWTValidator *mock.MockValidator
+	mockM2MValidator *mock.MockValidator
+	mockIntrospector *mock.MockValidator
+	mockAPIKeys      *dbmock.MockAPIKeys
+	mockRevocation   *tlsmock.MockRevocationChecker
 	userClaims       map[string]*entities.UserClaims
 	aliceCert        *x509.Certificate
 	eveCert          *x509.Certificate
@@ -74,14 +90,23 @@ func (s *authenticatorTestSuite) SetupTest() {
 	caCertPool.AddCert(s.eveCert)
 
 	s.mockJWTValidator = mock.NewMockValidator(ctrl)
+	s.mockM2MValidator = mock.NewMockValidator(ctrl)
+	s.mockIntrospector = mock.NewMockValidator(ctrl)
+	s.mockAPIKeys = dbmock.NewMockAPIKeys(ctrl)
+	s.mockRevocation = tlsmock.NewMockRevocationChecker(ctrl)
 	s.logger = testutils2.NewMockLogger(ctrl)
 
-	s.auth = New(s.mockJWTValidator, s.userClaims, caCertPool, s.logger)
+	jwtValidators := map[string]jwt.Validator{
+		workforceIssuer: s.mockJWTValidator,
+		machineIssuer:   s.mockM2MValidator,
+	}
+
+	s.auth = New(jwtValidators, nil, s.userClaims, nil, caCertPool, nil, nil, s.logger)
 }
 
 func (s *authenticatorTestSuite) TestAuthenticateJWT() {
 	ctx := context.Background()
-	token := "myToken"
+	token := fakeJWT(workforceIssuer)
 	tokenClaimObj := "tokenClaimsObj"
 
 	s.Run("should authenticate a jwt token successfully", func() {
@@ -95,7 +120,7 @@ func (s *authenticatorTestSuite) TestAuthenticateJWT() {
 		assert.Equal(s.T(), "TenantOne", userInfo.Tenant)
 		assert.Equal(s.T(), []string{"guest", "admin"}, userInfo.Roles)
 		assert.Equal(s.T(), []entities.Permission{"read:key", "write:key"}, userInfo.Permissions)
-		assert.Equal(s.T(), JWTAuthMode, userInfo.AuthMode)
+		assert.Equal(s.T(), entities.JWTAuthMode, userInfo.AuthMode)
 	})
 
 	s.Run("should authenticate a jwt token successfully with wildcard permissions", func() {
@@ -107,7 +132,83 @@ func (s *authenticatorTestSuite) TestAuthenticateJWT() {
 		userInfo, err := s.auth.AuthenticateJWT(ctx, token)
 
 		require.NoError(s.T(), err)
-		assert.Equal(s.T(), entities.NewWildcardUser().Permissions, userInfo.Permissions)
+		assert.Equal(s.T(), entities.ListPermissions(), userInfo.Permissions)
+	})
+
+	s.Run("should keep deny rules and conditional permissions of a jwt token", func() {
+		userClaims := testdata.FakeUserClaims()
+		userClaims.Permissions = []string{"*:keys", "!destroy:*", "destroy:secrets?auth_mode=tls"}
+		s.mockJWTValidator.EXPECT().ValidateToken(ctx, token).Return(tokenClaimObj, nil)
+		s.mockJWTValidator.EXPECT().ParseClaims(tokenClaimObj).Return(userClaims, nil)
+
+		userInfo, err := s.auth.AuthenticateJWT(ctx, token)
+
+		require.NoError(s.T(), err)
+		assert.Contains(s.T(), userInfo.Permissions, entities.SignKey)
+		assert.Contains(s.T(), userInfo.Permissions, entities.Permission("!destroy:*"))
+		assert.Contains(s.T(), userInfo.Permissions, entities.Permission("destroy:secrets?auth_mode=tls"))
+	})
+
+	s.Run("should authenticate a jwt token successfully with a mapped username", func() {
+		userClaims := testdata.FakeUserClaims()
+		userClaims.Tenant = "TenantOne"
+		userClaims.Username = "Bob"
+		s.mockJWTValidator.EXPECT().ValidateToken(ctx, token).Return(tokenClaimObj, nil)
+		s.mockJWTValidator.EXPECT().ParseClaims(tokenClaimObj).Return(userClaims, nil)
+
+		userInfo, err := s.auth.AuthenticateJWT(ctx, token)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "Bob", userInfo.Username)
+		assert.Equal(s.T(), "TenantOne", userInfo.Tenant)
+	})
+
+	s.Run("should authenticate a jwt token with the validator of its issuer", func() {
+		m2mToken := fakeJWT(machineIssuer)
+		s.mockM2MValidator.EXPECT().ValidateToken(ctx, m2mToken).Return(tokenClaimObj, nil)
+		s.mockM2MValidator.EXPECT().ParseClaims(tokenClaimObj).Return(testdata.FakeUserClaims(), nil)
+
+		userInfo, err := s.auth.AuthenticateJWT(ctx, m2mToken)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "TenantOne", userInfo.Tenant)
+	})
+
+	s.Run("should return UnauthorizedError if the token issuer is not trusted", func() {
+		userInfo, err := s.auth.AuthenticateJWT(ctx, fakeJWT("https://unknown.issuer.com/"))
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if the token is malformed", func() {
+		userInfo, err := s.auth.AuthenticateJWT(ctx, "myToken")
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should authenticate an opaque token with token introspection", func() {
+		auth := New(map[string]jwt.Validator{workforceIssuer: s.mockJWTValidator}, s.mockIntrospector, nil, nil, nil, nil, nil, s.logger)
+		opaqueToken := "opaque-token"
+		s.mockIntrospector.EXPECT().ValidateToken(ctx, opaqueToken).Return(tokenClaimObj, nil)
+		s.mockIntrospector.EXPECT().ParseClaims(tokenClaimObj).Return(testdata.FakeUserClaims(), nil)
+
+		userInfo, err := auth.AuthenticateJWT(ctx, opaqueToken)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "TenantOne", userInfo.Tenant)
+		assert.Equal(s.T(), entities.JWTAuthMode, userInfo.AuthMode)
+	})
+
+	s.Run("should not introspect a jwt token from an unknown issuer", func() {
+		auth := New(map[string]jwt.Validator{workforceIssuer: s.mockJWTValidator}, s.mockIntrospector, nil, nil, nil, nil, nil, s.logger)
+
+		userInfo, err := auth.AuthenticateJWT(ctx, fakeJWT("https://unknown.issuer.com/"))
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+		assert.Equal(s.T(), "jwt token issuer is not trusted", errors.FromError(err).GetMessage())
 	})
 
 	s.Run("should return UnauthorizedError if the token fails validation", func() {
@@ -130,7 +231,7 @@ func (s *authenticatorTestSuite) TestAuthenticateJWT() {
 	})
 
 	s.Run("should return UnauthorizedError if the authentication method is not enabled", func() {
-		auth := New(nil, nil, nil, s.logger)
+		auth := New(nil, nil, nil, nil, nil, nil, nil, s.logger)
 
 		userInfo, err := auth.AuthenticateJWT(ctx, token)
 
@@ -150,14 +251,14 @@ func (s *authenticatorTestSuite) TestAuthenticateAPIKey() {
 		assert.Equal(s.T(), "TenantOne", userInfo.Tenant)
 		assert.Equal(s.T(), []string{"guest", "admin"}, userInfo.Roles)
 		assert.Equal(s.T(), []entities.Permission{"read:key", "write:key"}, userInfo.Permissions)
-		assert.Equal(s.T(), APIKeyAuthMode, userInfo.AuthMode)
+		assert.Equal(s.T(), entities.APIKeyAuthMode, userInfo.AuthMode)
 	})
 
 	s.Run("should authenticate an api key successfully with wildcard permissions", func() {
 		userInfo, err := s.auth.AuthenticateAPIKey(ctx, []byte(bobAPIKey))
 
 		require.NoError(s.T(), err)
-		assert.Equal(s.T(), entities.NewWildcardUser().Permissions, userInfo.Permissions)
+		assert.Equal(s.T(), entities.ListPermissions(), userInfo.Permissions)
 	})
 
 	s.Run("should return UnauthorizedError if api key is not found", func() {
@@ -167,8 +268,62 @@ func (s *authenticatorTestSuite) TestAuthenticateAPIKey() {
 		assert.True(s.T(), errors.IsUnauthorizedError(err))
 	})
 
+	s.Run("should authenticate with a database api key successfully", func() {
+		auth := New(nil, nil, s.userClaims, s.mockAPIKeys, nil, nil, nil, s.logger)
+		apiKey := &entities.APIKey{
+			ID:          "my-api-key",
+			Owner:       "Carol",
+			Tenant:      "TenantTwo",
+			Roles:       []string{"signer"},
+			Permissions: []entities.Permission{"sign:keys"},
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+		s.mockAPIKeys.EXPECT().FindByHash(ctx, fmt.Sprintf("%x", sha256.Sum256([]byte("carolAPIKey")))).Return(apiKey, nil)
+		s.mockAPIKeys.EXPECT().UpdateLastUsed(ctx, apiKey.ID, gomock.Any()).Return(nil)
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte("carolAPIKey"))
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "Carol", userInfo.Username)
+		assert.Equal(s.T(), "TenantTwo", userInfo.Tenant)
+		assert.Equal(s.T(), []string{"signer"}, userInfo.Roles)
+		assert.Equal(s.T(), []entities.Permission{"sign:keys"}, userInfo.Permissions)
+		assert.Equal(s.T(), entities.APIKeyAuthMode, userInfo.AuthMode)
+	})
+
+	s.Run("should not record usage of a database api key used less than a minute ago", func() {
+		auth := New(nil, nil, nil, s.mockAPIKeys, nil, nil, nil, s.logger)
+		apiKey := &entities.APIKey{ID: "my-api-key", Tenant: "TenantTwo", LastUsedAt: time.Now()}
+		s.mockAPIKeys.EXPECT().FindByHash(ctx, gomock.Any()).Return(apiKey, nil)
+
+		_, err := auth.AuthenticateAPIKey(ctx, []byte("carolAPIKey"))
+
+		require.NoError(s.T(), err)
+	})
+
+	s.Run("should return UnauthorizedError if database api key has expired", func() {
+		auth := New(nil, nil, nil, s.mockAPIKeys, nil, nil, nil, s.logger)
+		apiKey := &entities.APIKey{ID: "my-api-key", ExpiresAt: time.Now().Add(-time.Minute)}
+		s.mockAPIKeys.EXPECT().FindByHash(ctx, gomock.Any()).Return(apiKey, nil)
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte("carolAPIKey"))
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if database api key is not found", func() {
+		auth := New(nil, nil, nil, s.mockAPIKeys, nil, nil, nil, s.logger)
+		s.mockAPIKeys.EXPECT().FindByHash(ctx, gomock.Any()).Return(nil, errors.NotFoundError("error"))
+
+		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte("carolAPIKey"))
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
 	s.Run("should return UnauthorizedError if the authentication method is not enabled", func() {
-		auth := New(nil, nil, nil, s.logger)
+		auth := New(nil, nil, nil, nil, nil, nil, nil, s.logger)
 
 		userInfo, err := auth.AuthenticateAPIKey(ctx, []byte(aliceAPIKey))
 
@@ -193,7 +348,7 @@ func (s *authenticatorTestSuite) TestAuthenticateTLS() {
 		assert.Equal(s.T(), "alice", userInfo.Tenant)
 		assert.Equal(s.T(), []string{"admin", "signer"}, userInfo.Roles)
 		assert.Equal(s.T(), []entities.Permission{"read:accounts", "delete:secrets"}, userInfo.Permissions)
-		assert.Equal(s.T(), TLSAuthMode, userInfo.AuthMode)
+		assert.Equal(s.T(), entities.TLSAuthMode, userInfo.AuthMode)
 	})
 
 	s.Run("should authenticate an api key successfully with wildcard permissions", func() {
@@ -220,13 +375,86 @@ func (s *authenticatorTestSuite) TestAuthenticateTLS() {
 		assert.True(s.T(), errors.IsUnauthorizedError(err))
 	})
 
+	s.Run("should authenticate with TLS successfully using an identity mapping", func() {
+		connState := &tls2.ConnectionState{
+			PeerCertificates: []*x509.Certificate{s.aliceCert},
+		}
+		connState.HandshakeComplete = true
+
+		tenant, err := identity.NewField(identity.SubjectCommonNameSource, "(al).*")
+		require.NoError(s.T(), err)
+		roles, err := identity.NewField(identity.SubjectOrganizationSource, "(sign).*")
+		require.NoError(s.T(), err)
+		auth := New(nil, nil, nil, nil, s.auth.rootCAs, nil, &identity.Mapping{Tenant: tenant, Roles: roles}, s.logger)
+
+		userInfo, err := auth.AuthenticateTLS(ctx, connState)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "al", userInfo.Tenant)
+		assert.Equal(s.T(), []string{"sign"}, userInfo.Roles)
+		assert.Empty(s.T(), userInfo.Permissions)
+	})
+
+	s.Run("should return UnauthorizedError if the certificate does not map to any tenant", func() {
+		connState := &tls2.ConnectionState{
+			PeerCertificates: []*x509.Certificate{s.aliceCert},
+		}
+		connState.HandshakeComplete = true
+
+		rule, err := identity.NewRule("spiffe://corp/ns/payments/sa/api", "", "payments", "", []string{"signer"}, nil)
+		require.NoError(s.T(), err)
+		auth := New(nil, nil, nil, nil, s.auth.rootCAs, nil, &identity.Mapping{Rules: []*identity.Rule{rule}}, s.logger)
+
+		userInfo, err := auth.AuthenticateTLS(ctx, connState)
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if the certificate is revoked", func() {
+		connState := &tls2.ConnectionState{
+			PeerCertificates: []*x509.Certificate{s.aliceCert},
+		}
+		connState.HandshakeComplete = true
+
+		caCertPool := x509.NewCertPool()
+		caCertPool.AddCert(s.aliceCert)
+		auth := New(nil, nil, nil, nil, caCertPool, s.mockRevocation, nil, s.logger)
+
+		s.mockRevocation.EXPECT().Check(gomock.Any(), []*x509.Certificate{s.aliceCert}).
+			Return(fmt.Errorf("%w: serial number 1", infratls.ErrRevoked))
+
+		userInfo, err := auth.AuthenticateTLS(ctx, connState)
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
+	s.Run("should return UnauthorizedError if the revocation status is unavailable", func() {
+		connState := &tls2.ConnectionState{
+			PeerCertificates: []*x509.Certificate{s.aliceCert},
+		}
+		connState.HandshakeComplete = true
+
+		caCertPool := x509.NewCertPool()
+		caCertPool.AddCert(s.aliceCert)
+		auth := New(nil, nil, nil, nil, caCertPool, s.mockRevocation, nil, s.logger)
+
+		s.mockRevocation.EXPECT().Check(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
+
+		userInfo, err := auth.AuthenticateTLS(ctx, connState)
+
+		require.Nil(s.T(), userInfo)
+		assert.True(s.T(), errors.IsUnauthorizedError(err))
+	})
+
 	s.Run("should return UnauthorizedError if the authentication method is not enabled", func() {
 		connState := &tls2.ConnectionState{
 			PeerCertificates: []*x509.Certificate{s.eveCert},
 		}
 		connState.HandshakeComplete = false
 
-		auth := New(nil, nil, nil, s.logger)
+		auth := New(nil, nil, nil, nil, nil, nil, nil, s.logger)
 
 		userInfo, err := auth.AuthenticateTLS(ctx, connState)
 
@@ -234,3 +462,9 @@ func (s *authenticatorTestSuite) TestAuthenticateTLS() {
 		assert.True(s.T(), errors.IsUnauthorizedError(err))
 	})
 }
+
+func fakeJWT(issuer string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iss":%q,"sub":"TenantOne|Alice"}`, issuer)))
+	return header + "." + payload + ".signature"
+}
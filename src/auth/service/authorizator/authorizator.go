@@ -1,7 +1,9 @@
 package authorizator
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth"
@@ -13,28 +15,60 @@ import (
 type Authorizator struct {
 	logger      log.Logger
 	permissions map[entities.Permission]bool // We use a map to avoid iterating an array, the boolean is irrelevant and always true
+	allowRules  []*entities.PermissionRule   // conditional permissions
+	denyRules   []*entities.PermissionRule   // deny rules take precedence over any permission
+	request     *entities.RequestInfo
 	tenant      string
 }
 
 var _ auth.Authorizator = &Authorizator{}
 
-func New(permissions []entities.Permission, tenant string, logger log.Logger) *Authorizator {
-	pMap := map[entities.Permission]bool{}
-	for _, p := range permissions {
-		pMap[p] = true
-	}
-
-	return &Authorizator{
-		permissions: pMap,
+// New creates an authorizator evaluating the permissions against the request information found in the context
+func New(ctx context.Context, permissions []entities.Permission, tenant string, logger log.Logger) *Authorizator {
+	author := &Authorizator{
+		permissions: map[entities.Permission]bool{},
+		request:     entities.RequestInfoFromContext(ctx),
 		tenant:      tenant,
 		logger:      logger,
 	}
+
+	for _, p := range permissions {
+		if !entities.IsPermissionRule(p) {
+			author.permissions[p] = true
+			continue
+		}
+
+		rule, err := entities.ParsePermissionRule(p)
+		if err != nil {
+			rule = invalidDenyRule(p)
+			if rule == nil {
+				logger.WithError(err).Warn("ignoring invalid permission rule", "permission", p)
+				continue
+			}
+			logger.WithError(err).Warn("enforcing invalid deny rule without conditions", "permission", p)
+		}
+
+		if rule.Deny {
+			author.denyRules = append(author.denyRules, rule)
+		} else {
+			author.allowRules = append(author.allowRules, rule)
+		}
+	}
+
+	return author
 }
 
 func (author *Authorizator) CheckPermission(ops ...*entities.Operation) error {
 	for _, op := range ops {
 		permission := buildPermission(op.Action, op.Resource)
-		if _, ok := author.permissions[permission]; !ok {
+
+		if rule := author.denyingRule(op); rule != nil {
+			errMessage := "operation is denied for this user"
+			author.logger.With("permission", permission, "rule", rule.Permission()).Error(errMessage)
+			return errors.ForbiddenError(errMessage)
+		}
+
+		if !author.granted(op) {
 			errMessage := "user is not authorized to perform this operation"
 			author.logger.With("permission", permission).Error(errMessage)
 			return errors.ForbiddenError(errMessage)
@@ -44,6 +78,11 @@ func (author *Authorizator) CheckPermission(ops ...*entities.Operation) error {
 	return nil
 }
 
+// HasPermission is CheckPermission for callers adapting their behaviour to the user permissions, it does not log
+func (author *Authorizator) HasPermission(op *entities.Operation) bool {
+	return author.denyingRule(op) == nil && author.granted(op)
+}
+
 func (author *Authorizator) CheckAccess(allowedTenants []string) error {
 	if len(allowedTenants) == 0 {
 		return nil
@@ -66,6 +105,51 @@ func (author *Authorizator) CheckAccess(allowedTenants []string) error {
 	return errors.NotFoundError(errMessage)
 }
 
+// denyingRule returns the deny rule applying to the operation, deny rules are enforced when the request cannot be
+// proven to escape their conditions
+func (author *Authorizator) denyingRule(op *entities.Operation) *entities.PermissionRule {
+	for _, rule := range author.denyRules {
+		if rule.Matches(op.Action, op.Resource) && rule.Conditions.MaySatisfy(author.request) {
+			return rule
+		}
+	}
+
+	return nil
+}
+
+func (author *Authorizator) granted(op *entities.Operation) bool {
+	if _, ok := author.permissions[buildPermission(op.Action, op.Resource)]; ok {
+		return true
+	}
+
+	for _, rule := range author.allowRules {
+		if rule.Matches(op.Action, op.Resource) && rule.Conditions.Satisfied(author.request) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// invalidDenyRule keeps denying the permission of a deny rule with invalid conditions rather than ignoring it
+func invalidDenyRule(p entities.Permission) *entities.PermissionRule {
+	value := string(p)
+	if !strings.HasPrefix(value, entities.DenyPrefix) {
+		return nil
+	}
+
+	if idx := strings.Index(value, entities.ConditionsSeparator); idx >= 0 {
+		value = value[:idx]
+	}
+
+	rule, err := entities.ParsePermissionRule(entities.Permission(value))
+	if err != nil {
+		return nil
+	}
+
+	return rule
+}
+
 func buildPermission(action entities.OpAction, resource entities.OpResource) entities.Permission {
 	return entities.Permission(fmt.Sprintf("%s:%s", action, resource))
 }
@@ -0,0 +1,61 @@
+package authorizator
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	testutils "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	signKey := &entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}
+	destroyKey := &entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceKey}
+	tlsCtx := entities.WithRequestInfo(context.Background(), &entities.RequestInfo{AuthMode: "tls", SourceIP: net.ParseIP("10.0.0.1")})
+	jwtCtx := entities.WithRequestInfo(context.Background(), &entities.RequestInfo{AuthMode: "jwt", SourceIP: net.ParseIP("192.168.0.1")})
+
+	t.Run("should allow granted permissions", func(t *testing.T) {
+		author := New(context.Background(), []entities.Permission{entities.SignKey}, "", logger)
+
+		assert.NoError(t, author.CheckPermission(signKey))
+		assert.True(t, errors.IsForbiddenError(author.CheckPermission(signKey, destroyKey)))
+	})
+
+	t.Run("should let deny rules take precedence", func(t *testing.T) {
+		author := New(context.Background(), []entities.Permission{entities.SignKey, entities.DestroyKey, "!destroy:*"}, "", logger)
+
+		assert.NoError(t, author.CheckPermission(signKey))
+		assert.True(t, errors.IsForbiddenError(author.CheckPermission(destroyKey)))
+	})
+
+	t.Run("should evaluate conditional permissions against the request", func(t *testing.T) {
+		permissions := []entities.Permission{"destroy:keys?auth_mode=tls"}
+
+		assert.NoError(t, New(tlsCtx, permissions, "", logger).CheckPermission(destroyKey))
+		assert.True(t, errors.IsForbiddenError(New(jwtCtx, permissions, "", logger).CheckPermission(destroyKey)))
+		assert.True(t, errors.IsForbiddenError(New(context.Background(), permissions, "", logger).CheckPermission(destroyKey)))
+	})
+
+	t.Run("should enforce conditional deny rules unless the request escapes them", func(t *testing.T) {
+		permissions := []entities.Permission{entities.DestroyKey, "!destroy:keys?cidr=10.0.0.0/8"}
+
+		assert.True(t, errors.IsForbiddenError(New(tlsCtx, permissions, "", logger).CheckPermission(destroyKey)))
+		assert.NoError(t, New(jwtCtx, permissions, "", logger).CheckPermission(destroyKey))
+		assert.True(t, errors.IsForbiddenError(New(context.Background(), permissions, "", logger).CheckPermission(destroyKey)))
+	})
+
+	t.Run("should enforce deny rules with invalid conditions", func(t *testing.T) {
+		author := New(jwtCtx, []entities.Permission{entities.DestroyKey, "!destroy:keys?cidr=invalid", "sign:keys?cidr=invalid"}, "", logger)
+
+		assert.True(t, errors.IsForbiddenError(author.CheckPermission(destroyKey)))
+		assert.True(t, errors.IsForbiddenError(author.CheckPermission(signKey)))
+	})
+}
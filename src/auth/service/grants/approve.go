@@ -0,0 +1,79 @@
+package grants
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+func (s *Grants) Approve(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	logger := s.logger.With("id", id, "approver", userInfo.Username)
+	logger.Debug("approving grant")
+
+	err := checkIdentified(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkApprover(ctx, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := s.findPending(ctx, id, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if grant.Username == userInfo.Username && grant.Tenant == userInfo.Tenant {
+		errMessage := "users cannot approve their own grants"
+		logger.Error(errMessage)
+		return nil, errors.ForbiddenError(errMessage)
+	}
+
+	// Approvers cannot grant more privileges than they have
+	rolePermissions, err := s.roles.RolePermissions(ctx, grant.Tenant, []string{grant.Role})
+	if err != nil {
+		errMessage := "granted role does not exist anymore"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	if p, ok := entities.UngrantedPermission(s.roles.UserPermissions(ctx, userInfo), rolePermissions); ok {
+		errMessage := "cannot grant permissions the user does not have"
+		logger.With("permission", p).Error(errMessage)
+		return nil, errors.ForbiddenError(errMessage)
+	}
+
+	now := time.Now()
+	grant.Status = entities.GrantApproved
+	grant.Approver = userInfo.Username
+	grant.ApprovedAt = now
+	grant.ExpiresAt = now.Add(grant.Duration)
+
+	grant, err = s.update(ctx, grant, entities.GrantPending, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("grant approved successfully", "username", grant.Username, "role", grant.Role, "expires_at", grant.ExpiresAt)
+	return grant, nil
+}
+
+func (s *Grants) findPending(ctx context.Context, id string, userInfo *entities.UserInfo, logger log.Logger) (*entities.Grant, error) {
+	grant, err := s.findOwned(ctx, id, true, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if grant.Status != entities.GrantPending {
+		errMessage := "only pending grants can be approved or rejected"
+		logger.With("status", grant.CurrentStatus()).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	return grant, nil
+}
@@ -0,0 +1,24 @@
+package grants
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Grants) Get(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	logger := s.logger.With("id", id)
+
+	err := checkIdentified(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := s.findOwned(ctx, id, s.isApprover(ctx, userInfo), userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("grant retrieved successfully")
+	return grant, nil
+}
@@ -0,0 +1,95 @@
+package grants
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/database"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+const grantIDSize = 16
+
+// MaxDuration is the longest duration a role can be granted for
+const MaxDuration = 24 * time.Hour
+
+type Grants struct {
+	db     database.Grants
+	roles  auth.Roles
+	logger log.Logger
+}
+
+var _ auth.Grants = &Grants{}
+
+func New(db database.Grants, rolesService auth.Roles, logger log.Logger) *Grants {
+	return &Grants{
+		db:     db,
+		roles:  rolesService,
+		logger: logger,
+	}
+}
+
+func newGrantID() (string, error) {
+	id := make([]byte, grantIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+// checkIdentified rejects anonymous and internal users, grants are bound to a username
+func checkIdentified(userInfo *entities.UserInfo, logger log.Logger) error {
+	if userInfo.AuthMode == "" || userInfo.Username == "" {
+		errMessage := "grants are only available to authenticated users with a username"
+		logger.Error(errMessage)
+		return errors.ForbiddenError(errMessage)
+	}
+
+	return nil
+}
+
+var approveOp = &entities.Operation{Action: entities.ActionApprove, Resource: entities.ResourceGrant}
+
+func (s *Grants) checkApprover(ctx context.Context, userInfo *entities.UserInfo, logger log.Logger) error {
+	return authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, logger).CheckPermission(approveOp)
+}
+
+func (s *Grants) isApprover(ctx context.Context, userInfo *entities.UserInfo) bool {
+	return authorizator.New(ctx, s.roles.UserPermissions(ctx, userInfo), userInfo.Tenant, s.logger).HasPermission(approveOp)
+}
+
+// findOwned gets a grant of the user tenant, only approvers can get the grants of other users
+func (s *Grants) findOwned(ctx context.Context, id string, approver bool, userInfo *entities.UserInfo, logger log.Logger) (*entities.Grant, error) {
+	grant, err := s.db.FindOne(ctx, id, userInfo.Tenant)
+	if err != nil {
+		errMessage := "failed to get grant"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if !approver && (grant.Username != userInfo.Username || grant.Tenant != userInfo.Tenant) {
+		errMessage := "grant was not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	return grant, nil
+}
+
+func (s *Grants) update(ctx context.Context, grant *entities.Grant, expectedStatus string, logger log.Logger) (*entities.Grant, error) {
+	grant, err := s.db.Update(ctx, grant, expectedStatus)
+	if err != nil {
+		errMessage := "failed to update grant"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return grant, nil
+}
@@ -0,0 +1,173 @@
+package grants
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	dbmock "github.com/consensys/quorum-key-manager/src/auth/database/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	testutils "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockDB := dbmock.NewMockGrants(ctrl)
+	mockRoles := mock.NewMockRoles(ctrl)
+	service := New(mockDB, mockRoles, testutils.NewMockLogger(ctrl))
+
+	alice := &entities.UserInfo{AuthMode: "jwt", Issuer: "https://idp.example.com/", Tenant: "tenant1", Username: "alice", Permissions: []entities.Permission{entities.ReadKey}}
+	bob := &entities.UserInfo{AuthMode: "jwt", Tenant: "tenant1", Username: "bob", Permissions: []entities.Permission{entities.ApproveGrant, entities.DestroyKey}}
+	mockRoles.EXPECT().UserPermissions(gomock.Any(), alice).Return(alice.Permissions).AnyTimes()
+	mockRoles.EXPECT().UserPermissions(gomock.Any(), bob).Return(bob.Permissions).AnyTimes()
+
+	pendingGrant := func() *entities.Grant {
+		return &entities.Grant{ID: "id", Tenant: "tenant1", Username: "alice", Role: "destroyer", Duration: time.Hour, Status: entities.GrantPending}
+	}
+
+	t.Run("should request a grant successfully", func(t *testing.T) {
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"destroyer"}).Return([]entities.Permission{entities.DestroyKey}, nil)
+		mockDB.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, grant *entities.Grant) (*entities.Grant, error) {
+			assert.Equal(t, "alice", grant.Username)
+			assert.Equal(t, "jwt", grant.AuthMode)
+			assert.Equal(t, "https://idp.example.com/", grant.Issuer)
+			assert.Equal(t, entities.GrantPending, grant.Status)
+			assert.NotEmpty(t, grant.ID)
+			return grant, nil
+		})
+
+		grant, err := service.Request(ctx, "destroyer", "incident", time.Hour, alice)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour, grant.Duration)
+	})
+
+	t.Run("should fail to request a grant with InvalidParameterError if the request is invalid", func(t *testing.T) {
+		_, err := service.Request(ctx, "destroyer", "", time.Hour, alice)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		_, err = service.Request(ctx, "destroyer", "incident", 48*time.Hour, alice)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"unknown"}).Return(nil, errors.NotFoundError("error"))
+		_, err = service.Request(ctx, "unknown", "incident", time.Hour, alice)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail to request a grant with ForbiddenError if the user is anonymous", func(t *testing.T) {
+		_, err := service.Request(ctx, "destroyer", "incident", time.Hour, entities.NewAnonymousUser())
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail to request a grant with ForbiddenError if the user is authenticated with an api key", func(t *testing.T) {
+		_, err := service.Request(ctx, "destroyer", "incident", time.Hour, &entities.UserInfo{AuthMode: "apikey", Tenant: "tenant1", Username: "alice"})
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should approve a grant successfully", func(t *testing.T) {
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(pendingGrant(), nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"destroyer"}).Return([]entities.Permission{entities.DestroyKey}, nil)
+		mockDB.EXPECT().Update(gomock.Any(), gomock.Any(), entities.GrantPending).DoAndReturn(func(_ context.Context, grant *entities.Grant, _ string) (*entities.Grant, error) {
+			return grant, nil
+		})
+
+		grant, err := service.Approve(ctx, "id", bob)
+
+		require.NoError(t, err)
+		assert.True(t, grant.IsActive())
+		assert.Equal(t, "bob", grant.Approver)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), grant.ExpiresAt, time.Minute)
+	})
+
+	t.Run("should fail to approve with ForbiddenError if the user is not an approver", func(t *testing.T) {
+		_, err := service.Approve(ctx, "id", alice)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail to approve with ForbiddenError if the approver is the requester", func(t *testing.T) {
+		grant := pendingGrant()
+		grant.Username = "bob"
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(grant, nil)
+
+		_, err := service.Approve(ctx, "id", bob)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail to approve with ForbiddenError if the role exceeds the approver permissions", func(t *testing.T) {
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(pendingGrant(), nil)
+		mockRoles.EXPECT().RolePermissions(gomock.Any(), "tenant1", []string{"destroyer"}).Return([]entities.Permission{entities.DestroySecret}, nil)
+
+		_, err := service.Approve(ctx, "id", bob)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail to approve with InvalidParameterError if the grant is not pending", func(t *testing.T) {
+		grant := pendingGrant()
+		grant.Status = entities.GrantRejected
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(grant, nil)
+
+		_, err := service.Approve(ctx, "id", bob)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should list the grants of the user or of the tenant for approvers", func(t *testing.T) {
+		mockDB.EXPECT().List(gomock.Any(), "tenant1", "alice").Return([]*entities.Grant{pendingGrant()}, nil)
+		grants, err := service.List(ctx, alice)
+		require.NoError(t, err)
+		assert.Len(t, grants, 1)
+
+		mockDB.EXPECT().List(gomock.Any(), "tenant1", "").Return([]*entities.Grant{}, nil)
+		_, err = service.List(ctx, bob)
+		require.NoError(t, err)
+	})
+
+	t.Run("should hide the grants of other users", func(t *testing.T) {
+		grant := pendingGrant()
+		grant.Username = "carol"
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(grant, nil)
+
+		_, err := service.Get(ctx, "id", alice)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should let requesters revoke their grants", func(t *testing.T) {
+		grant := pendingGrant()
+		grant.Status = entities.GrantApproved
+		grant.ExpiresAt = time.Now().Add(time.Hour)
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(grant, nil)
+		mockDB.EXPECT().Update(gomock.Any(), gomock.Any(), entities.GrantApproved).DoAndReturn(func(_ context.Context, grant *entities.Grant, _ string) (*entities.Grant, error) {
+			return grant, nil
+		})
+
+		revoked, err := service.Revoke(ctx, "id", alice)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.GrantRevoked, revoked.Status)
+	})
+
+	t.Run("should fail to revoke with InvalidParameterError if the grant has expired", func(t *testing.T) {
+		grant := pendingGrant()
+		grant.Status = entities.GrantApproved
+		grant.ExpiresAt = time.Now().Add(-time.Hour)
+		mockDB.EXPECT().FindOne(gomock.Any(), "id", "tenant1").Return(grant, nil)
+
+		_, err := service.Revoke(ctx, "id", alice)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
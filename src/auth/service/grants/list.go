@@ -0,0 +1,33 @@
+package grants
+
+import (
+	"context"
+	"sort"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Grants) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.Grant, error) {
+	err := checkIdentified(userInfo, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// Approvers list all the grants of their tenant
+	username := userInfo.Username
+	if s.isApprover(ctx, userInfo) {
+		username = ""
+	}
+
+	grants, err := s.db.List(ctx, userInfo.Tenant, username)
+	if err != nil {
+		errMessage := "failed to list grants"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+	sort.Slice(grants, func(i, j int) bool { return grants[i].CreatedAt.After(grants[j].CreatedAt) })
+
+	s.logger.Debug("grants listed successfully")
+	return grants, nil
+}
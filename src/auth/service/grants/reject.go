@@ -0,0 +1,37 @@
+package grants
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Grants) Reject(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	logger := s.logger.With("id", id, "approver", userInfo.Username)
+
+	err := checkIdentified(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkApprover(ctx, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := s.findPending(ctx, id, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	grant.Status = entities.GrantRejected
+	grant.Approver = userInfo.Username
+
+	grant, err = s.update(ctx, grant, entities.GrantPending, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("grant rejected successfully", "username", grant.Username, "role", grant.Role)
+	return grant, nil
+}
@@ -0,0 +1,74 @@
+package grants
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Grants) Request(ctx context.Context, role, reason string, duration time.Duration, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	logger := s.logger.With("role", role, "duration", duration, "tenant", userInfo.Tenant, "username", userInfo.Username)
+	logger.Debug("requesting grant")
+
+	err := checkIdentified(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// API keys share the username of their owner, a grant requested with one would apply to all of them
+	if userInfo.AuthMode == entities.APIKeyAuthMode {
+		errMessage := "grants cannot be requested with an api key"
+		logger.Error(errMessage)
+		return nil, errors.ForbiddenError(errMessage)
+	}
+
+	if reason == "" {
+		errMessage := "a reason is required to request a grant"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	if duration <= 0 || duration > MaxDuration {
+		errMessage := fmt.Sprintf("grant duration must be positive and at most %s", MaxDuration)
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	// Roles are resolved in the user tenant, as for the roles of the user
+	_, err = s.roles.RolePermissions(ctx, userInfo.Tenant, []string{role})
+	if err != nil {
+		errMessage := "requested role does not exist"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	id, err := newGrantID()
+	if err != nil {
+		errMessage := "failed to generate grant identifier"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	grant, err := s.db.Insert(ctx, &entities.Grant{
+		ID:       id,
+		Tenant:   userInfo.Tenant,
+		Username: userInfo.Username,
+		AuthMode: userInfo.AuthMode,
+		Issuer:   userInfo.Issuer,
+		Role:     role,
+		Reason:   reason,
+		Duration: duration,
+		Status:   entities.GrantPending,
+	})
+	if err != nil {
+		errMessage := "failed to create grant"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("grant requested successfully", "id", grant.ID)
+	return grant, nil
+}
@@ -0,0 +1,40 @@
+package grants
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+func (s *Grants) Revoke(ctx context.Context, id string, userInfo *entities.UserInfo) (*entities.Grant, error) {
+	logger := s.logger.With("id", id, "username", userInfo.Username)
+
+	err := checkIdentified(userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := s.findOwned(ctx, id, s.isApprover(ctx, userInfo), userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	status := grant.CurrentStatus()
+	if status != entities.GrantPending && status != entities.GrantApproved {
+		errMessage := "only pending or active grants can be revoked"
+		logger.With("status", status).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	expectedStatus := grant.Status
+	grant.Status = entities.GrantRevoked
+
+	grant, err = s.update(ctx, grant, expectedStatus, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("grant revoked successfully", "role", grant.Role)
+	return grant, nil
+}
@@ -2,25 +2,62 @@ package roles
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
 )
 
-func (i *Roles) Create(ctx context.Context, name string, permissions []entities.Permission, _ *entities.UserInfo) error {
-	logger := i.logger.With("name", name, "permissions", permissions)
+func (i *Roles) Create(ctx context.Context, name, tenant string, extends []string, permissions []entities.Permission, userInfo *entities.UserInfo) error {
+	logger := i.logger.With("name", name, "tenant", tenant, "extends", extends, "permissions", permissions)
 	logger.Debug("creating role")
 
-	// TODO: Implement {Resource/Role}BAC for roles
+	userPermissions := i.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(ctx, userPermissions, userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceRole})
+	if err != nil {
+		return err
+	}
+
+	if userInfo.Tenant != "" {
+		if tenant != "" && tenant != userInfo.Tenant {
+			errMessage := "cannot create roles for another tenant"
+			logger.Error(errMessage)
+			return errors.ForbiddenError(errMessage)
+		}
+		tenant = userInfo.Tenant
+	}
+
+	role := &entities.Role{
+		Name:        name,
+		Tenant:      tenant,
+		Extends:     extends,
+		Permissions: permissions,
+	}
 
-	if _, ok := i.roles[name]; ok {
-		errMessage := fmt.Sprintf("role %s already exist", name)
-		logger.Error(errMessage)
-		return errors.AlreadyExistsError(errMessage)
+	err = entities.ValidatePermissions(permissions)
+	if err != nil {
+		errMessage := "invalid role permissions"
+		logger.WithError(err).Error(errMessage)
+		return errors.InvalidParameterError("%s: %v", errMessage, err)
 	}
 
-	i.createRole(ctx, name, permissions)
+	// Users cannot create roles with more privileges than they have
+	i.mux.RLock()
+	rolePermissions := i.resolve(role)
+	i.mux.RUnlock()
+
+	if p, ok := entities.UngrantedPermission(userPermissions, rolePermissions); ok {
+		errMessage := "cannot grant permissions the user does not have"
+		logger.With("permission", p).Error(errMessage)
+		return errors.ForbiddenError(errMessage)
+	}
+
+	err = i.createRole(ctx, role)
+	if err != nil {
+		logger.WithError(err).Error("failed to create role")
+		return err
+	}
 
 	logger.Info("role created successfully")
 	return nil
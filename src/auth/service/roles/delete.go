@@ -0,0 +1,27 @@
+package roles
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+)
+
+func (i *Roles) Delete(ctx context.Context, name string, userInfo *entities.UserInfo) error {
+	logger := i.logger.With("name", name, "tenant", userInfo.Tenant)
+
+	resolver := authorizator.New(ctx, i.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceRole})
+	if err != nil {
+		return err
+	}
+
+	err = i.deleteRole(ctx, userInfo.Tenant, name)
+	if err != nil {
+		logger.WithError(err).Error("failed to delete role")
+		return err
+	}
+
+	logger.Info("role deleted successfully")
+	return nil
+}
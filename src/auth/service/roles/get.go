@@ -4,18 +4,23 @@ import (
 	"context"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
 )
 
-func (i *Roles) Get(ctx context.Context, name string, _ *entities.UserInfo) (*entities.Role, error) {
+func (i *Roles) Get(ctx context.Context, name string, userInfo *entities.UserInfo) (*entities.Role, error) {
 	logger := i.logger.With("name", name)
 
-	// TODO: Implement {Resource/Role}BAC for roles
+	resolver := authorizator.New(ctx, i.UserPermissions(ctx, userInfo), userInfo.Tenant, logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceRole})
+	if err != nil {
+		return nil, err
+	}
 
-	vault, err := i.getRole(ctx, name)
+	role, err := i.getRole(ctx, userInfo.Tenant, name)
 	if err != nil {
 		return nil, err
 	}
 
 	logger.Debug("role found successfully")
-	return vault, nil
+	return role, nil
 }
@@ -2,17 +2,34 @@ package roles
 
 import (
 	"context"
+	"sort"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
 )
 
-func (i *Roles) List(_ context.Context, _ *entities.UserInfo) ([]string, error) {
-	// TODO: Implement {Resource/Role}BAC for roles
+func (i *Roles) List(ctx context.Context, userInfo *entities.UserInfo) ([]*entities.Role, error) {
+	resolver := authorizator.New(ctx, i.UserPermissions(ctx, userInfo), userInfo.Tenant, i.logger)
+	err := resolver.CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceRole})
+	if err != nil {
+		return nil, err
+	}
 
-	roles := make([]string, 0, len(i.roles))
-	for role := range i.roles {
-		roles = append(roles, role)
+	i.mux.RLock()
+	roles := make([]*entities.Role, 0, len(i.roles))
+	for _, role := range i.roles {
+		if role.Tenant == "" || role.Tenant == userInfo.Tenant {
+			roles = append(roles, role)
+		}
 	}
+	i.mux.RUnlock()
+
+	sort.Slice(roles, func(a, b int) bool {
+		if roles[a].Name == roles[b].Name {
+			return roles[a].Tenant < roles[b].Tenant
+		}
+		return roles[a].Name < roles[b].Name
+	})
 
 	i.logger.Debug("roles listed successfully")
 	return roles, nil
@@ -3,48 +3,217 @@ package roles
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/database"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 )
 
+// roleKey identifies a role in its tenant scope, global roles have an empty tenant
+type roleKey struct {
+	tenant string
+	name   string
+}
+
 type Roles struct {
 	mux    sync.RWMutex
-	roles  map[string]*entities.Role
+	roles  map[roleKey]*entities.Role
+	grants database.Grants
 	logger log.Logger
+
+	// resolved caches the inherited permissions of roles, it is reset by every modification of the roles
+	resolved   map[roleKey][]entities.Permission
+	generation uint64
+
+	// granted caches the roles granted to users for grantsCacheTTL, so that active grants are not listed on every request
+	grantedMux sync.Mutex
+	granted    map[grantedKey]*grantedEntry
+	now        func() time.Time
 }
 
 var _ auth.Roles = &Roles{}
 
-func New(logger log.Logger) *Roles {
+// New creates the roles service, roles temporarily granted to users are ignored when grantsDB is nil
+func New(grantsDB database.Grants, logger log.Logger) *Roles {
 	return &Roles{
-		roles:  make(map[string]*entities.Role),
-		logger: logger,
+		roles:    make(map[roleKey]*entities.Role),
+		resolved: make(map[roleKey][]entities.Permission),
+		grants:   grantsDB,
+		logger:   logger,
+		granted:  make(map[grantedKey]*grantedEntry),
+		now:      time.Now,
 	}
 }
 
 // TODO: Move to data layer
-func (i *Roles) createRole(_ context.Context, name string, permissions []entities.Permission) {
+func (i *Roles) createRole(_ context.Context, role *entities.Role) error {
 	i.mux.Lock()
 	defer i.mux.Unlock()
 
-	i.roles[name] = &entities.Role{
-		Name:        name,
-		Permissions: permissions,
+	key := roleKey{tenant: role.Tenant, name: role.Name}
+	if _, ok := i.roles[key]; ok {
+		return errors.AlreadyExistsError("role %s already exist", role.Name)
+	}
+
+	i.roles[key] = role
+	if i.extendsItself(role) {
+		delete(i.roles, key)
+		return errors.InvalidParameterError("role %s cannot extend itself", role.Name)
 	}
+
+	i.resetCache()
+	return nil
 }
 
 // TODO: Move to data layer
-func (i *Roles) getRole(_ context.Context, name string) (*entities.Role, error) {
+func (i *Roles) getRole(_ context.Context, tenant, name string) (*entities.Role, error) {
 	i.mux.RLock()
 	defer i.mux.RUnlock()
 
-	if role, ok := i.roles[name]; ok {
+	if role := i.lookup(tenant, name); role != nil {
 		return role, nil
 	}
 
 	return nil, errors.NotFoundError("role was not found")
 }
+
+// TODO: Move to data layer
+func (i *Roles) deleteRole(_ context.Context, tenant, name string) error {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	key := roleKey{tenant: tenant, name: name}
+	if _, ok := i.roles[key]; !ok {
+		return errors.NotFoundError("role was not found")
+	}
+
+	delete(i.roles, key)
+	i.resetCache()
+	return nil
+}
+
+// lookup finds a role in the tenant scope, then globally. Must be called with mux locked
+func (i *Roles) lookup(tenant, name string) *entities.Role {
+	if tenant != "" {
+		if role, ok := i.roles[roleKey{tenant: tenant, name: name}]; ok {
+			return role
+		}
+	}
+
+	return i.roles[roleKey{name: name}]
+}
+
+// extendsItself detects inheritance cycles going through the role. Must be called with mux locked
+func (i *Roles) extendsItself(role *entities.Role) bool {
+	target := roleKey{tenant: role.Tenant, name: role.Name}
+	visited := map[roleKey]bool{}
+
+	var visit func(r *entities.Role) bool
+	visit = func(r *entities.Role) bool {
+		for _, name := range r.Extends {
+			parent := i.lookup(r.Tenant, name)
+			if parent == nil {
+				continue
+			}
+
+			parentKey := roleKey{tenant: parent.Tenant, name: parent.Name}
+			if parentKey == target {
+				return true
+			}
+			if visited[parentKey] {
+				continue
+			}
+
+			visited[parentKey] = true
+			if visit(parent) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return visit(role)
+}
+
+// resolve returns the permissions of the role and of the roles it extends, wildcards included and expanded,
+// without the permissions removed by unconditional deny rules.
+// Roles extending unknown roles inherit nothing from them. Must be called with mux locked
+func (i *Roles) resolve(role *entities.Role) []entities.Permission {
+	var permissions []entities.Permission
+	seen := map[entities.Permission]bool{}
+	visited := map[roleKey]bool{{tenant: role.Tenant, name: role.Name}: true}
+
+	var visit func(r *entities.Role)
+	visit = func(r *entities.Role) {
+		for _, p := range r.Permissions {
+			expansion := []entities.Permission{p}
+			if !entities.IsPermissionRule(p) {
+				expansion = append(expansion, entities.ListWildcardPermission(string(p))...)
+			}
+
+			for _, expanded := range expansion {
+				if !seen[expanded] {
+					seen[expanded] = true
+					permissions = append(permissions, expanded)
+				}
+			}
+		}
+
+		for _, name := range r.Extends {
+			parent := i.lookup(r.Tenant, name)
+			if parent == nil {
+				continue
+			}
+
+			parentKey := roleKey{tenant: parent.Tenant, name: parent.Name}
+			if !visited[parentKey] {
+				visited[parentKey] = true
+				visit(parent)
+			}
+		}
+	}
+	visit(role)
+
+	// Deny rules take precedence over the permissions of the role and of the roles it extends
+	return entities.ApplyDenyRules(permissions)
+}
+
+// resolvedPermissions returns the cached inherited permissions of a role as seen from a tenant
+func (i *Roles) resolvedPermissions(tenant, name string) ([]entities.Permission, error) {
+	i.mux.RLock()
+	role := i.lookup(tenant, name)
+	if role == nil {
+		i.mux.RUnlock()
+		return nil, errors.NotFoundError("role %s was not found", name)
+	}
+
+	key := roleKey{tenant: role.Tenant, name: role.Name}
+	if permissions, ok := i.resolved[key]; ok {
+		i.mux.RUnlock()
+		return permissions, nil
+	}
+
+	permissions := i.resolve(role)
+	generation := i.generation
+	i.mux.RUnlock()
+
+	i.mux.Lock()
+	// Roles modified while resolving invalidate the result
+	if generation == i.generation {
+		i.resolved[key] = permissions
+	}
+	i.mux.Unlock()
+
+	return permissions, nil
+}
+
+// resetCache must be called with mux locked
+func (i *Roles) resetCache() {
+	i.resolved = make(map[roleKey][]entities.Permission)
+	i.generation++
+}
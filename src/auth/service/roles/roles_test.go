@@ -0,0 +1,184 @@
+package roles
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	dbmock "github.com/consensys/quorum-key-manager/src/auth/database/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	testutils "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	admin := entities.NewWildcardUser()
+
+	newRoles := func(t *testing.T) *Roles {
+		roles := New(nil, testutils.NewMockLogger(ctrl))
+		require.NoError(t, roles.Create(ctx, "reader", "", nil, []entities.Permission{entities.ReadKey}, admin))
+		require.NoError(t, roles.Create(ctx, "signer", "", []string{"reader"}, []entities.Permission{entities.SignKey}, admin))
+		return roles
+	}
+
+	t.Run("should resolve inherited permissions", func(t *testing.T) {
+		roles := newRoles(t)
+		require.NoError(t, roles.Create(ctx, "operator", "", []string{"signer"}, []entities.Permission{"*:secrets"}, admin))
+
+		permissions := roles.UserPermissions(ctx, &entities.UserInfo{Roles: []string{"operator"}})
+
+		assert.Contains(t, permissions, entities.ReadKey)
+		assert.Contains(t, permissions, entities.SignKey)
+		assert.Contains(t, permissions, entities.DestroySecret)
+		assert.NotContains(t, permissions, entities.WriteKey)
+	})
+
+	t.Run("should scope roles to tenants", func(t *testing.T) {
+		roles := newRoles(t)
+		require.NoError(t, roles.Create(ctx, "signer", "tenant1", []string{"reader"}, []entities.Permission{entities.SignEth}, admin))
+
+		tenant1 := roles.UserPermissions(ctx, &entities.UserInfo{Tenant: "tenant1", Roles: []string{"signer"}})
+		assert.Contains(t, tenant1, entities.SignEth)
+		assert.Contains(t, tenant1, entities.ReadKey)
+		assert.NotContains(t, tenant1, entities.SignKey)
+
+		tenant2 := roles.UserPermissions(ctx, &entities.UserInfo{Tenant: "tenant2", Roles: []string{"signer"}})
+		assert.Contains(t, tenant2, entities.SignKey)
+		assert.NotContains(t, tenant2, entities.SignEth)
+
+		list, err := roles.List(ctx, &entities.UserInfo{Tenant: "tenant2", Permissions: []entities.Permission{entities.ReadRole}})
+		require.NoError(t, err)
+		assert.Len(t, list, 2)
+	})
+
+	t.Run("should let tenant admins create roles in their tenant only", func(t *testing.T) {
+		roles := newRoles(t)
+		tenantAdmin := &entities.UserInfo{
+			Tenant:      "tenant1",
+			Permissions: []entities.Permission{entities.WriteRole, entities.ReadKey, entities.SignKey},
+		}
+
+		require.NoError(t, roles.Create(ctx, "payments", "", []string{"signer"}, nil, tenantAdmin))
+		role, err := roles.getRole(ctx, "tenant1", "payments")
+		require.NoError(t, err)
+		assert.Equal(t, "tenant1", role.Tenant)
+		_, err = roles.getRole(ctx, "", "payments")
+		assert.True(t, errors.IsNotFoundError(err))
+
+		err = roles.Create(ctx, "other", "tenant2", nil, nil, tenantAdmin)
+		assert.True(t, errors.IsForbiddenError(err))
+
+		err = roles.Create(ctx, "escalated", "", []string{"signer"}, []entities.Permission{entities.WriteKey}, tenantAdmin)
+		assert.True(t, errors.IsForbiddenError(err))
+
+		err = roles.Create(ctx, "guest", "", nil, nil, &entities.UserInfo{Tenant: "tenant1"})
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should reject inheritance cycles", func(t *testing.T) {
+		roles := newRoles(t)
+		require.NoError(t, roles.Create(ctx, "a", "", []string{"b"}, nil, admin))
+
+		err := roles.Create(ctx, "b", "", []string{"a"}, nil, admin)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		err = roles.Create(ctx, "self", "", []string{"self"}, nil, admin)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		_, err = roles.getRole(ctx, "", "b")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should invalidate cached permissions when roles change", func(t *testing.T) {
+		roles := newRoles(t)
+		userInfo := &entities.UserInfo{Tenant: "tenant1", Roles: []string{"signer"}}
+		assert.Contains(t, roles.UserPermissions(ctx, userInfo), entities.ReadKey)
+
+		require.NoError(t, roles.Delete(ctx, "reader", admin))
+		assert.NotContains(t, roles.UserPermissions(ctx, userInfo), entities.ReadKey)
+
+		require.NoError(t, roles.Create(ctx, "reader", "", nil, []entities.Permission{entities.ReadSecret}, admin))
+		assert.Contains(t, roles.UserPermissions(ctx, userInfo), entities.ReadSecret)
+	})
+
+	t.Run("should fail to resolve unknown roles", func(t *testing.T) {
+		roles := newRoles(t)
+
+		_, err := roles.RolePermissions(ctx, "", []string{"signer", "unknown"})
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should let deny rules take precedence over inherited permissions", func(t *testing.T) {
+		roles := newRoles(t)
+		require.NoError(t, roles.Create(ctx, "operator", "", []string{"signer"}, []entities.Permission{"*:*", "!destroy:*"}, admin))
+
+		permissions := roles.UserPermissions(ctx, &entities.UserInfo{Roles: []string{"operator"}, Permissions: []entities.Permission{entities.DestroySecret}})
+
+		assert.Contains(t, permissions, entities.SignKey)
+		assert.Contains(t, permissions, entities.Permission("!destroy:*"))
+		assert.NotContains(t, permissions, entities.DestroyKey)
+		assert.NotContains(t, permissions, entities.DestroySecret)
+	})
+
+	t.Run("should keep conditional permissions and validate them", func(t *testing.T) {
+		roles := newRoles(t)
+		require.NoError(t, roles.Create(ctx, "destroyer", "", nil, []entities.Permission{"destroy:keys?auth_mode=tls"}, admin))
+
+		permissions := roles.UserPermissions(ctx, &entities.UserInfo{Roles: []string{"destroyer"}})
+		assert.Equal(t, []entities.Permission{"destroy:keys?auth_mode=tls"}, permissions)
+
+		err := roles.Create(ctx, "invalid", "", nil, []entities.Permission{"destroy:keys?cidr=invalid"}, admin)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should not let users grant conditional permissions they do not have", func(t *testing.T) {
+		roles := newRoles(t)
+		tenantAdmin := &entities.UserInfo{Tenant: "tenant1", Permissions: []entities.Permission{entities.WriteRole, entities.DestroyKey, "!destroy:keys?cidr=10.0.0.0/8"}}
+
+		err := roles.Create(ctx, "destroyer", "", nil, []entities.Permission{"destroy:secrets?auth_mode=tls"}, tenantAdmin)
+		assert.True(t, errors.IsForbiddenError(err))
+
+		err = roles.Create(ctx, "destroyer", "", nil, []entities.Permission{"destroy:keys?auth_mode=tls"}, tenantAdmin)
+		assert.True(t, errors.IsForbiddenError(err))
+
+		require.NoError(t, roles.Create(ctx, "restricted", "", nil, []entities.Permission{"!destroy:*"}, tenantAdmin))
+	})
+
+	t.Run("should include the roles granted temporarily to the user", func(t *testing.T) {
+		mockGrants := dbmock.NewMockGrants(ctrl)
+		roles := New(mockGrants, testutils.NewMockLogger(ctrl))
+		require.NoError(t, roles.Create(ctx, "destroyer", "tenant1", nil, []entities.Permission{entities.DestroyKey}, admin))
+		userInfo := &entities.UserInfo{AuthMode: "jwt", Issuer: "https://idp.example.com/", Tenant: "tenant1", Username: "alice", Permissions: []entities.Permission{entities.ReadKey}}
+
+		grant := &entities.Grant{Role: "destroyer", ExpiresAt: time.Now().Add(time.Hour)}
+		mockGrants.EXPECT().ListActive(gomock.Any(), "tenant1", "alice", "jwt", "https://idp.example.com/", gomock.Any()).Return([]*entities.Grant{grant}, nil)
+		assert.Equal(t, []entities.Permission{entities.ReadKey, entities.DestroyKey}, roles.UserPermissions(ctx, userInfo))
+
+		// Granted roles are cached, the grants are only listed again once the cache entry expires
+		assert.Equal(t, []entities.Permission{entities.ReadKey, entities.DestroyKey}, roles.UserPermissions(ctx, userInfo))
+
+		roles.now = func() time.Time { return time.Now().Add(grantsCacheTTL) }
+		mockGrants.EXPECT().ListActive(gomock.Any(), "tenant1", "alice", "jwt", "https://idp.example.com/", gomock.Any()).Return(nil, errors.DependencyFailureError("error"))
+		assert.Equal(t, []entities.Permission{entities.ReadKey}, roles.UserPermissions(ctx, userInfo))
+
+		// Anonymous users cannot be granted roles
+		assert.Empty(t, roles.UserPermissions(ctx, entities.NewAnonymousUser()))
+	})
+
+	t.Run("should not include the roles granted to the owner of an api key", func(t *testing.T) {
+		mockGrants := dbmock.NewMockGrants(ctrl)
+		roles := New(mockGrants, testutils.NewMockLogger(ctrl))
+		apiKey := &entities.UserInfo{AuthMode: entities.APIKeyAuthMode, Tenant: "tenant1", Username: "alice", Permissions: []entities.Permission{entities.ReadKey}}
+
+		// No grant is looked up for the owner of the api key
+		assert.Equal(t, []entities.Permission{entities.ReadKey}, roles.UserPermissions(ctx, apiKey))
+	})
+}
@@ -2,29 +2,126 @@ package roles
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 )
 
+// grantsCacheTTL bounds the delay for approved, rejected and revoked grants to apply to the permissions of their user
+const grantsCacheTTL = 5 * time.Second
+
+const grantsCacheSweepSize = 1000
+
+// grantedKey identifies the user a grant applies to
+type grantedKey struct {
+	tenant   string
+	username string
+	authMode string
+	issuer   string
+}
+
+type grantedEntry struct {
+	roles     []string
+	expiresAt time.Time
+}
+
 func (i *Roles) UserPermissions(ctx context.Context, userInfo *entities.UserInfo) []entities.Permission {
 	if userInfo == nil {
 		return []entities.Permission{}
 	}
 
-	permissions := userInfo.Permissions
+	permissions := append([]entities.Permission{}, userInfo.Permissions...)
 
-	for _, roleName := range userInfo.Roles {
-		role, err := i.Get(ctx, roleName, userInfo)
+	roles := append(append([]string{}, userInfo.Roles...), i.GrantedRoles(ctx, userInfo)...)
+	for _, roleName := range roles {
+		rolePermissions, err := i.resolvedPermissions(userInfo.Tenant, roleName)
 		if err != nil {
 			continue
 		}
 
-		permissions = append(permissions, role.Permissions...)
-		for _, p := range role.Permissions {
-			permissions = append(permissions, entities.ListWildcardPermission(string(p))...)
-		}
+		permissions = append(permissions, rolePermissions...)
 	}
 
+	// Deny rules of any role apply to the permissions of all the others
+	permissions = entities.ApplyDenyRules(permissions)
+
 	i.logger.Debug("permissions extracted successfully", "tenant", userInfo.Tenant, "username", userInfo.Username, "permissions", permissions)
 	return permissions
 }
+
+func (i *Roles) RolePermissions(_ context.Context, tenant string, roles []string) ([]entities.Permission, error) {
+	var permissions []entities.Permission
+	for _, roleName := range roles {
+		rolePermissions, err := i.resolvedPermissions(tenant, roleName)
+		if err != nil {
+			i.logger.WithError(err).Debug("failed to resolve role permissions", "tenant", tenant, "role", roleName)
+			return nil, err
+		}
+
+		permissions = append(permissions, rolePermissions...)
+	}
+
+	return entities.ApplyDenyRules(permissions), nil
+}
+
+// GrantedRoles returns the roles temporarily granted to the user, grants which cannot be retrieved are ignored.
+// Grants only apply to the authentication mode and issuer they were requested with, they are cached for grantsCacheTTL
+func (i *Roles) GrantedRoles(ctx context.Context, userInfo *entities.UserInfo) []string {
+	// Anonymous and internal users have no authentication mode and cannot be granted roles, API keys are authenticated
+	// with the name of their owner but only carry the roles they were created with
+	if i.grants == nil || userInfo.AuthMode == "" || userInfo.AuthMode == entities.APIKeyAuthMode || userInfo.Username == "" {
+		return nil
+	}
+
+	key := grantedKey{tenant: userInfo.Tenant, username: userInfo.Username, authMode: userInfo.AuthMode, issuer: userInfo.Issuer}
+	if roles, ok := i.cachedGrantedRoles(key); ok {
+		return roles
+	}
+
+	now := i.now()
+	grants, err := i.grants.ListActive(ctx, userInfo.Tenant, userInfo.Username, userInfo.AuthMode, userInfo.Issuer, now)
+	if err != nil {
+		i.logger.WithError(err).Warn("failed to retrieve granted roles", "tenant", userInfo.Tenant, "username", userInfo.Username)
+		return nil
+	}
+
+	var roles []string
+	expiresAt := now.Add(grantsCacheTTL)
+	for _, grant := range grants {
+		roles = append(roles, grant.Role)
+		// A grant expiring before the cache entry must not outlive its expiry
+		if !grant.ExpiresAt.IsZero() && grant.ExpiresAt.Before(expiresAt) {
+			expiresAt = grant.ExpiresAt
+		}
+	}
+
+	i.storeGrantedRoles(key, roles, expiresAt)
+	return roles
+}
+
+func (i *Roles) cachedGrantedRoles(key grantedKey) ([]string, bool) {
+	i.grantedMux.Lock()
+	defer i.grantedMux.Unlock()
+
+	entry, ok := i.granted[key]
+	if !ok || !i.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.roles, true
+}
+
+func (i *Roles) storeGrantedRoles(key grantedKey, roles []string, expiresAt time.Time) {
+	i.grantedMux.Lock()
+	defer i.grantedMux.Unlock()
+
+	if len(i.granted) >= grantsCacheSweepSize {
+		for k, entry := range i.granted {
+			if !i.now().Before(entry.expiresAt) {
+				delete(i.granted, k)
+			}
+		}
+	}
+
+	i.granted[key] = &grantedEntry{roles: roles, expiresAt: expiresAt}
+}
@@ -1,21 +1,38 @@
 package src
 
 import (
+	"time"
+
 	"github.com/consensys/quorum-key-manager/pkg/http/server"
 	"github.com/consensys/quorum-key-manager/src/infra/api-key/csv"
+	"github.com/consensys/quorum-key-manager/src/infra/jwt/introspection"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt/jose"
 	"github.com/consensys/quorum-key-manager/src/infra/log/zap"
 	manifestreader "github.com/consensys/quorum-key-manager/src/infra/manifests/yaml"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	tls "github.com/consensys/quorum-key-manager/src/infra/tls/filesystem"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/identity"
+	"github.com/consensys/quorum-key-manager/src/infra/tls/revocation"
 )
 
 type Config struct {
 	HTTP     *server.Config
 	Logger   *zap.Config
 	Postgres *client.Config
-	OIDC     *jose.Config
+	OIDC     []*jose.Config
 	APIKey   *csv.Config
 	TLS      *tls.Config
 	Manifest *manifestreader.Config
+
+	Introspection *introspection.Config
+	TLSRevocation *revocation.Config
+
+	// TLSIdentityMapping maps client certificates to user claims, the certificate subject is used when not set
+	TLSIdentityMapping *identity.Mapping
+
+	// KeyRotationInterval at which keys due for rotation are rotated, scheduled rotations are disabled when zero
+	KeyRotationInterval time.Duration
+
+	// PurgeInterval at which deleted items whose recovery period has elapsed are destroyed, automatic purges are disabled when zero
+	PurgeInterval time.Duration
 }
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+)
+
+// Cursor is a position in a list sorted by a column and the ID of the items, used for keyset pagination
+type Cursor struct {
+	SortBy   string `json:"s,omitempty"`
+	SortDesc bool   `json:"d,omitempty"`
+	// Value is the value of the sort column of the item, empty when sorted by ID
+	Value string `json:"v,omitempty"`
+	ID    string `json:"id"`
+	// Backward lists the items before the cursor instead of the items after
+	Backward bool `json:"b,omitempty"`
+}
+
+// Encode returns the opaque representation of the cursor exposed to clients
+func (c *Cursor) Encode() string {
+	bCursor, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(bCursor)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	bCursor, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	cursor := &Cursor{}
+	err = json.Unmarshal(bCursor, cursor)
+	if err != nil || cursor.ID == "" {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	return cursor, nil
+}
+
+// PageCursors returns the encoded cursors of the previous and next pages of the n items listed from the current
+// cursor with the given limit, cursorAt returns the cursor of the i-th listed item
+func PageCursors(current *Cursor, n int, limit uint64, cursorAt func(i int) *Cursor) (previous, next string) {
+	if n == 0 {
+		return "", ""
+	}
+
+	backward := current != nil && current.Backward
+	isFull := limit != 0 && uint64(n) == limit
+	if backward || isFull {
+		next = cursorAt(n - 1).Encode()
+	}
+	if (backward && isFull) || (!backward && current != nil) {
+		first := cursorAt(0)
+		first.Backward = true
+		previous = first.Encode()
+	}
+
+	return previous, next
+}
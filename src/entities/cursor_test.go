@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("should encode and decode a cursor successfully", func(t *testing.T) {
+		cursor := &Cursor{SortBy: "created_at", SortDesc: true, Value: "2021-01-01T00:00:00Z", ID: "my-id", Backward: true}
+
+		decoded, err := DecodeCursor(cursor.Encode())
+
+		require.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("should fail with invalid format error if cursor is invalid", func(t *testing.T) {
+		for _, value := range []string{"not base64!", "bm90IGpzb24", (&Cursor{}).Encode()} {
+			_, err := DecodeCursor(value)
+
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+func TestPageCursors(t *testing.T) {
+	cursorAt := func(i int) *Cursor {
+		return &Cursor{ID: []string{"a", "b", "c"}[i]}
+	}
+
+	t.Run("should return the next cursor of a full first page", func(t *testing.T) {
+		previous, next := PageCursors(nil, 3, 3, cursorAt)
+
+		assert.Empty(t, previous)
+		assert.Equal(t, (&Cursor{ID: "c"}).Encode(), next)
+	})
+
+	t.Run("should return the previous cursor of a last page", func(t *testing.T) {
+		previous, next := PageCursors(&Cursor{ID: "z"}, 3, 10, cursorAt)
+
+		assert.Equal(t, (&Cursor{ID: "a", Backward: true}).Encode(), previous)
+		assert.Empty(t, next)
+	})
+
+	t.Run("should return both cursors of a full page listed backward", func(t *testing.T) {
+		previous, next := PageCursors(&Cursor{ID: "d", Backward: true}, 3, 3, cursorAt)
+
+		assert.Equal(t, (&Cursor{ID: "a", Backward: true}).Encode(), previous)
+		assert.Equal(t, (&Cursor{ID: "c"}).Encode(), next)
+	})
+
+	t.Run("should return no cursor for an empty page", func(t *testing.T) {
+		previous, next := PageCursors(&Cursor{ID: "d"}, 0, 3, cursorAt)
+
+		assert.Empty(t, previous)
+		assert.Empty(t, next)
+	})
+}
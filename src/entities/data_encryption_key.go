@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// DataEncryptionKey is the key encrypting the data of a vault, it is only persisted wrapped by a master key
+type DataEncryptionKey struct {
+	Vault      string
+	WrappedKey []byte
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
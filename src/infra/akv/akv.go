@@ -15,7 +15,7 @@ type Client interface {
 }
 
 type SecretClient interface {
-	SetSecret(ctx context.Context, secretName string, value string, tags map[string]string) (keyvault.SecretBundle, error)
+	SetSecret(ctx context.Context, secretName, value, contentType string, tags map[string]string) (keyvault.SecretBundle, error)
 	GetSecret(ctx context.Context, secretName, secretVersion string) (keyvault.SecretBundle, error)
 	ListSecrets(ctx context.Context, maxResults int32) ([]keyvault.SecretItem, error)
 	UpdateSecret(ctx context.Context, secretName string, secretVersion string, expireAt time.Time) (keyvault.SecretBundle, error)
@@ -40,4 +40,5 @@ type KeysClient interface {
 	Sign(ctx context.Context, keyName string, version string, alg keyvault.JSONWebKeySignatureAlgorithm, payload string) (string, error)
 	Encrypt(ctx context.Context, keyName string, version string, alg keyvault.JSONWebKeyEncryptionAlgorithm, payload string) (string, error)
 	Decrypt(ctx context.Context, keyName string, version string, alg keyvault.JSONWebKeyEncryptionAlgorithm, value string) (string, error)
+	IsManagedHSM() bool
 }
@@ -1,14 +1,20 @@
 package client
 
 import (
+	"crypto/tls"
+	"net/http"
+
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault/keyvaultapi"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/consensys/quorum-key-manager/pkg/http/resilience"
 	"github.com/consensys/quorum-key-manager/src/infra/akv"
 )
 
 type AKVClient struct {
-	client keyvaultapi.BaseClientAPI
-	cfg    *Config
+	client    keyvaultapi.BaseClientAPI
+	cfg       *Config
+	transport *resilience.Transport
 }
 
 var _ akv.Client = &AKVClient{}
@@ -22,5 +28,31 @@ func NewClient(cfg *Config) (*AKVClient, error) {
 	}
 	client.Authorizer = authorizer
 
-	return &AKVClient{client: client, cfg: cfg}, nil
+	transport := resilience.NewTransport(newHTTPTransport(), cfg.Limits)
+	client.Sender = &http.Client{Transport: transport}
+	if cfg.Limits.MaxRetries > 0 {
+		// Retries are performed by the transport with jittered back-off, the default autorest retries are then disabled
+		client.SendDecorators = []autorest.SendDecorator{}
+	}
+
+	return &AKVClient{client: client, cfg: cfg, transport: transport}, nil
+}
+
+// CircuitBreaker returns the circuit breaker protecting the vault from requests while it is failing
+func (c *AKVClient) CircuitBreaker() *resilience.CircuitBreaker {
+	return c.transport.CircuitBreaker()
+}
+
+func newHTTPTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	return transport
+}
+
+// IsManagedHSM indicates whether the client targets an Azure Key Vault Managed HSM pool instead of a standard Key Vault
+func (c *AKVClient) IsManagedHSM() bool {
+	return c.cfg.ManagedHSM
 }
@@ -2,10 +2,12 @@ package client
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/consensys/quorum-key-manager/pkg/http/resilience"
 	"github.com/consensys/quorum-key-manager/src/entities"
 )
 
@@ -22,15 +24,47 @@ type Config struct {
 	Password            string
 	EnvironmentName     string
 	Resource            string
+	FederatedTokenFile  string
+	UseManagedIdentity  bool
+	ManagedHSM          bool
+	Limits              *resilience.Config
 }
 
 func NewConfig(cfg *entities.AzureConfig) *Config {
-	return &Config{
-		Endpoint:     fmt.Sprintf("https://%s.%s", cfg.VaultName, azure.PublicCloud.KeyVaultDNSSuffix),
-		TenantID:     cfg.TenantID,
-		ClientID:     cfg.ClientID,
-		ClientSecret: cfg.ClientSecret,
+	env, err := azure.EnvironmentFromName(cfg.Environment)
+	if err != nil {
+		// Invalid environment names are reported when building the authorizer
+		env = azure.PublicCloud
 	}
+
+	config := &Config{
+		Endpoint:            fmt.Sprintf("https://%s.%s", cfg.VaultName, env.KeyVaultDNSSuffix),
+		TenantID:            cfg.TenantID,
+		ClientID:            cfg.ClientID,
+		ClientSecret:        cfg.ClientSecret,
+		CertificatePath:     cfg.ClientCertificatePath,
+		CertificatePassword: cfg.ClientCertificatePassword,
+		EnvironmentName:     cfg.Environment,
+		FederatedTokenFile:  cfg.FederatedTokenFile,
+		UseManagedIdentity:  cfg.UseManagedIdentity,
+		ManagedHSM:          cfg.ManagedHSM,
+		Limits: (&resilience.Config{
+			RateLimit:        cfg.RateLimit,
+			BurstLimit:       cfg.BurstLimit,
+			MaxRetries:       cfg.MaxRetries,
+			BreakerThreshold: cfg.CircuitBreakerThreshold,
+			BreakerTimeout:   cfg.CircuitBreakerTimeout,
+		}).SetDefault(),
+	}
+
+	if cfg.ManagedHSM {
+		// Managed HSM pools live under "managedhsm.<suffix>" instead of "vault.<suffix>" and use it as OAuth resource
+		dnsSuffix := "managedhsm." + strings.TrimPrefix(env.KeyVaultDNSSuffix, "vault.")
+		config.Endpoint = fmt.Sprintf("https://%s.%s", cfg.VaultName, dnsSuffix)
+		config.Resource = fmt.Sprintf("https://%s", dnsSuffix)
+	}
+
+	return config
 }
 
 // ToAzureAuthConfig  Inspired by NewAuthorizerFromEnvironmentWithResource from github.com/azure/go-autorest/autorest/azure/auth@v0.5.7/auth.go (https://github.com/Azure/go-autorest/blob/master/autorest/azure/auth/auth.go)
@@ -46,7 +80,15 @@ func (c *Config) ToAzureAuthConfig() (autorest.Authorizer, error) {
 	}
 
 	settings.Values[auth.Resource] = resource
-	return settings.GetAuthorizer()
+
+	switch {
+	case c.FederatedTokenFile != "":
+		return newFederatedTokenAuthorizer(settings, c.FederatedTokenFile)
+	case c.UseManagedIdentity:
+		return settings.GetMSI().Authorizer()
+	default:
+		return settings.GetAuthorizer()
+	}
 }
 
 // Inspired by getResource from services/keyvault/auth/auth.go (https://github.com/Azure/azure-sdk-for-go/blob/master/services/keyvault/auth/auth.go)
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfig(t *testing.T) {
+	t.Run("should target a standard key vault by default", func(t *testing.T) {
+		cfg := NewConfig(&entities.AzureConfig{VaultName: "my-vault"})
+
+		assert.Equal(t, "https://my-vault.vault.azure.net", cfg.Endpoint)
+		assert.Empty(t, cfg.Resource)
+		assert.False(t, cfg.ManagedHSM)
+	})
+
+	t.Run("should target a managed HSM pool", func(t *testing.T) {
+		cfg := NewConfig(&entities.AzureConfig{VaultName: "my-hsm", ManagedHSM: true})
+
+		assert.Equal(t, "https://my-hsm.managedhsm.azure.net", cfg.Endpoint)
+		assert.Equal(t, "https://managedhsm.azure.net", cfg.Resource)
+		assert.True(t, cfg.ManagedHSM)
+	})
+
+	t.Run("should use the DNS suffix of the environment", func(t *testing.T) {
+		cfg := NewConfig(&entities.AzureConfig{VaultName: "my-hsm", ManagedHSM: true, Environment: "AzureUSGovernmentCloud"})
+
+		assert.Equal(t, "https://my-hsm.managedhsm.usgovcloudapi.net", cfg.Endpoint)
+	})
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+)
+
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// federatedTokenSecret implements adal.ServicePrincipalSecret for workload identity federation.
+// The token file is read on every refresh as it is periodically rotated by the orchestrator (e.g. Kubernetes projected volume)
+type federatedTokenSecret struct {
+	tokenFile string
+}
+
+var _ adal.ServicePrincipalSecret = &federatedTokenSecret{}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, values *url.Values) error {
+	token, err := ioutil.ReadFile(s.tokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read federated token file: %w", err)
+	}
+
+	values.Set("client_assertion", strings.TrimSpace(string(token)))
+	values.Set("client_assertion_type", clientAssertionType)
+	return nil
+}
+
+func newFederatedTokenAuthorizer(settings auth.EnvironmentSettings, tokenFile string) (autorest.Authorizer, error) {
+	oauthConfig, err := adal.NewOAuthConfig(settings.Environment.ActiveDirectoryEndpoint, settings.Values[auth.TenantID])
+	if err != nil {
+		return nil, err
+	}
+
+	spToken, err := adal.NewServicePrincipalTokenWithSecret(
+		*oauthConfig,
+		settings.Values[auth.ClientID],
+		settings.Values[auth.Resource],
+		&federatedTokenSecret{tokenFile: tokenFile},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spToken), nil
+}
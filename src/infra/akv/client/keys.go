@@ -26,11 +26,17 @@ func (c *AKVClient) CreateKey(ctx context.Context, keyName string, kty keyvault.
 }
 
 func (c *AKVClient) ImportKey(ctx context.Context, keyName string, k *keyvault.JSONWebKey, attr *keyvault.KeyAttributes, tags map[string]string) (keyvault.KeyBundle, error) {
-	result, err := c.client.ImportKey(ctx, c.cfg.Endpoint, keyName, keyvault.KeyImportParameters{
+	params := keyvault.KeyImportParameters{
 		Key:           k,
 		Tags:          common.Tomapstrptr(tags),
 		KeyAttributes: attr,
-	})
+	}
+	if c.cfg.ManagedHSM {
+		// Imported keys must be protected by the HSM
+		params.Hsm = &c.cfg.ManagedHSM
+	}
+
+	result, err := c.client.ImportKey(ctx, c.cfg.Endpoint, keyName, params)
 	if err != nil {
 		return result, parseErrorResponse(err)
 	}
@@ -10,11 +10,16 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/common"
 )
 
-func (c *AKVClient) SetSecret(ctx context.Context, secretName, value string, tags map[string]string) (keyvault.SecretBundle, error) {
-	result, err := c.client.SetSecret(ctx, c.cfg.Endpoint, secretName, keyvault.SecretSetParameters{
+func (c *AKVClient) SetSecret(ctx context.Context, secretName, value, contentType string, tags map[string]string) (keyvault.SecretBundle, error) {
+	params := keyvault.SecretSetParameters{
 		Value: &value,
 		Tags:  common.Tomapstrptr(tags),
-	})
+	}
+	if contentType != "" {
+		params.ContentType = &contentType
+	}
+
+	result, err := c.client.SetSecret(ctx, c.cfg.Endpoint, secretName, params)
 	if err != nil {
 		return result, parseErrorResponse(err)
 	}
@@ -36,18 +36,18 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // SetSecret mocks base method
-func (m *MockClient) SetSecret(ctx context.Context, secretName, value string, tags map[string]string) (keyvault.SecretBundle, error) {
+func (m *MockClient) SetSecret(ctx context.Context, secretName, value, contentType string, tags map[string]string) (keyvault.SecretBundle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSecret", ctx, secretName, value, tags)
+	ret := m.ctrl.Call(m, "SetSecret", ctx, secretName, value, contentType, tags)
 	ret0, _ := ret[0].(keyvault.SecretBundle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetSecret indicates an expected call of SetSecret
-func (mr *MockClientMockRecorder) SetSecret(ctx, secretName, value, tags interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetSecret(ctx, secretName, value, contentType, tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockClient)(nil).SetSecret), ctx, secretName, value, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockClient)(nil).SetSecret), ctx, secretName, value, contentType, tags)
 }
 
 // GetSecret mocks base method
@@ -365,6 +365,20 @@ func (mr *MockClientMockRecorder) Decrypt(ctx, keyName, version, alg, value inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockClient)(nil).Decrypt), ctx, keyName, version, alg, value)
 }
 
+// IsManagedHSM mocks base method
+func (m *MockClient) IsManagedHSM() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsManagedHSM")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsManagedHSM indicates an expected call of IsManagedHSM
+func (mr *MockClientMockRecorder) IsManagedHSM() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManagedHSM", reflect.TypeOf((*MockClient)(nil).IsManagedHSM))
+}
+
 // MockSecretClient is a mock of SecretClient interface
 type MockSecretClient struct {
 	ctrl     *gomock.Controller
@@ -389,18 +403,18 @@ func (m *MockSecretClient) EXPECT() *MockSecretClientMockRecorder {
 }
 
 // SetSecret mocks base method
-func (m *MockSecretClient) SetSecret(ctx context.Context, secretName, value string, tags map[string]string) (keyvault.SecretBundle, error) {
+func (m *MockSecretClient) SetSecret(ctx context.Context, secretName, value, contentType string, tags map[string]string) (keyvault.SecretBundle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSecret", ctx, secretName, value, tags)
+	ret := m.ctrl.Call(m, "SetSecret", ctx, secretName, value, contentType, tags)
 	ret0, _ := ret[0].(keyvault.SecretBundle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetSecret indicates an expected call of SetSecret
-func (mr *MockSecretClientMockRecorder) SetSecret(ctx, secretName, value, tags interface{}) *gomock.Call {
+func (mr *MockSecretClientMockRecorder) SetSecret(ctx, secretName, value, contentType, tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockSecretClient)(nil).SetSecret), ctx, secretName, value, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockSecretClient)(nil).SetSecret), ctx, secretName, value, contentType, tags)
 }
 
 // GetSecret mocks base method
@@ -740,3 +754,17 @@ func (mr *MockKeysClientMockRecorder) Decrypt(ctx, keyName, version, alg, value
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeysClient)(nil).Decrypt), ctx, keyName, version, alg, value)
 }
+
+// IsManagedHSM mocks base method
+func (m *MockKeysClient) IsManagedHSM() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsManagedHSM")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsManagedHSM indicates an expected call of IsManagedHSM
+func (mr *MockKeysClientMockRecorder) IsManagedHSM() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManagedHSM", reflect.TypeOf((*MockKeysClient)(nil).IsManagedHSM))
+}
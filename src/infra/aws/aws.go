@@ -31,15 +31,21 @@ type SecretsManagerClient interface {
 }
 
 type KmsClient interface {
-	CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error)
+	CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error)
+	ImportKey(ctx context.Context, id, keyType string, keyMaterial []byte, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error)
+	ReplicateKey(ctx context.Context, id, keyID, region string, tags []*kms.Tag) (*kms.ReplicateKeyOutput, error)
+	ImportKeyMaterial(ctx context.Context, keyID, region string, keyMaterial []byte) error
 	GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error)
 	ListKeys(ctx context.Context, limit int64, marker string) (*kms.ListKeysOutput, error)
 	ListTags(ctx context.Context, keyID, marker string) (*kms.ListResourceTagsOutput, error)
 	DescribeKey(ctx context.Context, id string) (*kms.DescribeKeyOutput, error)
 	Sign(ctx context.Context, keyID string, msg []byte, signingAlgorithm string) (*kms.SignOutput, error)
 	DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleKeyDeletionOutput, error)
+	DeleteOrphanKey(ctx context.Context, id, keyID, region string) error
 	RestoreKey(ctx context.Context, keyID string) (*kms.CancelKeyDeletionOutput, error)
 	GetAlias(ctx context.Context, keyID string) (string, error)
 	TagResource(ctx context.Context, keyID string, tags []*kms.Tag) (*kms.TagResourceOutput, error)
 	UntagResource(ctx context.Context, keyID string, tagKeys []*string) (*kms.UntagResourceOutput, error)
+	Encrypt(ctx context.Context, keyID string, plaintext []byte) (*kms.EncryptOutput, error)
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte) (*kms.DecryptOutput, error)
 }
@@ -1,12 +1,14 @@
 package client
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/quorum-key-manager/pkg/http/resilience"
 	awsinfra "github.com/consensys/quorum-key-manager/src/infra/aws"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 )
@@ -14,6 +16,8 @@ import (
 type AWSClient struct {
 	secretsClient *secretsmanager.SecretsManager
 	kmsClient     *kms.KMS
+	session       *session.Session
+	transport     *resilience.Transport
 	cfg           *Config
 	backOff       backoff.BackOff
 	logger        log.Logger
@@ -22,13 +26,24 @@ type AWSClient struct {
 var _ awsinfra.Client = &AWSClient{}
 
 func New(cfg *Config, logger log.Logger) (*AWSClient, error) {
-	sess, err := session.NewSession(cfg.ToAWSConfig())
+	// The session expects a *http.Transport to load custom CA bundles, so it is wrapped once the session is created
+	awsConfig := cfg.ToAWSConfig()
+	awsConfig.HTTPClient = &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+	sess, err := session.NewSession(awsConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	// Retries are performed by the AWS retryer, the transport only applies rate limiting and circuit breaking
+	limits := *cfg.Limits
+	limits.MaxRetries = 0
+	transport := resilience.NewTransport(sess.Config.HTTPClient.Transport, &limits)
+	sess.Config.HTTPClient.Transport = transport
+
 	return &AWSClient{
 		kmsClient:     kms.New(sess),
+		session:       sess,
+		transport:     transport,
 		secretsClient: secretsmanager.New(sess),
 		// Max wait of 5 seconds to wait for KMS to transition the state of assets
 		backOff: backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 5),
@@ -36,3 +51,8 @@ func New(cfg *Config, logger log.Logger) (*AWSClient, error) {
 		logger:  logger,
 	}, nil
 }
+
+// CircuitBreaker returns the circuit breaker protecting the vault from requests while it is failing
+func (c *AWSClient) CircuitBreaker() *resilience.CircuitBreaker {
+	return c.transport.CircuitBreaker()
+}
@@ -2,7 +2,9 @@ package client
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/consensys/quorum-key-manager/pkg/http/resilience"
 	"github.com/consensys/quorum-key-manager/src/entities"
 )
 
@@ -11,6 +13,7 @@ type Config struct {
 	AccessID  string
 	SecretKey string
 	Debug     bool
+	Limits    *resilience.Config
 }
 
 func NewConfig(cfg *entities.AWSConfig) *Config {
@@ -19,6 +22,13 @@ func NewConfig(cfg *entities.AWSConfig) *Config {
 		AccessID:  cfg.AccessID,
 		SecretKey: cfg.SecretKey,
 		Debug:     cfg.Debug,
+		Limits: (&resilience.Config{
+			RateLimit:        cfg.RateLimit,
+			BurstLimit:       cfg.BurstLimit,
+			MaxRetries:       cfg.MaxRetries,
+			BreakerThreshold: cfg.CircuitBreakerThreshold,
+			BreakerTimeout:   cfg.CircuitBreakerTimeout,
+		}).SetDefault(),
 	}
 }
 
@@ -28,6 +38,17 @@ func (c *Config) ToAWSConfig() *aws.Config {
 		Region:      aws.String(c.Region),
 	}
 
+	if c.Limits != nil && c.Limits.MaxRetries > 0 {
+		// The AWS retryer applies jittered exponential back-off and knows AWS specific throttling errors
+		awsConfig.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    c.Limits.MaxRetries,
+			MinRetryDelay:    c.Limits.MinBackOff,
+			MaxRetryDelay:    c.Limits.MaxBackOff,
+			MinThrottleDelay: c.Limits.MinBackOff,
+			MaxThrottleDelay: c.Limits.MaxBackOff,
+		}
+	}
+
 	if c.Debug {
 		awsConfig.WithLogLevel(aws.LogDebug)
 		awsConfig.CredentialsChainVerboseErrors = aws.Bool(true)
@@ -2,6 +2,10 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"fmt"
 	"strings"
 	"time"
@@ -22,14 +26,15 @@ const (
 	KeyStateDisabled        = "Disabled"
 )
 
-func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
+func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error) {
 	// Always create with same usage for key now (sign & verify)
 	keyUsage := kms.KeyUsageTypeSignVerify
 
 	out, err := c.kmsClient.CreateKey(&kms.CreateKeyInput{
-		KeySpec:  &keyType,
-		KeyUsage: &keyUsage,
-		Tags:     tags,
+		KeySpec:     &keyType,
+		KeyUsage:    &keyUsage,
+		Tags:        tags,
+		MultiRegion: &multiRegion,
 	})
 	if err != nil {
 		return nil, parseKmsErrorResponse(err)
@@ -43,7 +48,7 @@ func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags [
 		return nil, parseKmsErrorResponse(err)
 	}
 
-	err = c.waitKeyState(ctx, keyID, func(metadata *kms.KeyMetadata) error {
+	err = c.waitKeyState(ctx, c.kmsClient, keyID, func(metadata *kms.KeyMetadata) error {
 		if *metadata.Enabled {
 			return nil
 		}
@@ -56,6 +61,91 @@ func (c *AWSClient) CreateKey(ctx context.Context, keyID, keyType string, tags [
 	return out, nil
 }
 
+func (c *AWSClient) ImportKey(ctx context.Context, keyID, keyType string, keyMaterial []byte, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error) {
+	keyUsage := kms.KeyUsageTypeSignVerify
+	origin := kms.OriginTypeExternal
+
+	out, err := c.kmsClient.CreateKey(&kms.CreateKeyInput{
+		KeySpec:     &keyType,
+		KeyUsage:    &keyUsage,
+		Origin:      &origin,
+		Tags:        tags,
+		MultiRegion: &multiRegion,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	err = importKeyMaterial(c.kmsClient, *out.KeyMetadata.KeyId, keyMaterial)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.kmsClient.CreateAlias(&kms.CreateAliasInput{
+		AliasName:   &keyID,
+		TargetKeyId: out.KeyMetadata.KeyId,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	err = c.waitKeyState(ctx, c.kmsClient, keyID, func(metadata *kms.KeyMetadata) error {
+		if *metadata.Enabled {
+			return nil
+		}
+		return fmt.Errorf("key %s is still in not enabled", keyID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ReplicateKey creates a replica of a multi-region primary key in the given region, aliased with the same id
+func (c *AWSClient) ReplicateKey(ctx context.Context, keyID, primaryKeyID, region string, tags []*kms.Tag) (*kms.ReplicateKeyOutput, error) {
+	out, err := c.kmsClient.ReplicateKey(&kms.ReplicateKeyInput{
+		KeyId:         &primaryKeyID,
+		ReplicaRegion: &region,
+		Tags:          tags,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	regionClient := c.regionKmsClient(region)
+	_, err = regionClient.CreateAlias(&kms.CreateAliasInput{
+		AliasName:   &keyID,
+		TargetKeyId: out.ReplicaKeyMetadata.KeyId,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	// Replicas of imported keys wait for their key material instead of being enabled
+	err = c.waitKeyState(ctx, regionClient, keyID, func(metadata *kms.KeyMetadata) error {
+		if *metadata.KeyState != KeyStateCreating {
+			return nil
+		}
+		return fmt.Errorf("replica key %s is still being created in %s", keyID, region)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ImportKeyMaterial imports the key material of a key pending import in the given region, or in the client region if empty
+func (c *AWSClient) ImportKeyMaterial(_ context.Context, keyID, region string, keyMaterial []byte) error {
+	kmsClient := c.kmsClient
+	if region != "" {
+		kmsClient = c.regionKmsClient(region)
+	}
+
+	return importKeyMaterial(kmsClient, keyID, keyMaterial)
+}
+
 func (c *AWSClient) GetPublicKey(_ context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
 	out, err := c.kmsClient.GetPublicKey(&kms.GetPublicKeyInput{
 		KeyId: &keyID,
@@ -148,7 +238,7 @@ func (c *AWSClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleK
 		return nil, parseKmsErrorResponse(err)
 	}
 
-	err = c.waitKeyState(ctx, keyID, func(metadata *kms.KeyMetadata) error {
+	err = c.waitKeyState(ctx, c.kmsClient, keyID, func(metadata *kms.KeyMetadata) error {
 		if !*metadata.Enabled && *metadata.KeyState == KeyStatePendingDeletion {
 			return nil
 		}
@@ -162,6 +252,37 @@ func (c *AWSClient) DeleteKey(ctx context.Context, keyID string) (*kms.ScheduleK
 	return out, nil
 }
 
+// orphanKeyPendingWindowInDays is the shortest waiting period before the deletion of a key, orphan keys were never used
+const orphanKeyPendingWindowInDays = 7
+
+// DeleteOrphanKey schedules the deletion of a key left behind by a failed creation, of its replica when a region is
+// given, and deletes its alias so that the key can be created again. Keys or aliases which do not exist are ignored
+func (c *AWSClient) DeleteOrphanKey(_ context.Context, id, keyID, region string) error {
+	kmsClient := c.kmsClient
+	if region != "" {
+		kmsClient = c.regionKmsClient(region)
+	}
+
+	_, err := kmsClient.DeleteAlias(&kms.DeleteAliasInput{AliasName: &id})
+	if err != nil {
+		if err = parseKmsErrorResponse(err); !errors.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	_, err = kmsClient.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+		KeyId:               &keyID,
+		PendingWindowInDays: aws.Int64(orphanKeyPendingWindowInDays),
+	})
+	if err != nil {
+		if err = parseKmsErrorResponse(err); !errors.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *AWSClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKeyDeletionOutput, error) {
 	out, err := c.kmsClient.CancelKeyDeletion(&kms.CancelKeyDeletionInput{
 		KeyId: &keyID,
@@ -170,7 +291,7 @@ func (c *AWSClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKe
 		return nil, parseKmsErrorResponse(err)
 	}
 
-	err = c.waitKeyState(ctx, keyID, func(metadata *kms.KeyMetadata) error {
+	err = c.waitKeyState(ctx, c.kmsClient, keyID, func(metadata *kms.KeyMetadata) error {
 		if *metadata.KeyState == KeyStatePendingDeletion {
 			return fmt.Errorf("key %s is still pending for deletion deletion", keyID)
 		}
@@ -187,7 +308,7 @@ func (c *AWSClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKe
 		return nil, parseKmsErrorResponse(err)
 	}
 
-	err = c.waitKeyState(ctx, keyID, func(metadata *kms.KeyMetadata) error {
+	err = c.waitKeyState(ctx, c.kmsClient, keyID, func(metadata *kms.KeyMetadata) error {
 		if *metadata.Enabled {
 			return nil
 		}
@@ -224,9 +345,37 @@ func (c *AWSClient) UntagResource(_ context.Context, keyID string, tagKeys []*st
 	return outUntagResource, nil
 }
 
-func (c *AWSClient) waitKeyState(ctx context.Context, keyID string, stateCheck func(metadata *kms.KeyMetadata) error) error {
+func (c *AWSClient) Encrypt(_ context.Context, keyID string, plaintext []byte) (*kms.EncryptOutput, error) {
+	out, err := c.kmsClient.Encrypt(&kms.EncryptInput{
+		KeyId:     &keyID,
+		Plaintext: plaintext,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *AWSClient) Decrypt(_ context.Context, keyID string, ciphertext []byte) (*kms.DecryptOutput, error) {
+	out, err := c.kmsClient.Decrypt(&kms.DecryptInput{
+		KeyId:          &keyID,
+		CiphertextBlob: ciphertext,
+	})
+	if err != nil {
+		return nil, parseKmsErrorResponse(err)
+	}
+
+	return out, nil
+}
+
+func (c *AWSClient) regionKmsClient(region string) *kms.KMS {
+	return kms.New(c.session, aws.NewConfig().WithRegion(region))
+}
+
+func (c *AWSClient) waitKeyState(_ context.Context, kmsClient *kms.KMS, keyID string, stateCheck func(metadata *kms.KeyMetadata) error) error {
 	return backoff.RetryNotify(func() error {
-		descData, err := c.DescribeKey(ctx, keyID)
+		descData, err := kmsClient.DescribeKey(&kms.DescribeKeyInput{KeyId: &keyID})
 		if err != nil {
 			return parseKmsErrorResponse(err)
 		}
@@ -244,3 +393,44 @@ func (c *AWSClient) waitKeyState(ctx context.Context, keyID string, stateCheck f
 		},
 	)
 }
+
+// importKeyMaterial wraps the key material with the wrapping key provided by KMS and imports it
+func importKeyMaterial(kmsClient *kms.KMS, keyID string, keyMaterial []byte) error {
+	wrappingAlgorithm := kms.AlgorithmSpecRsaesOaepSha256
+	wrappingKeySpec := kms.WrappingKeySpecRsa2048
+	params, err := kmsClient.GetParametersForImport(&kms.GetParametersForImportInput{
+		KeyId:             &keyID,
+		WrappingAlgorithm: &wrappingAlgorithm,
+		WrappingKeySpec:   &wrappingKeySpec,
+	})
+	if err != nil {
+		return parseKmsErrorResponse(err)
+	}
+
+	wrappingKey, err := x509.ParsePKIXPublicKey(params.PublicKey)
+	if err != nil {
+		return errors.AWSError("failed to parse AWS wrapping key")
+	}
+	rsaWrappingKey, ok := wrappingKey.(*rsa.PublicKey)
+	if !ok {
+		return errors.AWSError("invalid AWS wrapping key type")
+	}
+
+	encryptedKeyMaterial, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaWrappingKey, keyMaterial, nil)
+	if err != nil {
+		return errors.CryptoOperationError("failed to wrap key material")
+	}
+
+	expirationModel := kms.ExpirationModelTypeKeyMaterialDoesNotExpire
+	_, err = kmsClient.ImportKeyMaterial(&kms.ImportKeyMaterialInput{
+		KeyId:                &keyID,
+		ImportToken:          params.ImportToken,
+		EncryptedKeyMaterial: encryptedKeyMaterial,
+		ExpirationModel:      &expirationModel,
+	})
+	if err != nil {
+		return parseKmsErrorResponse(err)
+	}
+
+	return nil
+}
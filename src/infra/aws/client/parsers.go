@@ -2,6 +2,7 @@ package client
 
 import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
@@ -13,6 +14,10 @@ func parseSecretsManagerErrorResponse(err error) error {
 		return errors.AWSError(err.Error())
 	}
 
+	if request.IsErrorThrottle(aerr) {
+		return errors.TooManyRequestError(aerr.Error())
+	}
+
 	switch aerr.Code() {
 	case secretsmanager.ErrCodeResourceExistsException:
 		return errors.AlreadyExistsError(aerr.Error())
@@ -38,6 +43,10 @@ func parseKmsErrorResponse(err error) error {
 		return errors.AWSError(err.Error())
 	}
 
+	if request.IsErrorThrottle(aerr) {
+		return errors.TooManyRequestError(aerr.Error())
+	}
+
 	switch aerr.Code() {
 	case kms.ErrCodeNotFoundException:
 		return errors.NotFoundError(aerr.Error())
@@ -187,21 +187,6 @@ func (mr *MockClientMockRecorder) DestroySecret(ctx, id interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockClient)(nil).DestroySecret), ctx, id)
 }
 
-// CreateKey mocks base method
-func (m *MockClient) CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", ctx, id, keyType, tags)
-	ret0, _ := ret[0].(*kms.CreateKeyOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateKey indicates an expected call of CreateKey
-func (mr *MockClientMockRecorder) CreateKey(ctx, id, keyType, tags interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockClient)(nil).CreateKey), ctx, id, keyType, tags)
-}
-
 // GetPublicKey mocks base method
 func (m *MockClient) GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +277,20 @@ func (mr *MockClientMockRecorder) DeleteKey(ctx, keyID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKey", reflect.TypeOf((*MockClient)(nil).DeleteKey), ctx, keyID)
 }
 
+// DeleteOrphanKey mocks base method
+func (m *MockClient) DeleteOrphanKey(ctx context.Context, id, keyID, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanKey", ctx, id, keyID, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrphanKey indicates an expected call of DeleteOrphanKey
+func (mr *MockClientMockRecorder) DeleteOrphanKey(ctx, id, keyID, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanKey", reflect.TypeOf((*MockClient)(nil).DeleteOrphanKey), ctx, id, keyID, region)
+}
+
 // RestoreKey mocks base method
 func (m *MockClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKeyDeletionOutput, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +351,95 @@ func (mr *MockClientMockRecorder) UntagResource(ctx, keyID, tagKeys interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockClient)(nil).UntagResource), ctx, keyID, tagKeys)
 }
 
+// Encrypt mocks base method
+func (m *MockClient) Encrypt(ctx context.Context, keyID string, plaintext []byte) (*kms.EncryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, keyID, plaintext)
+	ret0, _ := ret[0].(*kms.EncryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockClientMockRecorder) Encrypt(ctx, keyID, plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockClient)(nil).Encrypt), ctx, keyID, plaintext)
+}
+
+// Decrypt mocks base method
+func (m *MockClient) Decrypt(ctx context.Context, keyID string, ciphertext []byte) (*kms.DecryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, keyID, ciphertext)
+	ret0, _ := ret[0].(*kms.DecryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockClientMockRecorder) Decrypt(ctx, keyID, ciphertext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockClient)(nil).Decrypt), ctx, keyID, ciphertext)
+}
+
+// CreateKey mocks base method
+func (m *MockClient) CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, id, keyType, tags, multiRegion)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey
+func (mr *MockClientMockRecorder) CreateKey(ctx, id, keyType, tags, multiRegion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockClient)(nil).CreateKey), ctx, id, keyType, tags, multiRegion)
+}
+
+// ImportKey mocks base method
+func (m *MockClient) ImportKey(ctx context.Context, id, keyType string, keyMaterial []byte, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKey", ctx, id, keyType, keyMaterial, tags, multiRegion)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey
+func (mr *MockClientMockRecorder) ImportKey(ctx, id, keyType, keyMaterial, tags, multiRegion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockClient)(nil).ImportKey), ctx, id, keyType, keyMaterial, tags, multiRegion)
+}
+
+// ReplicateKey mocks base method
+func (m *MockClient) ReplicateKey(ctx context.Context, id, keyID, region string, tags []*kms.Tag) (*kms.ReplicateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateKey", ctx, id, keyID, region, tags)
+	ret0, _ := ret[0].(*kms.ReplicateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicateKey indicates an expected call of ReplicateKey
+func (mr *MockClientMockRecorder) ReplicateKey(ctx, id, keyID, region, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateKey", reflect.TypeOf((*MockClient)(nil).ReplicateKey), ctx, id, keyID, region, tags)
+}
+
+// ImportKeyMaterial mocks base method
+func (m *MockClient) ImportKeyMaterial(ctx context.Context, keyID, region string, keyMaterial []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKeyMaterial", ctx, keyID, region, keyMaterial)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportKeyMaterial indicates an expected call of ImportKeyMaterial
+func (mr *MockClientMockRecorder) ImportKeyMaterial(ctx, keyID, region, keyMaterial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKeyMaterial", reflect.TypeOf((*MockClient)(nil).ImportKeyMaterial), ctx, keyID, region, keyMaterial)
+}
+
 // MockSecretsManagerClient is a mock of SecretsManagerClient interface
 type MockSecretsManagerClient struct {
 	ctrl     *gomock.Controller
@@ -549,21 +637,6 @@ func (m *MockKmsClient) EXPECT() *MockKmsClientMockRecorder {
 	return m.recorder
 }
 
-// CreateKey mocks base method
-func (m *MockKmsClient) CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag) (*kms.CreateKeyOutput, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", ctx, id, keyType, tags)
-	ret0, _ := ret[0].(*kms.CreateKeyOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CreateKey indicates an expected call of CreateKey
-func (mr *MockKmsClientMockRecorder) CreateKey(ctx, id, keyType, tags interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockKmsClient)(nil).CreateKey), ctx, id, keyType, tags)
-}
-
 // GetPublicKey mocks base method
 func (m *MockKmsClient) GetPublicKey(ctx context.Context, keyID string) (*kms.GetPublicKeyOutput, error) {
 	m.ctrl.T.Helper()
@@ -654,6 +727,20 @@ func (mr *MockKmsClientMockRecorder) DeleteKey(ctx, keyID interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKey", reflect.TypeOf((*MockKmsClient)(nil).DeleteKey), ctx, keyID)
 }
 
+// DeleteOrphanKey mocks base method
+func (m *MockKmsClient) DeleteOrphanKey(ctx context.Context, id, keyID, region string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanKey", ctx, id, keyID, region)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrphanKey indicates an expected call of DeleteOrphanKey
+func (mr *MockKmsClientMockRecorder) DeleteOrphanKey(ctx, id, keyID, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanKey", reflect.TypeOf((*MockKmsClient)(nil).DeleteOrphanKey), ctx, id, keyID, region)
+}
+
 // RestoreKey mocks base method
 func (m *MockKmsClient) RestoreKey(ctx context.Context, keyID string) (*kms.CancelKeyDeletionOutput, error) {
 	m.ctrl.T.Helper()
@@ -713,3 +800,92 @@ func (mr *MockKmsClientMockRecorder) UntagResource(ctx, keyID, tagKeys interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockKmsClient)(nil).UntagResource), ctx, keyID, tagKeys)
 }
+
+// Encrypt mocks base method
+func (m *MockKmsClient) Encrypt(ctx context.Context, keyID string, plaintext []byte) (*kms.EncryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, keyID, plaintext)
+	ret0, _ := ret[0].(*kms.EncryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockKmsClientMockRecorder) Encrypt(ctx, keyID, plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKmsClient)(nil).Encrypt), ctx, keyID, plaintext)
+}
+
+// Decrypt mocks base method
+func (m *MockKmsClient) Decrypt(ctx context.Context, keyID string, ciphertext []byte) (*kms.DecryptOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, keyID, ciphertext)
+	ret0, _ := ret[0].(*kms.DecryptOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockKmsClientMockRecorder) Decrypt(ctx, keyID, ciphertext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKmsClient)(nil).Decrypt), ctx, keyID, ciphertext)
+}
+
+// CreateKey mocks base method
+func (m *MockKmsClient) CreateKey(ctx context.Context, id, keyType string, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, id, keyType, tags, multiRegion)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey
+func (mr *MockKmsClientMockRecorder) CreateKey(ctx, id, keyType, tags, multiRegion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockKmsClient)(nil).CreateKey), ctx, id, keyType, tags, multiRegion)
+}
+
+// ImportKey mocks base method
+func (m *MockKmsClient) ImportKey(ctx context.Context, id, keyType string, keyMaterial []byte, tags []*kms.Tag, multiRegion bool) (*kms.CreateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKey", ctx, id, keyType, keyMaterial, tags, multiRegion)
+	ret0, _ := ret[0].(*kms.CreateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKey indicates an expected call of ImportKey
+func (mr *MockKmsClientMockRecorder) ImportKey(ctx, id, keyType, keyMaterial, tags, multiRegion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKey", reflect.TypeOf((*MockKmsClient)(nil).ImportKey), ctx, id, keyType, keyMaterial, tags, multiRegion)
+}
+
+// ReplicateKey mocks base method
+func (m *MockKmsClient) ReplicateKey(ctx context.Context, id, keyID, region string, tags []*kms.Tag) (*kms.ReplicateKeyOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateKey", ctx, id, keyID, region, tags)
+	ret0, _ := ret[0].(*kms.ReplicateKeyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplicateKey indicates an expected call of ReplicateKey
+func (mr *MockKmsClientMockRecorder) ReplicateKey(ctx, id, keyID, region, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateKey", reflect.TypeOf((*MockKmsClient)(nil).ReplicateKey), ctx, id, keyID, region, tags)
+}
+
+// ImportKeyMaterial mocks base method
+func (m *MockKmsClient) ImportKeyMaterial(ctx context.Context, keyID, region string, keyMaterial []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKeyMaterial", ctx, keyID, region, keyMaterial)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportKeyMaterial indicates an expected call of ImportKeyMaterial
+func (mr *MockKmsClientMockRecorder) ImportKeyMaterial(ctx, keyID, region, keyMaterial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKeyMaterial", reflect.TypeOf((*MockKmsClient)(nil).ImportKeyMaterial), ctx, keyID, region, keyMaterial)
+}
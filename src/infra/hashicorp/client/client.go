@@ -6,11 +6,15 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
-const dataLabel = "data"
+const (
+	dataLabel        = "data"
+	defaultKVVersion = 2
+)
 
 type HashicorpVaultClient struct {
 	client     *api.Client
 	mountPoint string
+	kvVersion  int
 }
 
 var _ hashicorp.Client = &HashicorpVaultClient{}
@@ -27,7 +31,16 @@ func NewClient(cfg *Config) (*HashicorpVaultClient, error) {
 
 	client.SetNamespace(cfg.Namespace)
 
-	return &HashicorpVaultClient{client: client, mountPoint: cfg.MountPoint}, nil
+	kvVersion := cfg.KVVersion
+	if kvVersion == 0 {
+		kvVersion = defaultKVVersion
+	}
+
+	return &HashicorpVaultClient{client: client, mountPoint: cfg.MountPoint, kvVersion: kvVersion}, nil
+}
+
+func (c *HashicorpVaultClient) KVVersion() int {
+	return c.kvVersion
 }
 
 func (c *HashicorpVaultClient) SetToken(token string) {
@@ -18,6 +18,7 @@ import (
 // Config object that be converted into an api.Config later
 type Config struct {
 	MountPoint    string
+	KVVersion     int
 	Address       string
 	CACert        string
 	CAPath        string
@@ -47,6 +48,7 @@ func NewConfig(specs *entities.HashicorpConfig) *Config {
 		MaxRetries:    specs.MaxRetries,
 		SkipVerify:    specs.SkipVerify,
 		MountPoint:    specs.MountPoint,
+		KVVersion:     specs.KVVersion,
 	}
 }
 
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"path"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func (c *HashicorpVaultClient) ReadKvv1(id string) (*api.Secret, error) {
+	secret, err := c.client.Logical().Read(c.pathKvv1(id))
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) WriteKvv1(id string, data map[string]interface{}) error {
+	_, err := c.client.Logical().Write(c.pathKvv1(id), data)
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) ListKvv1(dir string) (*api.Secret, error) {
+	secret, err := c.client.Logical().List(c.pathKvv1(dir))
+	if err != nil {
+		return nil, parseErrorResponse(err)
+	}
+
+	return secret, nil
+}
+
+func (c *HashicorpVaultClient) DeleteKvv1(id string) error {
+	_, err := c.client.Logical().Delete(c.pathKvv1(id))
+	if err != nil {
+		return parseErrorResponse(err)
+	}
+
+	return nil
+}
+
+func (c *HashicorpVaultClient) pathKvv1(id string) string {
+	return path.Join(c.mountPoint, id)
+}
@@ -64,8 +64,8 @@ func (c *HashicorpVaultClient) DestroySecret(id string, data map[string][]string
 	return nil
 }
 
-func (c *HashicorpVaultClient) ListSecrets() (*api.Secret, error) {
-	secret, err := c.client.Logical().List(c.pathMetadata(""))
+func (c *HashicorpVaultClient) ListSecrets(dir string) (*api.Secret, error) {
+	secret, err := c.client.Logical().List(c.pathMetadata(dir))
 	if err != nil {
 		return nil, parseErrorResponse(err)
 	}
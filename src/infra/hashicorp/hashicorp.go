@@ -7,19 +7,28 @@ import (
 //go:generate mockgen -source=hashicorp.go -destination=mocks/hashicorp.go -package=mocks
 
 type Client interface {
+	Kvv1Client
 	Kvv2Client
 	PluginClient
 	SetToken(token string)
 	UnwrapToken(token string) (*hashicorp.Secret, error)
 	Mount(path string, mountInfo *hashicorp.MountInput) error
 	HealthCheck() error
+	KVVersion() int
+}
+
+type Kvv1Client interface {
+	ReadKvv1(id string) (*hashicorp.Secret, error)
+	WriteKvv1(id string, data map[string]interface{}) error
+	ListKvv1(path string) (*hashicorp.Secret, error)
+	DeleteKvv1(id string) error
 }
 
 type Kvv2Client interface {
 	ReadData(id string, data map[string][]string) (*hashicorp.Secret, error)
 	ReadMetadata(id string) (*hashicorp.Secret, error)
 	SetSecret(id string, data map[string]interface{}) (*hashicorp.Secret, error)
-	ListSecrets() (*hashicorp.Secret, error)
+	ListSecrets(path string) (*hashicorp.Secret, error)
 	DeleteSecret(id string, data map[string][]string) error
 	RestoreSecret(id string, data map[string][]string) error
 	DestroySecret(id string, data map[string][]string) error
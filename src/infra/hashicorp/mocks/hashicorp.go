@@ -78,21 +78,6 @@ func (mr *MockClientMockRecorder) SetSecret(id, data interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockClient)(nil).SetSecret), id, data)
 }
 
-// ListSecrets mocks base method
-func (m *MockClient) ListSecrets() (*api.Secret, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecrets")
-	ret0, _ := ret[0].(*api.Secret)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ListSecrets indicates an expected call of ListSecrets
-func (mr *MockClientMockRecorder) ListSecrets() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockClient)(nil).ListSecrets))
-}
-
 // DeleteSecret mocks base method
 func (m *MockClient) DeleteSecret(id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
@@ -294,6 +279,93 @@ func (mr *MockClientMockRecorder) HealthCheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockClient)(nil).HealthCheck))
 }
 
+// ReadKvv1 mocks base method
+func (m *MockClient) ReadKvv1(id string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKvv1", id)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadKvv1 indicates an expected call of ReadKvv1
+func (mr *MockClientMockRecorder) ReadKvv1(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKvv1", reflect.TypeOf((*MockClient)(nil).ReadKvv1), id)
+}
+
+// WriteKvv1 mocks base method
+func (m *MockClient) WriteKvv1(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteKvv1", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteKvv1 indicates an expected call of WriteKvv1
+func (mr *MockClientMockRecorder) WriteKvv1(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteKvv1", reflect.TypeOf((*MockClient)(nil).WriteKvv1), id, data)
+}
+
+// ListKvv1 mocks base method
+func (m *MockClient) ListKvv1(path string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKvv1", path)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKvv1 indicates an expected call of ListKvv1
+func (mr *MockClientMockRecorder) ListKvv1(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKvv1", reflect.TypeOf((*MockClient)(nil).ListKvv1), path)
+}
+
+// DeleteKvv1 mocks base method
+func (m *MockClient) DeleteKvv1(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKvv1", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKvv1 indicates an expected call of DeleteKvv1
+func (mr *MockClientMockRecorder) DeleteKvv1(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKvv1", reflect.TypeOf((*MockClient)(nil).DeleteKvv1), id)
+}
+
+// ListSecrets mocks base method
+func (m *MockClient) ListSecrets(path string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets
+func (mr *MockClientMockRecorder) ListSecrets(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockClient)(nil).ListSecrets), path)
+}
+
+// KVVersion mocks base method
+func (m *MockClient) KVVersion() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KVVersion")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// KVVersion indicates an expected call of KVVersion
+func (mr *MockClientMockRecorder) KVVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KVVersion", reflect.TypeOf((*MockClient)(nil).KVVersion))
+}
+
 // MockKvv2Client is a mock of Kvv2Client interface
 type MockKvv2Client struct {
 	ctrl     *gomock.Controller
@@ -362,21 +434,6 @@ func (mr *MockKvv2ClientMockRecorder) SetSecret(id, data interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecret", reflect.TypeOf((*MockKvv2Client)(nil).SetSecret), id, data)
 }
 
-// ListSecrets mocks base method
-func (m *MockKvv2Client) ListSecrets() (*api.Secret, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecrets")
-	ret0, _ := ret[0].(*api.Secret)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ListSecrets indicates an expected call of ListSecrets
-func (mr *MockKvv2ClientMockRecorder) ListSecrets() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockKvv2Client)(nil).ListSecrets))
-}
-
 // DeleteSecret mocks base method
 func (m *MockKvv2Client) DeleteSecret(id string, data map[string][]string) error {
 	m.ctrl.T.Helper()
@@ -419,6 +476,21 @@ func (mr *MockKvv2ClientMockRecorder) DestroySecret(id, data interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockKvv2Client)(nil).DestroySecret), id, data)
 }
 
+// ListSecrets mocks base method
+func (m *MockKvv2Client) ListSecrets(path string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", path)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets
+func (mr *MockKvv2ClientMockRecorder) ListSecrets(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockKvv2Client)(nil).ListSecrets), path)
+}
+
 // MockPluginClient is a mock of PluginClient interface
 type MockPluginClient struct {
 	ctrl     *gomock.Controller
@@ -545,3 +617,84 @@ func (mr *MockPluginClientMockRecorder) Sign(id, data interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockPluginClient)(nil).Sign), id, data)
 }
+
+// MockKvv1Client is a mock of Kvv1Client interface
+type MockKvv1Client struct {
+	ctrl     *gomock.Controller
+	recorder *MockKvv1ClientMockRecorder
+}
+
+// MockKvv1ClientMockRecorder is the mock recorder for MockKvv1Client
+type MockKvv1ClientMockRecorder struct {
+	mock *MockKvv1Client
+}
+
+// NewMockKvv1Client creates a new mock instance
+func NewMockKvv1Client(ctrl *gomock.Controller) *MockKvv1Client {
+	mock := &MockKvv1Client{ctrl: ctrl}
+	mock.recorder = &MockKvv1ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockKvv1Client) EXPECT() *MockKvv1ClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteKvv1 mocks base method
+func (m *MockKvv1Client) DeleteKvv1(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKvv1", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKvv1 indicates an expected call of DeleteKvv1
+func (mr *MockKvv1ClientMockRecorder) DeleteKvv1(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKvv1", reflect.TypeOf((*MockKvv1Client)(nil).DeleteKvv1), id)
+}
+
+// ListKvv1 mocks base method
+func (m *MockKvv1Client) ListKvv1(path string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKvv1", path)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKvv1 indicates an expected call of ListKvv1
+func (mr *MockKvv1ClientMockRecorder) ListKvv1(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKvv1", reflect.TypeOf((*MockKvv1Client)(nil).ListKvv1), path)
+}
+
+// ReadKvv1 mocks base method
+func (m *MockKvv1Client) ReadKvv1(id string) (*api.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKvv1", id)
+	ret0, _ := ret[0].(*api.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadKvv1 indicates an expected call of ReadKvv1
+func (mr *MockKvv1ClientMockRecorder) ReadKvv1(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKvv1", reflect.TypeOf((*MockKvv1Client)(nil).ReadKvv1), id)
+}
+
+// WriteKvv1 mocks base method
+func (m *MockKvv1Client) WriteKvv1(id string, data map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteKvv1", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteKvv1 indicates an expected call of WriteKvv1
+func (mr *MockKvv1ClientMockRecorder) WriteKvv1(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteKvv1", reflect.TypeOf((*MockKvv1Client)(nil).WriteKvv1), id, data)
+}
@@ -24,7 +24,7 @@ func WriteHTTPErrorResponse(rw http.ResponseWriter, err error) {
 		writeErrorResponse(rw, http.StatusNotFound, err)
 	case errors.IsUnauthorizedError(err):
 		writeErrorResponse(rw, http.StatusUnauthorized, err)
-	case errors.IsForbiddenError(err):
+	case errors.IsForbiddenError(err), errors.IsKeyUsageError(err):
 		writeErrorResponse(rw, http.StatusForbidden, err)
 	case errors.IsInvalidFormatError(err):
 		writeErrorResponse(rw, http.StatusBadRequest, err)
@@ -59,21 +59,49 @@ func WriteJSON(rw http.ResponseWriter, data interface{}) error {
 }
 
 func WritePagingResponse(rw http.ResponseWriter, req *http.Request, data interface{}) error {
+	res, err := newPageResponse(req, data)
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(rw, res)
+}
+
+// WriteSearchPagingResponse writes the page of data with the cursors and total of paging. Unless the request
+// is paginated by page number, the links to the previous and next pages are built from the cursors
+func WriteSearchPagingResponse(rw http.ResponseWriter, req *http.Request, data interface{}, paging PagePagingResponse) error {
+	res, err := newPageResponse(req, data)
+	if err != nil {
+		return err
+	}
+
+	if req.URL.Query().Get("page") == "" {
+		res.Paging.Previous = cursorURL(req, paging.PreviousCursor)
+		res.Paging.Next = cursorURL(req, paging.NextCursor)
+		res.Paging.PreviousCursor = paging.PreviousCursor
+		res.Paging.NextCursor = paging.NextCursor
+	}
+	res.Paging.Total = paging.Total
+
+	return WriteJSON(rw, res)
+}
+
+func newPageResponse(req *http.Request, data interface{}) (*PageResponse, error) {
 	var arrData []interface{}
 	bData, err := json.Marshal(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = json.Unmarshal(bData, &arrData)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if arrData == nil {
 		arrData = []interface{}{}
 	}
 
-	res := PageResponse{
+	res := &PageResponse{
 		Data: arrData,
 	}
 
@@ -122,5 +150,21 @@ func WritePagingResponse(rw http.ResponseWriter, req *http.Request, data interfa
 		}
 	}
 
-	return WriteJSON(rw, res)
+	return res, nil
+}
+
+func cursorURL(req *http.Request, cursor string) string {
+	if cursor == "" {
+		return ""
+	}
+
+	baseURL, _ := url.Parse(req.Host)
+	params := req.URL.Query()
+	params.Set("cursor", cursor)
+	baseURL.RawQuery = params.Encode()
+	if req.TLS != nil {
+		return "https://" + baseURL.String()
+	}
+
+	return baseURL.String()
 }
@@ -11,6 +11,9 @@ type PageResponse struct {
 }
 
 type PagePagingResponse struct {
-	Previous string `json:"previous,omitempty" example:"https://quorum-key-manager.com/stores/your-store/secrets?page=1"`
-	Next     string `json:"next,omitempty" example:"https://quorum-key-manager.com/stores/your-store/secrets?page=3"`
+	Previous       string  `json:"previous,omitempty" example:"https://quorum-key-manager.com/stores/your-store/secrets?page=1"`
+	Next           string  `json:"next,omitempty" example:"https://quorum-key-manager.com/stores/your-store/secrets?page=3"`
+	PreviousCursor string  `json:"previousCursor,omitempty" example:"eyJpZCI6Im15LXNlY3JldCIsImIiOnRydWV9"`
+	NextCursor     string  `json:"nextCursor,omitempty" example:"eyJpZCI6Im15LXNlY3JldCJ9"`
+	Total          *uint64 `json:"total,omitempty" example:"250"`
 }
@@ -0,0 +1,108 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/common"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+// Job runs a task at a fixed interval in a parallel goroutine, between the start and the stop of the application
+type Job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	logger   log.Logger
+
+	mux    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+var _ common.Runnable = &Job{}
+
+func New(name string, interval time.Duration, run func(ctx context.Context) error, logger log.Logger) *Job {
+	return &Job{
+		name:     name,
+		interval: interval,
+		run:      run,
+		logger:   logger.With("job", name),
+	}
+}
+
+func (j *Job) Start(ctx context.Context) error {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	if j.cancel != nil {
+		return nil
+	}
+
+	// The job outlives the start context, it runs until the job is stopped
+	runCtx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go j.loop(runCtx)
+
+	j.logger.Info("job started", "interval", j.interval.String())
+	return nil
+}
+
+func (j *Job) loop(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := j.run(ctx)
+			if err != nil {
+				j.logger.WithError(err).Error("job run failed")
+			}
+
+			j.mux.Lock()
+			j.err = err
+			j.mux.Unlock()
+		}
+	}
+}
+
+func (j *Job) Stop(ctx context.Context) error {
+	j.mux.Lock()
+	cancel, done := j.cancel, j.done
+	j.cancel = nil
+	j.mux.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	select {
+	case <-done:
+		j.logger.Info("job stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (j *Job) Close() error {
+	return nil
+}
+
+// Error returns the error of the last run of the job
+func (j *Job) Error() error {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	return j.err
+}
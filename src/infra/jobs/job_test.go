@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	expectedErr := fmt.Errorf("error")
+
+	t.Run("should run the task at every interval until stopped", func(t *testing.T) {
+		var runs int32
+		job := New("test", 5*time.Millisecond, func(_ context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return expectedErr
+		}, logger)
+
+		require.NoError(t, job.Start(context.Background()))
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&runs) >= 2 }, time.Second, time.Millisecond)
+
+		require.NoError(t, job.Stop(context.Background()))
+		stoppedRuns := atomic.LoadInt32(&runs)
+		time.Sleep(20 * time.Millisecond)
+
+		assert.Equal(t, stoppedRuns, atomic.LoadInt32(&runs))
+		assert.Equal(t, expectedErr, job.Error())
+	})
+
+	t.Run("should not fail to stop a job not started", func(t *testing.T) {
+		job := New("test", time.Minute, func(_ context.Context) error { return nil }, logger)
+
+		assert.NoError(t, job.Stop(context.Background()))
+		assert.NoError(t, job.Close())
+	})
+}
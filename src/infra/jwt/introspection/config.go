@@ -0,0 +1,30 @@
+package introspection
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/jwt/jose"
+)
+
+type Config struct {
+	URL          string
+	ClientID     string
+	ClientSecret string
+	Audience     []string
+	ClaimMapping *jose.ClaimMapping
+
+	// MaxCacheTTL bounds the time an active token is cached for, tokens are never cached after their expiry
+	MaxCacheTTL time.Duration
+	Timeout     time.Duration
+}
+
+func NewConfig(url, clientID, clientSecret string, audience []string, maxCacheTTL time.Duration) *Config {
+	return &Config{
+		URL:          url,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Audience:     audience,
+		MaxCacheTTL:  maxCacheTTL,
+		Timeout:      10 * time.Second,
+	}
+}
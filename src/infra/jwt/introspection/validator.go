@@ -0,0 +1,245 @@
+package introspection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/jwt"
+)
+
+// SweepInterval is the interval at which expired tokens should be purged from the cache with Sweep
+const SweepInterval = time.Minute
+
+// maxCacheSize bounds the number of cached tokens, an arbitrary token is evicted to cache a new one once it is reached
+// and no cached token has expired
+const maxCacheSize = 10000
+
+// Validator validates opaque access tokens against an OAuth2 token introspection endpoint (RFC 7662)
+type Validator struct {
+	cfg    *Config
+	client *http.Client
+
+	mux   sync.Mutex
+	cache map[[sha256.Size]byte]*cachedResponse
+	now   func() time.Time
+}
+
+// Response is the introspection response of an active token
+type Response struct {
+	Subject  string
+	Username string
+	Scope    []string
+	Expiry   time.Time
+	Raw      map[string]interface{}
+}
+
+type cachedResponse struct {
+	response  *Response
+	expiresAt time.Time
+}
+
+var _ jwt.Validator = &Validator{}
+
+func New(cfg *Config) (*Validator, error) {
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf("invalid introspection endpoint: %w", err)
+	}
+
+	return &Validator{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cache:  make(map[[sha256.Size]byte]*cachedResponse),
+		now:    time.Now,
+	}, nil
+}
+
+func (v *Validator) ValidateToken(ctx context.Context, token string) (interface{}, error) {
+	key := sha256.Sum256([]byte(token))
+	if resp, ok := v.cached(key); ok {
+		return resp, nil
+	}
+
+	resp, err := v.introspect(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = v.validateAudience(resp.Raw["aud"]); err != nil {
+		return nil, err
+	}
+
+	v.store(key, resp)
+
+	return resp, nil
+}
+
+func (v *Validator) ParseClaims(tokenClaims interface{}) (*entities.UserClaims, error) {
+	resp, ok := tokenClaims.(*Response)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	userClaims := &entities.UserClaims{
+		Tenant:      resp.Subject,
+		Username:    resp.Username,
+		Permissions: resp.Scope,
+	}
+
+	if v.cfg.ClaimMapping != nil {
+		if err := v.cfg.ClaimMapping.Apply(resp.Raw, userClaims); err != nil {
+			return nil, err
+		}
+	}
+
+	return userClaims, nil
+}
+
+func (v *Validator) introspect(ctx context.Context, token string) (*Response, error) {
+	form := url.Values{}
+	form.Set("token", token)
+	form.Set("token_type_hint", "access_token")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.cfg.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if v.cfg.ClientID != "" {
+		req.SetBasicAuth(url.QueryEscape(v.cfg.ClientID), url.QueryEscape(v.cfg.ClientSecret))
+	}
+
+	httpResp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call introspection endpoint: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection endpoint responded with status %d", httpResp.StatusCode)
+	}
+
+	raw := make(map[string]interface{})
+	if err = json.NewDecoder(httpResp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+
+	if active, _ := raw["active"].(bool); !active {
+		return nil, errors.New("token is not active")
+	}
+
+	resp := &Response{Raw: raw}
+	resp.Subject, _ = raw["sub"].(string)
+	resp.Username, _ = raw["username"].(string)
+	if scope, ok := raw["scope"].(string); ok {
+		resp.Scope = strings.Fields(scope)
+	}
+	if exp, ok := raw["exp"].(float64); ok {
+		resp.Expiry = time.Unix(int64(exp), 0)
+		if !resp.Expiry.After(v.now()) {
+			return nil, errors.New("token is expired")
+		}
+	}
+
+	return resp, nil
+}
+
+func (v *Validator) validateAudience(aud interface{}) error {
+	if len(v.cfg.Audience) == 0 {
+		return nil
+	}
+
+	var audiences []string
+	switch a := aud.(type) {
+	case string:
+		audiences = []string{a}
+	case []interface{}:
+		for _, item := range a {
+			if str, ok := item.(string); ok {
+				audiences = append(audiences, str)
+			}
+		}
+	}
+
+	for _, expected := range v.cfg.Audience {
+		for _, audience := range audiences {
+			if audience == expected {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("token audience is not allowed")
+}
+
+func (v *Validator) cached(key [sha256.Size]byte) (*Response, bool) {
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	entry, ok := v.cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !v.now().Before(entry.expiresAt) {
+		delete(v.cache, key)
+		return nil, false
+	}
+
+	return entry.response, true
+}
+
+func (v *Validator) store(key [sha256.Size]byte, resp *Response) {
+	// Tokens without expiry could be revoked at any time, they are introspected on every request
+	if resp.Expiry.IsZero() {
+		return
+	}
+
+	expiresAt := resp.Expiry
+	if v.cfg.MaxCacheTTL > 0 && v.now().Add(v.cfg.MaxCacheTTL).Before(expiresAt) {
+		expiresAt = v.now().Add(v.cfg.MaxCacheTTL)
+	}
+
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	if len(v.cache) >= maxCacheSize {
+		v.sweep()
+	}
+
+	if len(v.cache) >= maxCacheSize {
+		for k := range v.cache {
+			delete(v.cache, k)
+			break
+		}
+	}
+
+	v.cache[key] = &cachedResponse{response: resp, expiresAt: expiresAt}
+}
+
+// Sweep purges the expired tokens from the cache
+func (v *Validator) Sweep(_ context.Context) error {
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	v.sweep()
+	return nil
+}
+
+func (v *Validator) sweep() {
+	now := v.now()
+	for k, entry := range v.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(v.cache, k)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package introspection
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/jwt/jose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	activeToken   = "active-token"
+	inactiveToken = "inactive-token"
+	clientID      = "qkm"
+	clientSecret  = "qkm-secret"
+)
+
+func newIntrospectionServer(t *testing.T, calls *int, exp time.Time) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		*calls++
+		id, secret, ok := req.BasicAuth()
+		if !ok || id != clientID || secret != clientSecret {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		require.NoError(t, req.ParseForm())
+		resp := map[string]interface{}{"active": false}
+		if req.PostForm.Get("token") == activeToken {
+			resp = map[string]interface{}{
+				"active":   true,
+				"sub":      "tenant_id",
+				"username": "alice",
+				"scope":    "read:keys sign:keys",
+				"aud":      []string{"qkm"},
+				"exp":      exp.Unix(),
+				"groups":   []string{"kms-admins"},
+			}
+		}
+		_ = json.NewEncoder(rw).Encode(resp)
+	}))
+}
+
+func TestValidator_ValidateToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should introspect and cache an active token until expiry", func(t *testing.T) {
+		calls := 0
+		now := time.Now()
+		server := newIntrospectionServer(t, &calls, now.Add(time.Minute))
+		defer server.Close()
+
+		v, err := New(NewConfig(server.URL, clientID, clientSecret, []string{"qkm"}, 0))
+		require.NoError(t, err)
+
+		resp, err := v.ValidateToken(ctx, activeToken)
+		require.NoError(t, err)
+		assert.Equal(t, "tenant_id", resp.(*Response).Subject)
+
+		_, err = v.ValidateToken(ctx, activeToken)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+
+		v.now = func() time.Time { return now.Add(2 * time.Minute) }
+		_, err = v.ValidateToken(ctx, activeToken)
+		assert.Error(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should sweep expired tokens and bound the size of the cache", func(t *testing.T) {
+		now := time.Now()
+		v, err := New(NewConfig("http://introspection", clientID, clientSecret, nil, 0))
+		require.NoError(t, err)
+		v.now = func() time.Time { return now }
+
+		v.store([32]byte{1}, &Response{Expiry: now.Add(time.Minute)})
+		v.store([32]byte{2}, &Response{Expiry: now.Add(time.Hour)})
+
+		v.now = func() time.Time { return now.Add(2 * time.Minute) }
+		require.NoError(t, v.Sweep(ctx))
+		assert.Len(t, v.cache, 1)
+
+		for i := 0; i < maxCacheSize+10; i++ {
+			key := [32]byte{}
+			key[0], key[1] = byte(i), byte(i>>8)
+			key[2] = 1
+			v.store(key, &Response{Expiry: now.Add(time.Hour)})
+		}
+		assert.Len(t, v.cache, maxCacheSize)
+	})
+
+	t.Run("should fail if token is not active", func(t *testing.T) {
+		calls := 0
+		server := newIntrospectionServer(t, &calls, time.Now().Add(time.Minute))
+		defer server.Close()
+
+		v, err := New(NewConfig(server.URL, clientID, clientSecret, nil, 0))
+		require.NoError(t, err)
+
+		_, err = v.ValidateToken(ctx, inactiveToken)
+		assert.Error(t, err)
+
+		_, err = v.ValidateToken(ctx, inactiveToken)
+		assert.Error(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should fail if client credentials are rejected", func(t *testing.T) {
+		calls := 0
+		server := newIntrospectionServer(t, &calls, time.Now().Add(time.Minute))
+		defer server.Close()
+
+		v, err := New(NewConfig(server.URL, clientID, "wrong-secret", nil, 0))
+		require.NoError(t, err)
+
+		_, err = v.ValidateToken(ctx, activeToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if audience is not allowed", func(t *testing.T) {
+		calls := 0
+		server := newIntrospectionServer(t, &calls, time.Now().Add(time.Minute))
+		defer server.Close()
+
+		v, err := New(NewConfig(server.URL, clientID, clientSecret, []string{"other"}, 0))
+		require.NoError(t, err)
+
+		_, err = v.ValidateToken(ctx, activeToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to instantiate with invalid URL", func(t *testing.T) {
+		_, err := New(NewConfig("invalid url", clientID, clientSecret, nil, 0))
+		assert.Error(t, err)
+	})
+}
+
+func TestValidator_ParseClaims(t *testing.T) {
+	resp := &Response{
+		Subject:  "tenant_id",
+		Username: "alice",
+		Scope:    []string{"read:keys"},
+		Raw: map[string]interface{}{
+			"groups": []interface{}{"kms-admins"},
+		},
+	}
+
+	t.Run("should parse introspection response successfully", func(t *testing.T) {
+		v := &Validator{cfg: &Config{}}
+
+		claims, err := v.ParseClaims(resp)
+		require.NoError(t, err)
+		assert.Equal(t, "tenant_id", claims.Tenant)
+		assert.Equal(t, "alice", claims.Username)
+		assert.Equal(t, []string{"read:keys"}, claims.Permissions)
+	})
+
+	t.Run("should parse introspection response with claim mapping successfully", func(t *testing.T) {
+		v := &Validator{cfg: &Config{ClaimMapping: &jose.ClaimMapping{
+			Roles:       "groups",
+			RoleMapping: map[string][]string{"kms-admins": {"admin"}},
+		}}}
+
+		claims, err := v.ParseClaims(resp)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"admin"}, claims.Roles)
+	})
+
+	t.Run("should fail if invalid claims are passed", func(t *testing.T) {
+		v := &Validator{cfg: &Config{}}
+
+		_, err := v.ParseClaims("invalid")
+		assert.Error(t, err)
+	})
+}
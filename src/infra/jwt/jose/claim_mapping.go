@@ -0,0 +1,73 @@
+package jose
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+// ClaimMapping locates user claims in the token with dot separated JSON paths (ie. "realm_access.roles")
+type ClaimMapping struct {
+	Tenant      string
+	Username    string
+	Roles       string
+	Permissions string
+
+	// RoleMapping translates identity provider groups or roles to Quorum Key Manager roles.
+	// Once a mapping is set, values without a mapping are dropped, otherwise they are used as role names unchanged
+	RoleMapping map[string][]string
+}
+
+// Apply overrides the user claims with the values found in the raw token claims
+func (m *ClaimMapping) Apply(raw map[string]interface{}, userClaims *entities.UserClaims) error {
+	if m.Tenant != "" {
+		tenant, ok := lookupClaim(raw, m.Tenant)
+		if !ok {
+			return fmt.Errorf("missing tenant claim %q", m.Tenant)
+		}
+
+		if userClaims.Tenant, ok = tenant.(string); !ok {
+			return fmt.Errorf("tenant claim %q must be a string", m.Tenant)
+		}
+	}
+
+	if m.Username != "" {
+		if username, ok := lookupClaim(raw, m.Username); ok {
+			userClaims.Username, _ = username.(string)
+		}
+	}
+
+	if m.Permissions != "" {
+		if permissions, ok := lookupClaim(raw, m.Permissions); ok {
+			userClaims.Permissions = claimValues(permissions)
+		}
+	}
+
+	if m.Roles != "" {
+		if roles, ok := lookupClaim(raw, m.Roles); ok {
+			userClaims.Roles = m.mapRoles(claimValues(roles))
+		}
+	}
+
+	return nil
+}
+
+func (m *ClaimMapping) mapRoles(groups []string) []string {
+	if len(m.RoleMapping) == 0 {
+		return groups
+	}
+
+	var roles []string
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		// An unmapped group must not grant the Quorum Key Manager role of the same name
+		for _, role := range m.RoleMapping[group] {
+			if !seen[role] {
+				seen[role] = true
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	return roles
+}
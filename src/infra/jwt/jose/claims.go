@@ -11,6 +11,9 @@ type Claims struct {
 	CustomClaims    *CustomClaims `json:"-"`
 	Scope           []string      `json:"scope"`
 	customClaimPath string
+
+	// Raw holds every claim of the token so they can be looked up by claim mapping
+	Raw map[string]interface{} `json:"-"`
 }
 
 type CustomClaims struct {
@@ -27,11 +30,13 @@ func NewClaims(customClaimPath string) *Claims {
 func (c *Claims) UnmarshalJSON(data []byte) error {
 	c.Scope = nil
 	c.CustomClaims = nil
+	c.Raw = nil
 
 	var res map[string]interface{}
 	if err := json.Unmarshal(data, &res); err != nil {
 		return err
 	}
+	c.Raw = res
 
 	if c.customClaimPath != "" {
 		c.CustomClaims = &CustomClaims{}
@@ -61,3 +66,42 @@ func (c *Claims) Validate(_ context.Context) error {
 	// TODO: Apply validation on custom claims if needed, currently no validation is needed
 	return nil
 }
+
+// lookupClaim returns the value located at the dot separated path in the claims.
+// Claim names can themselves contain dots (ie. "https://my.domain/roles"), so the longest matching name is preferred
+func lookupClaim(claims map[string]interface{}, path string) (interface{}, bool) {
+	if value, ok := claims[path]; ok {
+		return value, true
+	}
+
+	for i := strings.LastIndex(path, "."); i > 0; i = strings.LastIndex(path[:i], ".") {
+		nested, ok := claims[path[:i]].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if value, ok := lookupClaim(nested, path[i+1:]); ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// claimValues converts a claim to a list of strings, space separated strings are split as for the "scope" claim
+func claimValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			if str, ok := item.(string); ok {
+				values = append(values, str)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
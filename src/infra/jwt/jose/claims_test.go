@@ -88,3 +88,38 @@ func TestClaims_validate(t *testing.T) {
 	c := NewClaims("")
 	assert.Equal(t, nil, c.Validate(context.Background()))
 }
+
+func TestClaims_lookupClaim(t *testing.T) {
+	claims := map[string]interface{}{
+		"realm_access": map[string]interface{}{
+			"roles": []interface{}{"admin", "signer"},
+		},
+		"https://quorum-key-manager.consensys.net": map[string]interface{}{
+			"tenant_id": "tenantID",
+		},
+		"groups": "group1 group2",
+	}
+
+	t.Run("should find nested claim", func(t *testing.T) {
+		value, ok := lookupClaim(claims, "realm_access.roles")
+		assert.True(t, ok)
+		assert.Equal(t, []string{"admin", "signer"}, claimValues(value))
+	})
+
+	t.Run("should find claim with dots in its name", func(t *testing.T) {
+		value, ok := lookupClaim(claims, "https://quorum-key-manager.consensys.net.tenant_id")
+		assert.True(t, ok)
+		assert.Equal(t, "tenantID", value)
+	})
+
+	t.Run("should split space separated claims", func(t *testing.T) {
+		value, ok := lookupClaim(claims, "groups")
+		assert.True(t, ok)
+		assert.Equal(t, []string{"group1", "group2"}, claimValues(value))
+	})
+
+	t.Run("should not find missing claim", func(t *testing.T) {
+		_, ok := lookupClaim(claims, "realm_access.groups")
+		assert.False(t, ok)
+	})
+}
@@ -4,18 +4,28 @@ import (
 	"time"
 )
 
+// DefaultAlgorithm is the signing algorithm accepted when none is configured
+const DefaultAlgorithm = "RS256"
+
 type Config struct {
 	IssuerURL       string
 	CacheTTL        time.Duration
 	Audience        []string
 	CustomClaimPath string
+	Algorithms      []string
+	ClaimMapping    *ClaimMapping
 }
 
-func NewConfig(issuerURL string, audience []string, customClaimPath string, cacheTTL time.Duration) *Config {
+func NewConfig(issuerURL string, audience []string, customClaimPath string, cacheTTL time.Duration, algorithms ...string) *Config {
+	if len(algorithms) == 0 {
+		algorithms = []string{DefaultAlgorithm}
+	}
+
 	return &Config{
 		IssuerURL:       issuerURL,
 		CacheTTL:        cacheTTL,
 		Audience:        audience,
 		CustomClaimPath: customClaimPath,
+		Algorithms:      algorithms,
 	}
 }
@@ -1,17 +1,36 @@
 package jose
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/url"
 
 	"github.com/auth0/go-jwt-middleware/v2/jwks"
 	"github.com/auth0/go-jwt-middleware/v2/validator"
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/jwt"
+	josejwt "gopkg.in/square/go-jose.v2/jwt"
 )
 
+// Only asymmetric algorithms are supported as verification keys are fetched from the issuer JWKS
+var supportedAlgorithms = map[string]validator.SignatureAlgorithm{
+	"RS256": validator.RS256,
+	"RS384": validator.RS384,
+	"RS512": validator.RS512,
+	"PS256": validator.PS256,
+	"PS384": validator.PS384,
+	"PS512": validator.PS512,
+	"ES256": validator.ES256,
+	"ES384": validator.ES384,
+	"ES512": validator.ES512,
+	"EdDSA": validator.EdDSA,
+}
+
 type Validator struct {
-	*validator.Validator
+	// validators holds one validator per allowed signing algorithm, all sharing the same JWKS cache
+	validators   map[string]*validator.Validator
+	claimMapping *ClaimMapping
 }
 
 var _ jwt.Validator = &Validator{}
@@ -22,20 +41,51 @@ func New(cfg *Config) (*Validator, error) {
 		return nil, err
 	}
 
-	v, err := validator.New(
-		jwks.NewCachingProvider(issuerURL, cfg.CacheTTL).KeyFunc,
-		validator.RS256,
-		issuerURL.String(),
-		cfg.Audience,
-		validator.WithCustomClaims(func() validator.CustomClaims {
-			return NewClaims(cfg.CustomClaimPath)
-		}),
-	)
+	algorithms := cfg.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = []string{DefaultAlgorithm}
+	}
+
+	keyFunc := jwks.NewCachingProvider(issuerURL, cfg.CacheTTL).KeyFunc
+	validators := make(map[string]*validator.Validator, len(algorithms))
+	for _, alg := range algorithms {
+		signatureAlgorithm, ok := supportedAlgorithms[alg]
+		if !ok {
+			return nil, fmt.Errorf("unsupported signing algorithm %q", alg)
+		}
+
+		v, err := validator.New(
+			keyFunc,
+			signatureAlgorithm,
+			issuerURL.String(),
+			cfg.Audience,
+			validator.WithCustomClaims(func() validator.CustomClaims {
+				return NewClaims(cfg.CustomClaimPath)
+			}),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		validators[alg] = v
+	}
+
+	return &Validator{validators: validators, claimMapping: cfg.ClaimMapping}, nil
+}
+
+func (v *Validator) ValidateToken(ctx context.Context, token string) (interface{}, error) {
+	parsedToken, err := josejwt.ParseSigned(token)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("could not parse the token: %w", err)
+	}
+
+	alg := parsedToken.Headers[0].Algorithm
+	algValidator, ok := v.validators[alg]
+	if !ok {
+		return nil, fmt.Errorf("signing algorithm %q is not allowed", alg)
 	}
 
-	return &Validator{v}, nil
+	return algValidator.ValidateToken(ctx, token)
 }
 
 func (v *Validator) ParseClaims(tokenClaims interface{}) (*entities.UserClaims, error) {
@@ -54,6 +104,17 @@ func (v *Validator) ParseClaims(tokenClaims interface{}) (*entities.UserClaims,
 		}
 	}
 
+	if v.claimMapping != nil {
+		var raw map[string]interface{}
+		if customClaims, ok := claims.CustomClaims.(*Claims); ok {
+			raw = customClaims.Raw
+		}
+
+		if err := v.claimMapping.Apply(raw, userClaims); err != nil {
+			return nil, err
+		}
+	}
+
 	return userClaims, nil
 }
 
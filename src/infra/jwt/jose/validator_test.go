@@ -1,11 +1,21 @@
 package jose
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/auth0/go-jwt-middleware/v2/validator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	jose2 "gopkg.in/square/go-jose.v2"
+	josejwt "gopkg.in/square/go-jose.v2/jwt"
 )
 
 func TestValidator_New(t *testing.T) {
@@ -15,6 +25,18 @@ func TestValidator_New(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should instantiate validator with several algorithms successfully", func(t *testing.T) {
+		cfg := NewConfig("http://issuer.url", []string{}, "", time.Minute, "ES256", "PS256", "EdDSA")
+		_, err := New(cfg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to instantiate validator with unsupported algorithm", func(t *testing.T) {
+		cfg := NewConfig("http://issuer.url", []string{}, "", time.Minute, "HS256")
+		_, err := New(cfg)
+		assert.Error(t, err)
+	})
+
 	t.Run("should failt to instantiate validator with invalid URL", func(t *testing.T) {
 		cfg := NewConfig("~ASD!'`://issuer.url", []string{}, "", time.Minute)
 		_, err := New(cfg)
@@ -22,6 +44,66 @@ func TestValidator_New(t *testing.T) {
 	})
 }
 
+func TestValidator_ValidateToken(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var issuerURL string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(rw).Encode(map[string]string{"jwks_uri": issuerURL + ".well-known/jwks.json"})
+		default:
+			_ = json.NewEncoder(rw).Encode(jose2.JSONWebKeySet{Keys: []jose2.JSONWebKey{
+				{Key: privKey.Public(), KeyID: "kid", Algorithm: "ES256", Use: "sig"},
+			}})
+		}
+	}))
+	defer server.Close()
+	issuerURL = server.URL + "/"
+
+	signToken := func(alg jose2.SignatureAlgorithm, key interface{}, issuer string) string {
+		signer, err := jose2.NewSigner(jose2.SigningKey{Algorithm: alg, Key: key}, (&jose2.SignerOptions{}).WithHeader("kid", "kid"))
+		require.NoError(t, err)
+		token, err := josejwt.Signed(signer).Claims(josejwt.Claims{
+			Issuer:   issuer,
+			Subject:  "tenant_id",
+			Audience: []string{"qkm"},
+			Expiry:   josejwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}).CompactSerialize()
+		require.NoError(t, err)
+		return token
+	}
+
+	v, err := New(NewConfig(issuerURL, []string{"qkm"}, "", time.Minute, "RS256", "ES256"))
+	require.NoError(t, err)
+
+	t.Run("should validate an ES256 token successfully", func(t *testing.T) {
+		claims, err := v.ValidateToken(ctx, signToken(jose2.ES256, privKey, issuerURL))
+		require.NoError(t, err)
+
+		userClaims, err := v.ParseClaims(claims)
+		require.NoError(t, err)
+		assert.Equal(t, "tenant_id", userClaims.Tenant)
+	})
+
+	t.Run("should fail if the token algorithm is not allowed", func(t *testing.T) {
+		_, err := v.ValidateToken(ctx, signToken(jose2.HS256, []byte("my-secret-key-of-sufficient-len"), issuerURL))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the token issuer does not match", func(t *testing.T) {
+		_, err := v.ValidateToken(ctx, signToken(jose2.ES256, privKey, "https://other.issuer/"))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the token is malformed", func(t *testing.T) {
+		_, err := v.ValidateToken(ctx, "invalid token")
+		assert.Error(t, err)
+	})
+}
+
 func TestValidator_Parser(t *testing.T) {
 	v := Validator{}
 
@@ -70,6 +152,63 @@ func TestValidator_Parser(t *testing.T) {
 		assert.Equal(t, []string{"read:*", "*:keys"}, c.Permissions)
 	})
 
+	t.Run("should parse token with claim mapping successfully", func(t *testing.T) {
+		mappedValidator := Validator{claimMapping: &ClaimMapping{
+			Tenant:      "org.tenant",
+			Username:    "preferred_username",
+			Roles:       "realm_access.roles",
+			Permissions: "permissions",
+			RoleMapping: map[string][]string{"kms-admins": {"admin", "signer"}, "kms-users": {"signer"}},
+		}}
+		tokenClaims := &validator.ValidatedClaims{
+			CustomClaims: &Claims{
+				Scope: []string{"read:*"},
+				Raw: map[string]interface{}{
+					"org":                map[string]interface{}{"tenant": "tenant_id_3"},
+					"preferred_username": "alice",
+					"realm_access":       map[string]interface{}{"roles": []interface{}{"kms-admins", "kms-users", "guest"}},
+					"permissions":        []interface{}{"sign:keys", "read:keys"},
+				},
+			},
+			RegisteredClaims: validator.RegisteredClaims{
+				Subject: "tenant_id",
+			},
+		}
+		c, err := mappedValidator.ParseClaims(tokenClaims)
+		assert.NoError(t, err)
+		assert.Equal(t, "tenant_id_3", c.Tenant)
+		assert.Equal(t, "alice", c.Username)
+		assert.Equal(t, []string{"admin", "signer"}, c.Roles)
+		assert.Equal(t, []string{"sign:keys", "read:keys"}, c.Permissions)
+	})
+
+	t.Run("should not grant roles to unmapped groups once a role mapping is set", func(t *testing.T) {
+		mappedValidator := Validator{claimMapping: &ClaimMapping{
+			Roles:       "groups",
+			RoleMapping: map[string][]string{"kms-users": {"signer"}},
+		}}
+		tokenClaims := &validator.ValidatedClaims{
+			CustomClaims: &Claims{
+				Raw: map[string]interface{}{"groups": []interface{}{"admin"}},
+			},
+			RegisteredClaims: validator.RegisteredClaims{
+				Subject: "tenant_id",
+			},
+		}
+		c, err := mappedValidator.ParseClaims(tokenClaims)
+		assert.NoError(t, err)
+		assert.Empty(t, c.Roles)
+	})
+
+	t.Run("should fail if mapped tenant claim is missing", func(t *testing.T) {
+		mappedValidator := Validator{claimMapping: &ClaimMapping{Tenant: "org.tenant"}}
+		tokenClaims := &validator.ValidatedClaims{
+			CustomClaims: &Claims{Raw: map[string]interface{}{}},
+		}
+		_, err := mappedValidator.ParseClaims(tokenClaims)
+		assert.Error(t, err)
+	})
+
 	t.Run("should fail if invalid token is passed", func(t *testing.T) {
 		tokenClaims := validator.ValidatedClaims{}
 		_, err := v.ParseClaims(tokenClaims)
@@ -0,0 +1,43 @@
+package akv
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/consensys/quorum-key-manager/src/infra/akv"
+	"github.com/consensys/quorum-key-manager/src/infra/masterkey"
+)
+
+// MasterKey wraps data encryption keys with an RSA key held in Azure Key Vault using RSA-OAEP-256
+type MasterKey struct {
+	client akv.KeysClient
+	keyID  string
+}
+
+var _ masterkey.MasterKey = &MasterKey{}
+
+func New(client akv.KeysClient, keyID string) *MasterKey {
+	return &MasterKey{
+		client: client,
+		keyID:  keyID,
+	}
+}
+
+func (k *MasterKey) Wrap(ctx context.Context, dek []byte) ([]byte, error) {
+	res, err := k.client.Encrypt(ctx, k.keyID, "", keyvault.RSAOAEP256, base64.RawURLEncoding.EncodeToString(dek))
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.RawURLEncoding.DecodeString(res)
+}
+
+func (k *MasterKey) Unwrap(ctx context.Context, wrappedDEK []byte) ([]byte, error) {
+	res, err := k.client.Decrypt(ctx, k.keyID, "", keyvault.RSAOAEP256, base64.RawURLEncoding.EncodeToString(wrappedDEK))
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.RawURLEncoding.DecodeString(res)
+}
@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/infra/aws"
+	"github.com/consensys/quorum-key-manager/src/infra/masterkey"
+)
+
+// MasterKey wraps data encryption keys with a symmetric AWS KMS key
+type MasterKey struct {
+	client aws.KmsClient
+	keyID  string
+}
+
+var _ masterkey.MasterKey = &MasterKey{}
+
+func New(client aws.KmsClient, keyID string) *MasterKey {
+	return &MasterKey{
+		client: client,
+		keyID:  keyID,
+	}
+}
+
+func (k *MasterKey) Wrap(ctx context.Context, dek []byte) ([]byte, error) {
+	out, err := k.client.Encrypt(ctx, k.keyID, dek)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.CiphertextBlob, nil
+}
+
+func (k *MasterKey) Unwrap(ctx context.Context, wrappedDEK []byte) ([]byte, error) {
+	out, err := k.client.Decrypt(ctx, k.keyID, wrappedDEK)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Plaintext, nil
+}
@@ -0,0 +1,13 @@
+package local
+
+type Config struct {
+	Env  string
+	Path string
+}
+
+func NewConfig(env, path string) *Config {
+	return &Config{
+		Env:  env,
+		Path: path,
+	}
+}
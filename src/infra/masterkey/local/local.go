@@ -0,0 +1,65 @@
+package local
+
+import (
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/src/infra/masterkey"
+)
+
+// MasterKey is an AES-256-GCM master key loaded from an environment variable or a file.
+// The key material is expected to be the base64 encoding of 32 random bytes
+type MasterKey struct {
+	aead cipher.AEAD
+}
+
+var _ masterkey.MasterKey = &MasterKey{}
+
+func New(cfg *Config) (*MasterKey, error) {
+	var encodedKey string
+	switch {
+	case cfg.Env != "":
+		encodedKey = os.Getenv(cfg.Env)
+		if encodedKey == "" {
+			return nil, fmt.Errorf("environment variable %s is empty", cfg.Env)
+		}
+	case cfg.Path != "":
+		data, err := ioutil.ReadFile(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		encodedKey = string(data)
+	default:
+		return nil, fmt.Errorf("either an environment variable or a file path must be specified")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, fmt.Errorf("master key must be base64 encoded: %w", err)
+	}
+
+	return NewFromKey(key)
+}
+
+func NewFromKey(key []byte) (*MasterKey, error) {
+	aead, err := aes.NewGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MasterKey{aead: aead}, nil
+}
+
+func (k *MasterKey) Wrap(_ context.Context, dek []byte) ([]byte, error) {
+	return aes.Seal(k.aead, dek, nil)
+}
+
+func (k *MasterKey) Unwrap(_ context.Context, wrappedDEK []byte) ([]byte, error) {
+	return aes.Open(k.aead, wrappedDEK, nil)
+}
@@ -0,0 +1,74 @@
+package local
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMasterKey(t *testing.T) {
+	ctx := context.Background()
+	key, _ := aes.NewKey()
+	encodedKey := base64.StdEncoding.EncodeToString(key)
+
+	t.Run("should wrap and unwrap a key loaded from an environment variable", func(t *testing.T) {
+		_ = os.Setenv("TEST_QKM_MASTER_KEY", encodedKey)
+		defer os.Unsetenv("TEST_QKM_MASTER_KEY")
+
+		masterKey, err := New(NewConfig("TEST_QKM_MASTER_KEY", ""))
+		require.NoError(t, err)
+
+		dek, _ := aes.NewKey()
+		wrappedDEK, err := masterKey.Wrap(ctx, dek)
+		require.NoError(t, err)
+		assert.NotEqual(t, dek, wrappedDEK)
+
+		unwrappedDEK, err := masterKey.Unwrap(ctx, wrappedDEK)
+		require.NoError(t, err)
+		assert.Equal(t, dek, unwrappedDEK)
+	})
+
+	t.Run("should load a key from a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "master.key")
+		require.NoError(t, ioutil.WriteFile(path, []byte(encodedKey+"\n"), 0600))
+
+		masterKey, err := New(NewConfig("", path))
+		require.NoError(t, err)
+
+		fromEnvKey, _ := NewFromKey(key)
+		wrappedDEK, _ := fromEnvKey.Wrap(ctx, []byte("dek"))
+		unwrappedDEK, err := masterKey.Unwrap(ctx, wrappedDEK)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("dek"), unwrappedDEK)
+	})
+
+	t.Run("should fail to unwrap with a different master key", func(t *testing.T) {
+		masterKey, _ := NewFromKey(key)
+		otherKey, _ := aes.NewKey()
+		otherMasterKey, _ := NewFromKey(otherKey)
+
+		wrappedDEK, _ := masterKey.Wrap(ctx, []byte("dek"))
+		_, err := otherMasterKey.Unwrap(ctx, wrappedDEK)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the key is not base64 encoded", func(t *testing.T) {
+		_ = os.Setenv("TEST_QKM_MASTER_KEY", "not base64!")
+		defer os.Unsetenv("TEST_QKM_MASTER_KEY")
+
+		_, err := New(NewConfig("TEST_QKM_MASTER_KEY", ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if no source is specified", func(t *testing.T) {
+		_, err := New(NewConfig("", ""))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,13 @@
+package masterkey
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=masterkey.go -destination=mock/masterkey.go -package=mock
+
+// MasterKey wraps and unwraps data encryption keys (envelope encryption)
+type MasterKey interface {
+	Wrap(ctx context.Context, dek []byte) ([]byte, error)
+	Unwrap(ctx context.Context, wrappedDEK []byte) ([]byte, error)
+}
@@ -0,0 +1,203 @@
+package identity
+
+import (
+	"crypto/x509"
+	"fmt"
+	"regexp"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+// Certificate fields identities can be extracted from
+const (
+	SubjectCommonNameSource         = "subject.cn"
+	SubjectOrganizationSource       = "subject.o"
+	SubjectOrganizationalUnitSource = "subject.ou"
+	URISANSource                    = "uri_san"
+	DNSSANSource                    = "dns_san"
+	EmailSource                     = "email"
+)
+
+// Mapping derives user claims from a client certificate. Fields are extracted first, then the first rule matching
+// a URI SAN (ie. a SPIFFE ID) overrides the tenant and username and adds its roles and permissions
+type Mapping struct {
+	Tenant      *Field
+	Username    *Field
+	Roles       *Field
+	Permissions *Field
+	Rules       []*Rule
+}
+
+// Field extracts the values of a certificate field. When Pattern is set, values not matching it entirely are ignored
+// and the first capture group, if any, is extracted from the values matching it
+type Field struct {
+	Source  string
+	Pattern *regexp.Regexp
+}
+
+// Rule maps a URI SAN equal to SPIFFEID, or matching Pattern entirely, to an identity.
+// Tenant and Username can reference the capture groups of Pattern (ie. "${ns}" or "$1")
+type Rule struct {
+	SPIFFEID    string
+	Pattern     *regexp.Regexp
+	Tenant      string
+	Username    string
+	Roles       []string
+	Permissions []string
+}
+
+// DefaultMapping reads the tenant from the subject common name, the roles from the organizations and the permissions
+// from the organizational units
+func DefaultMapping() *Mapping {
+	return &Mapping{
+		Tenant:      &Field{Source: SubjectCommonNameSource},
+		Roles:       &Field{Source: SubjectOrganizationSource},
+		Permissions: &Field{Source: SubjectOrganizationalUnitSource},
+	}
+}
+
+func NewField(source, pattern string) (*Field, error) {
+	switch source {
+	case SubjectCommonNameSource, SubjectOrganizationSource, SubjectOrganizationalUnitSource, URISANSource, DNSSANSource, EmailSource:
+	default:
+		return nil, fmt.Errorf("invalid certificate field %q", source)
+	}
+
+	field := &Field{Source: source}
+	if pattern != "" {
+		var err error
+		if field.Pattern, err = compileAnchored(pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern for certificate field %q: %w", source, err)
+		}
+	}
+
+	return field, nil
+}
+
+func NewRule(spiffeID, pattern, tenant, username string, roles, permissions []string) (*Rule, error) {
+	if (spiffeID == "") == (pattern == "") {
+		return nil, fmt.Errorf("identity rule must have either a SPIFFE ID or a pattern")
+	}
+
+	rule := &Rule{
+		SPIFFEID:    spiffeID,
+		Tenant:      tenant,
+		Username:    username,
+		Roles:       roles,
+		Permissions: permissions,
+	}
+	if pattern != "" {
+		var err error
+		if rule.Pattern, err = compileAnchored(pattern); err != nil {
+			return nil, fmt.Errorf("invalid identity rule pattern: %w", err)
+		}
+	}
+
+	return rule, nil
+}
+
+// compileAnchored compiles a pattern that must match the whole value, so that a pattern granting an identity cannot
+// be satisfied by a value merely containing a matching substring
+func compileAnchored(pattern string) (*regexp.Regexp, error) {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, err
+	}
+
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func (m *Mapping) Apply(cert *x509.Certificate) *entities.UserClaims {
+	claims := &entities.UserClaims{}
+
+	if values := m.Tenant.values(cert); len(values) > 0 {
+		claims.Tenant = values[0]
+	}
+	if values := m.Username.values(cert); len(values) > 0 {
+		claims.Username = values[0]
+	}
+	claims.Roles = m.Roles.values(cert)
+	claims.Permissions = m.Permissions.values(cert)
+
+	for _, rule := range m.Rules {
+		for _, uri := range cert.URIs {
+			if rule.apply(uri.String(), claims) {
+				return claims
+			}
+		}
+	}
+
+	return claims
+}
+
+func (f *Field) values(cert *x509.Certificate) []string {
+	if f == nil {
+		return nil
+	}
+
+	var values []string
+	switch f.Source {
+	case SubjectCommonNameSource:
+		if cert.Subject.CommonName != "" {
+			values = []string{cert.Subject.CommonName}
+		}
+	case SubjectOrganizationSource:
+		values = cert.Subject.Organization
+	case SubjectOrganizationalUnitSource:
+		values = cert.Subject.OrganizationalUnit
+	case URISANSource:
+		for _, uri := range cert.URIs {
+			values = append(values, uri.String())
+		}
+	case DNSSANSource:
+		values = cert.DNSNames
+	case EmailSource:
+		values = cert.EmailAddresses
+	}
+
+	if f.Pattern == nil {
+		return values
+	}
+
+	var extracted []string
+	for _, value := range values {
+		match := f.Pattern.FindStringSubmatch(value)
+		switch {
+		case match == nil:
+		case len(match) > 1:
+			extracted = append(extracted, match[1])
+		default:
+			extracted = append(extracted, match[0])
+		}
+	}
+
+	return extracted
+}
+
+func (r *Rule) apply(uri string, claims *entities.UserClaims) bool {
+	expand := func(template string) string { return template }
+	if r.SPIFFEID != "" {
+		if uri != r.SPIFFEID {
+			return false
+		}
+	} else {
+		match := r.Pattern.FindStringSubmatchIndex(uri)
+		if match == nil {
+			return false
+		}
+
+		expand = func(template string) string {
+			return string(r.Pattern.ExpandString(nil, template, uri, match))
+		}
+	}
+
+	if r.Tenant != "" {
+		claims.Tenant = expand(r.Tenant)
+	}
+	if r.Username != "" {
+		claims.Username = expand(r.Username)
+	}
+	claims.Roles = append(claims.Roles, r.Roles...)
+	claims.Permissions = append(claims.Permissions, r.Permissions...)
+
+	return true
+}
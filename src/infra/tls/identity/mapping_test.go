@@ -0,0 +1,100 @@
+package identity
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCertificate(t *testing.T, uris ...string) *x509.Certificate {
+	cert := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "alice",
+			Organization:       []string{"admin", "signer"},
+			OrganizationalUnit: []string{"read:accounts"},
+		},
+		DNSNames:       []string{"api.payments.svc.cluster.local"},
+		EmailAddresses: []string{"alice@consensys.net"},
+	}
+
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+		cert.URIs = append(cert.URIs, u)
+	}
+
+	return cert
+}
+
+func TestMapping(t *testing.T) {
+	t.Run("should map subject fields with the default mapping", func(t *testing.T) {
+		claims := DefaultMapping().Apply(newCertificate(t))
+
+		assert.Equal(t, "alice", claims.Tenant)
+		assert.Equal(t, []string{"admin", "signer"}, claims.Roles)
+		assert.Equal(t, []string{"read:accounts"}, claims.Permissions)
+	})
+
+	t.Run("should extract values with patterns", func(t *testing.T) {
+		tenant, err := NewField(DNSSANSource, `[^.]+\.([^.]+)\.svc\.cluster\.local`)
+		require.NoError(t, err)
+		username, err := NewField(EmailSource, `([^@]+)@consensys\.net`)
+		require.NoError(t, err)
+
+		claims := (&Mapping{Tenant: tenant, Username: username}).Apply(newCertificate(t))
+
+		assert.Equal(t, "payments", claims.Tenant)
+		assert.Equal(t, "alice", claims.Username)
+		assert.Empty(t, claims.Roles)
+	})
+
+	t.Run("should map SPIFFE IDs with rules", func(t *testing.T) {
+		exact, err := NewRule("spiffe://corp/ns/payments/sa/api", "", "payments", "api", []string{"signer"}, nil)
+		require.NoError(t, err)
+		pattern, err := NewRule("", `^spiffe://corp/ns/(?P<ns>[^/]+)/sa/(?P<sa>[^/]+)$`, "${ns}", "$sa", []string{"reader"}, []string{"read:keys"})
+		require.NoError(t, err)
+		mapping := &Mapping{Rules: []*Rule{exact, pattern}}
+
+		claims := mapping.Apply(newCertificate(t, "spiffe://corp/ns/payments/sa/api"))
+		assert.Equal(t, "payments", claims.Tenant)
+		assert.Equal(t, "api", claims.Username)
+		assert.Equal(t, []string{"signer"}, claims.Roles)
+
+		claims = mapping.Apply(newCertificate(t, "spiffe://corp/ns/billing/sa/worker"))
+		assert.Equal(t, "billing", claims.Tenant)
+		assert.Equal(t, "worker", claims.Username)
+		assert.Equal(t, []string{"reader"}, claims.Roles)
+		assert.Equal(t, []string{"read:keys"}, claims.Permissions)
+
+		claims = mapping.Apply(newCertificate(t, "spiffe://other/ns/billing/sa/worker"))
+		assert.Empty(t, claims.Tenant)
+	})
+
+	t.Run("should only match patterns against whole values", func(t *testing.T) {
+		tenant, err := NewField(DNSSANSource, `[^.]+\.([^.]+)\.svc`)
+		require.NoError(t, err)
+		rule, err := NewRule("", `spiffe://corp/ns/([^/]+)/sa/api`, "$1", "", nil, nil)
+		require.NoError(t, err)
+
+		claims := (&Mapping{Tenant: tenant}).Apply(newCertificate(t))
+		assert.Empty(t, claims.Tenant)
+
+		claims = (&Mapping{Rules: []*Rule{rule}}).Apply(newCertificate(t, "spiffe://corp/ns/payments/sa/api/other"))
+		assert.Empty(t, claims.Tenant)
+	})
+
+	t.Run("should fail to create invalid fields and rules", func(t *testing.T) {
+		_, err := NewField("subject.unknown", "")
+		assert.Error(t, err)
+		_, err = NewField(URISANSource, "(")
+		assert.Error(t, err)
+		_, err = NewRule("spiffe://corp/ns/payments/sa/api", "^spiffe://", "payments", "", nil, nil)
+		assert.Error(t, err)
+		_, err = NewRule("", "", "payments", "", nil, nil)
+		assert.Error(t, err)
+	})
+}
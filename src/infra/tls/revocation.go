@@ -0,0 +1,18 @@
+package tls
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+)
+
+//go:generate mockgen -source=revocation.go -destination=mock/revocation.go -package=mock
+
+// ErrRevoked is returned (wrapped) by a RevocationChecker when a certificate of the chain has been revoked
+var ErrRevoked = errors.New("certificate revoked")
+
+// RevocationChecker checks the revocation status of certificate chains
+type RevocationChecker interface {
+	// Check verifies that no certificate of the verified chain (leaf first) has been revoked
+	Check(ctx context.Context, chain []*x509.Certificate) error
+}
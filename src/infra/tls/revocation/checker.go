@@ -0,0 +1,99 @@
+package revocation
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/tls"
+)
+
+type status int
+
+const (
+	statusUnknown status = iota
+	statusGood
+	statusRevoked
+)
+
+// Checker checks certificates against CRLs, reloaded in the background by RefreshCRLs, then against OCSP responses, queried
+// and cached until their next update.
+// Certificates covered by no CRL and no OCSP responder are accepted, certificates whose status cannot be determined
+// because a source is unavailable are rejected unless FailOpen is set
+type Checker struct {
+	cfg    *Config
+	client *http.Client
+	logger log.Logger
+	now    func() time.Time
+
+	crlMux sync.RWMutex
+	crls   map[string]*crlEntry
+	crlErr error
+	// crlsLoadedAt is the time every CRL was last loaded successfully
+	crlsLoadedAt time.Time
+
+	ocspMux   sync.Mutex
+	ocspCache map[string]*ocspEntry
+}
+
+var _ tls.RevocationChecker = &Checker{}
+
+// New creates a Checker and loads the configured CRLs, failing if one of them cannot be loaded
+func New(ctx context.Context, cfg *Config, logger log.Logger) (*Checker, error) {
+	c := &Checker{
+		cfg:       cfg,
+		client:    &http.Client{Timeout: cfg.Timeout},
+		logger:    logger,
+		now:       time.Now,
+		crls:      make(map[string]*crlEntry),
+		ocspCache: make(map[string]*ocspEntry),
+	}
+
+	err := c.RefreshCRLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Checker) Check(ctx context.Context, chain []*x509.Certificate) error {
+	// The last certificate of a verified chain is a trusted root
+	for i := 0; i < len(chain)-1; i++ {
+		cert, issuer := chain[i], chain[i+1]
+
+		certStatus, err := c.checkCertificate(ctx, cert, issuer)
+		if certStatus == statusRevoked {
+			return fmt.Errorf("%w: serial number %s", tls.ErrRevoked, cert.SerialNumber)
+		}
+
+		if err != nil {
+			if c.cfg.FailOpen {
+				c.logger.WithError(err).Warn("revocation status unavailable, accepting certificate", "serial", cert.SerialNumber.String())
+				continue
+			}
+
+			return fmt.Errorf("revocation status of certificate %s unavailable: %w", cert.SerialNumber, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Checker) checkCertificate(ctx context.Context, cert, issuer *x509.Certificate) (status, error) {
+	crlStatus, crlErr := c.crlStatus(cert, issuer)
+	if crlStatus != statusUnknown {
+		return crlStatus, nil
+	}
+
+	ocspStatus, err := c.ocspStatus(ctx, cert, issuer)
+	if ocspStatus != statusUnknown || err != nil {
+		return ocspStatus, err
+	}
+
+	return statusUnknown, crlErr
+}
@@ -0,0 +1,275 @@
+package revocation
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testutils "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/infra/tls"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+type testPKI struct {
+	ca    *x509.Certificate
+	caKey crypto.Signer
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca", Organization: []string{"ConsenSys"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testPKI{ca: ca, caKey: key}
+}
+
+func (p *testPKI) leaf(t *testing.T, serial int64, ocspServer string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "tenant"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if ocspServer != "" {
+		template.OCSPServer = []string{ocspServer}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, p.ca, key.Public(), p.caKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func (p *testPKI) crl(t *testing.T, nextUpdate time.Time, revokedSerials ...int64) []byte {
+	var revoked []pkix.RevokedCertificate
+	for _, serial := range revokedSerials {
+		revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              big.NewInt(time.Now().UnixNano()),
+		ThisUpdate:          nextUpdate.Add(-time.Hour),
+		NextUpdate:          nextUpdate,
+		RevokedCertificates: revoked,
+	}, p.ca, p.caKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func (p *testPKI) ocspResponse(t *testing.T, cert *x509.Certificate, certStatus int) []byte {
+	resp, err := ocsp.CreateResponse(p.ca, p.ca, ocsp.Response{
+		Status:       certStatus,
+		SerialNumber: cert.SerialNumber,
+		ThisUpdate:   time.Now().Add(-time.Minute),
+		NextUpdate:   time.Now().Add(time.Hour),
+		RevokedAt:    time.Now().Add(-time.Minute),
+	}, p.caKey)
+	require.NoError(t, err)
+
+	return resp
+}
+
+func writeFile(t *testing.T, data []byte) string {
+	path := filepath.Join(t.TempDir(), "ca.crl")
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+	return path
+}
+
+func TestChecker_CRL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	pki := newTestPKI(t)
+	revokedCert := pki.leaf(t, 10, "")
+	validCert := pki.leaf(t, 11, "")
+
+	t.Run("should reject a certificate revoked in a CRL file", func(t *testing.T) {
+		path := writeFile(t, pki.crl(t, time.Now().Add(time.Hour), 10))
+		checker, err := New(ctx, NewConfig([]string{path}, time.Hour, false, time.Minute, false), testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+
+		err = checker.Check(ctx, []*x509.Certificate{revokedCert, pki.ca})
+		assert.True(t, errors.Is(err, tls.ErrRevoked))
+
+		err = checker.Check(ctx, []*x509.Certificate{validCert, pki.ca})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reload CRL URLs on refresh", func(t *testing.T) {
+		crl := pki.crl(t, time.Now().Add(time.Hour))
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			_, _ = rw.Write(crl)
+		}))
+		defer server.Close()
+
+		checker, err := New(ctx, NewConfig([]string{server.URL}, time.Hour, false, time.Minute, false), testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+
+		err = checker.Check(ctx, []*x509.Certificate{revokedCert, pki.ca})
+		require.NoError(t, err)
+
+		crl = pki.crl(t, time.Now().Add(3*time.Hour), 10)
+		err = checker.Check(ctx, []*x509.Certificate{revokedCert, pki.ca})
+		require.NoError(t, err)
+
+		require.NoError(t, checker.RefreshCRLs(ctx))
+
+		err = checker.Check(ctx, []*x509.Certificate{revokedCert, pki.ca})
+		assert.True(t, errors.Is(err, tls.ErrRevoked))
+	})
+
+	t.Run("should keep the previous CRL if it cannot be reloaded", func(t *testing.T) {
+		crl := pki.crl(t, time.Now().Add(time.Hour), 10)
+		available := true
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			if !available {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = rw.Write(crl)
+		}))
+		defer server.Close()
+
+		checker, err := New(ctx, NewConfig([]string{server.URL}, time.Hour, false, time.Minute, false), testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+		loadedAt := checker.crlsLoadedAt
+
+		available = false
+		err = checker.RefreshCRLs(ctx)
+		require.Error(t, err)
+		assert.Equal(t, loadedAt, checker.crlsLoadedAt)
+
+		err = checker.Check(ctx, []*x509.Certificate{revokedCert, pki.ca})
+		assert.True(t, errors.Is(err, tls.ErrRevoked))
+	})
+
+	t.Run("should fail if the CRL is outdated unless fail open", func(t *testing.T) {
+		path := writeFile(t, pki.crl(t, time.Now().Add(-time.Minute)))
+
+		checker, err := New(ctx, NewConfig([]string{path}, time.Hour, false, time.Minute, false), testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+		err = checker.Check(ctx, []*x509.Certificate{validCert, pki.ca})
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, tls.ErrRevoked))
+
+		checker, err = New(ctx, NewConfig([]string{path}, time.Hour, false, time.Minute, true), testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+		err = checker.Check(ctx, []*x509.Certificate{validCert, pki.ca})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to create the checker if a CRL cannot be loaded", func(t *testing.T) {
+		_, err := New(ctx, NewConfig([]string{filepath.Join(t.TempDir(), "missing.crl")}, time.Hour, false, time.Minute, false), testutils.NewMockLogger(ctrl))
+		assert.Error(t, err)
+	})
+}
+
+func TestChecker_OCSP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	pki := newTestPKI(t)
+
+	var calls int32
+	responses := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := ioutil.ReadAll(req.Body)
+		ocspReq, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+
+		certStatus, ok := responses[ocspReq.SerialNumber.String()]
+		if !ok {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resp, err := ocsp.CreateResponse(pki.ca, pki.ca, ocsp.Response{
+			Status:       certStatus,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, pki.caKey)
+		require.NoError(t, err)
+		_, _ = rw.Write(resp)
+	}))
+	defer server.Close()
+
+	revokedCert := pki.leaf(t, 20, server.URL)
+	validCert := pki.leaf(t, 21, server.URL)
+	unavailableCert := pki.leaf(t, 22, server.URL)
+	responses["20"] = ocsp.Revoked
+	responses["21"] = ocsp.Good
+
+	checker, err := New(ctx, NewConfig(nil, time.Hour, true, time.Minute, false), testutils.NewMockLogger(ctrl))
+	require.NoError(t, err)
+
+	t.Run("should reject a certificate revoked by the OCSP responder", func(t *testing.T) {
+		err := checker.Check(ctx, []*x509.Certificate{revokedCert, pki.ca})
+		assert.True(t, errors.Is(err, tls.ErrRevoked))
+	})
+
+	t.Run("should cache OCSP responses", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+
+		require.NoError(t, checker.Check(ctx, []*x509.Certificate{validCert, pki.ca}))
+		require.NoError(t, checker.Check(ctx, []*x509.Certificate{validCert, pki.ca}))
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("should fail if the OCSP responder is unavailable unless fail open", func(t *testing.T) {
+		err := checker.Check(ctx, []*x509.Certificate{unavailableCert, pki.ca})
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, tls.ErrRevoked))
+
+		failOpenChecker, err := New(ctx, NewConfig(nil, time.Hour, true, time.Minute, true), testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+		err = failOpenChecker.Check(ctx, []*x509.Certificate{unavailableCert, pki.ca})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should accept certificates without revocation information", func(t *testing.T) {
+		err := checker.Check(ctx, []*x509.Certificate{pki.leaf(t, 23, ""), pki.ca})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,30 @@
+package revocation
+
+import (
+	"time"
+)
+
+type Config struct {
+	// CRLs are file paths or http(s) URLs of PEM or DER encoded certificate revocation lists
+	CRLs               []string
+	CRLRefreshInterval time.Duration
+
+	// OCSP enables queries to the OCSP responders listed in the certificates
+	OCSP         bool
+	OCSPCacheTTL time.Duration
+
+	// FailOpen accepts certificates whose revocation status cannot be determined
+	FailOpen bool
+	Timeout  time.Duration
+}
+
+func NewConfig(crls []string, crlRefreshInterval time.Duration, ocsp bool, ocspCacheTTL time.Duration, failOpen bool) *Config {
+	return &Config{
+		CRLs:               crls,
+		CRLRefreshInterval: crlRefreshInterval,
+		OCSP:               ocsp,
+		OCSPCacheTTL:       ocspCacheTTL,
+		FailOpen:           failOpen,
+		Timeout:            10 * time.Second,
+	}
+}
@@ -0,0 +1,169 @@
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CRLs and OCSP responses bigger than this size are rejected
+const maxResponseSize = 32 << 20
+
+type crlEntry struct {
+	list       *pkix.CertificateList
+	issuer     string
+	revoked    map[string]bool
+	nextUpdate time.Time
+
+	// The issuing certificate is only known from the verified chains, the signature of the CRL is verified once per
+	// issuer and the result is kept until the CRL is reloaded
+	signatureMux sync.Mutex
+	signatureErr map[string]error
+}
+
+func (c *Checker) crlStatus(cert, issuer *x509.Certificate) (status, error) {
+	if len(c.cfg.CRLs) == 0 {
+		return statusUnknown, nil
+	}
+
+	// CRLs are refreshed in the background, requests never wait for them to be fetched
+	c.crlMux.RLock()
+	crls, loadErr := c.crls, c.crlErr
+	c.crlMux.RUnlock()
+
+	issuerName := issuer.Subject.ToRDNSequence().String()
+	for source, entry := range crls {
+		if entry.issuer != issuerName {
+			continue
+		}
+
+		if err := entry.checkSignature(issuer); err != nil {
+			return statusUnknown, fmt.Errorf("invalid signature of crl %s: %w", source, err)
+		}
+
+		// Revocations are permanent so an outdated CRL is only unreliable for the certificates it does not list
+		if entry.revoked[cert.SerialNumber.String()] {
+			return statusRevoked, nil
+		}
+
+		if !entry.nextUpdate.IsZero() && c.now().After(entry.nextUpdate) {
+			return statusUnknown, fmt.Errorf("crl %s is outdated since %s", source, entry.nextUpdate)
+		}
+
+		return statusGood, nil
+	}
+
+	// A CRL that could not be loaded may have covered the certificate
+	return statusUnknown, loadErr
+}
+
+// RefreshCRLs reloads every CRL, keeping the previous version of the ones that fail to load. CRLs are fetched without
+// holding the lock used by the requests, the time of the last load is only updated when every CRL is loaded
+func (c *Checker) RefreshCRLs(ctx context.Context) error {
+	c.crlMux.RLock()
+	previousCRLs := c.crls
+	c.crlMux.RUnlock()
+
+	crls := make(map[string]*crlEntry, len(c.cfg.CRLs))
+	var loadErr error
+	for _, source := range c.cfg.CRLs {
+		entry, err := c.loadCRL(ctx, source)
+		if err != nil {
+			c.logger.WithError(err).Error("failed to load crl", "source", source)
+			loadErr = fmt.Errorf("failed to load crl %s: %w", source, err)
+			if previous, ok := previousCRLs[source]; ok {
+				crls[source] = previous
+			}
+			continue
+		}
+
+		crls[source] = entry
+	}
+
+	c.crlMux.Lock()
+	defer c.crlMux.Unlock()
+
+	c.crls = crls
+	c.crlErr = loadErr
+	if loadErr == nil {
+		c.crlsLoadedAt = c.now()
+	}
+
+	return loadErr
+}
+
+func (c *Checker) loadCRL(ctx context.Context, source string) (*crlEntry, error) {
+	var data []byte
+	var err error
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		data, err = c.fetch(ctx, http.MethodGet, source, "", nil)
+	} else {
+		data, err = ioutil.ReadFile(source)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// ParseCRL accepts both PEM and DER encoded lists
+	list, err := x509.ParseCRL(data)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make(map[string]bool, len(list.TBSCertList.RevokedCertificates))
+	for _, revokedCert := range list.TBSCertList.RevokedCertificates {
+		revoked[revokedCert.SerialNumber.String()] = true
+	}
+
+	return &crlEntry{
+		list:         list,
+		issuer:       list.TBSCertList.Issuer.String(),
+		revoked:      revoked,
+		nextUpdate:   list.TBSCertList.NextUpdate,
+		signatureErr: make(map[string]error),
+	}, nil
+}
+
+func (e *crlEntry) checkSignature(issuer *x509.Certificate) error {
+	e.signatureMux.Lock()
+	defer e.signatureMux.Unlock()
+
+	key := string(issuer.Raw)
+	if err, ok := e.signatureErr[key]; ok {
+		return err
+	}
+
+	err := issuer.CheckCRLSignature(e.list)
+	e.signatureErr[key] = err
+	return err
+}
+
+func (c *Checker) fetch(ctx context.Context, method, url, contentType string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
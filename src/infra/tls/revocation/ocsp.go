@@ -0,0 +1,112 @@
+package revocation
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// Expired entries are purged from the OCSP cache once it holds this many responses
+const ocspCacheSweepSize = 1000
+
+type ocspEntry struct {
+	status    status
+	expiresAt time.Time
+}
+
+func (c *Checker) ocspStatus(ctx context.Context, cert, issuer *x509.Certificate) (status, error) {
+	if !c.cfg.OCSP || len(cert.OCSPServer) == 0 {
+		return statusUnknown, nil
+	}
+
+	key := ocspCacheKey(cert, issuer)
+	if entry, ok := c.cachedOCSP(key); ok {
+		return entry.status, nil
+	}
+
+	req, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return statusUnknown, err
+	}
+
+	data, err := c.fetch(ctx, http.MethodPost, cert.OCSPServer[0], "application/ocsp-request", req)
+	if err != nil {
+		return statusUnknown, fmt.Errorf("ocsp request failed: %w", err)
+	}
+
+	resp, err := ocsp.ParseResponseForCert(data, cert, issuer)
+	if err != nil {
+		return statusUnknown, fmt.Errorf("invalid ocsp response: %w", err)
+	}
+
+	if !c.isFresh(resp) {
+		return statusUnknown, fmt.Errorf("ocsp response is outdated since %s", resp.NextUpdate)
+	}
+
+	respStatus, err := responseStatus(resp)
+	if err != nil {
+		return statusUnknown, err
+	}
+
+	c.storeOCSP(key, respStatus, resp.NextUpdate)
+
+	return respStatus, nil
+}
+
+func (c *Checker) isFresh(resp *ocsp.Response) bool {
+	return resp.NextUpdate.IsZero() || c.now().Before(resp.NextUpdate)
+}
+
+func responseStatus(resp *ocsp.Response) (status, error) {
+	switch resp.Status {
+	case ocsp.Good:
+		return statusGood, nil
+	case ocsp.Revoked:
+		return statusRevoked, nil
+	default:
+		return statusUnknown, fmt.Errorf("ocsp responder does not know the certificate")
+	}
+}
+
+func (c *Checker) cachedOCSP(key string) (*ocspEntry, bool) {
+	c.ocspMux.Lock()
+	defer c.ocspMux.Unlock()
+
+	entry, ok := c.ocspCache[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// storeOCSP caches a response for OCSPCacheTTL, or until its next update if sooner
+func (c *Checker) storeOCSP(key string, respStatus status, nextUpdate time.Time) {
+	expiresAt := c.now().Add(c.cfg.OCSPCacheTTL)
+	if !nextUpdate.IsZero() && nextUpdate.Before(expiresAt) {
+		expiresAt = nextUpdate
+	}
+
+	c.ocspMux.Lock()
+	defer c.ocspMux.Unlock()
+
+	if len(c.ocspCache) >= ocspCacheSweepSize {
+		for k, entry := range c.ocspCache {
+			if !c.now().Before(entry.expiresAt) {
+				delete(c.ocspCache, k)
+			}
+		}
+	}
+
+	c.ocspCache[key] = &ocspEntry{status: respStatus, expiresAt: expiresAt}
+}
+
+func ocspCacheKey(cert, issuer *x509.Certificate) string {
+	issuerHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+	return fmt.Sprintf("%x:%s", issuerHash, cert.SerialNumber)
+}
@@ -12,7 +12,7 @@ import (
 
 func (i *Nodes) Get(ctx context.Context, name string, userInfo *authtypes.UserInfo) (*proxynode.Node, error) {
 	permissions := i.roles.UserPermissions(ctx, userInfo)
-	resolver := authorizator.New(permissions, userInfo.Tenant, i.logger)
+	resolver := authorizator.New(ctx, permissions, userInfo.Tenant, i.logger)
 
 	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionProxy, Resource: authtypes.ResourceNode})
 	if err != nil {
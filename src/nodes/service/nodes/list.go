@@ -15,7 +15,7 @@ func (i *Nodes) List(ctx context.Context, userInfo *entities.UserInfo) ([]string
 	var nodeNames []string
 	for name, nodeInfo := range i.nodes {
 		permissions := i.roles.UserPermissions(ctx, userInfo)
-		resolver := authorizator.New(permissions, userInfo.Tenant, i.logger)
+		resolver := authorizator.New(ctx, permissions, userInfo.Tenant, i.logger)
 
 		if err := resolver.CheckAccess(nodeInfo.AllowedTenants); err != nil {
 			continue
@@ -6,6 +6,7 @@ import (
 	common2 "github.com/consensys/quorum-key-manager/pkg/common"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	quorumtypes "github.com/consensys/quorum/core/types"
@@ -150,6 +151,14 @@ func FormatEthAccResponse(ethAcc *entities.ETHAccount) *types.EthAccountResponse
 		Disabled:            ethAcc.Metadata.Disabled,
 	}
 
+	if !ethAcc.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &ethAcc.Metadata.ExpireAt
+	}
+
+	if ethAcc.Metadata.RecoveryPeriod > 0 {
+		resp.RecoveryPeriod = &json.Duration{Duration: ethAcc.Metadata.RecoveryPeriod}
+	}
+
 	if !ethAcc.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &ethAcc.Metadata.DeletedAt
 	}
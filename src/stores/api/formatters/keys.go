@@ -3,6 +3,7 @@ package formatters
 import (
 	"encoding/base64"
 
+	"github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
@@ -15,11 +16,32 @@ func FormatKeyResponse(key *entities.Key) *types.KeyResponse {
 		SigningAlgorithm: string(key.Algo.Type),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
+		Version:          key.Metadata.Version,
 		Disabled:         key.Metadata.Disabled,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
 	}
 
+	for _, op := range key.Operations {
+		resp.Operations = append(resp.Operations, string(op))
+	}
+
+	if !key.Metadata.ExpireAt.IsZero() {
+		resp.ExpireAt = &key.Metadata.ExpireAt
+	}
+
+	if key.RotationPeriod > 0 {
+		resp.RotationPeriod = &json.Duration{Duration: key.RotationPeriod}
+	}
+
+	if !key.NextRotationAt.IsZero() {
+		resp.NextRotationAt = &key.NextRotationAt
+	}
+
+	if key.Metadata.RecoveryPeriod > 0 {
+		resp.RecoveryPeriod = &json.Duration{Duration: key.Metadata.RecoveryPeriod}
+	}
+
 	if !key.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &key.Metadata.DeletedAt
 	}
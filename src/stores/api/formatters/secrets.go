@@ -1,6 +1,7 @@
 package formatters
 
 import (
+	"github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
@@ -10,12 +11,21 @@ func FormatSecretResponse(secret *entities.Secret) *types.SecretResponse {
 		ID:        secret.ID,
 		Version:   secret.Metadata.Version,
 		Value:     secret.Value,
+		ValueType: string(secret.ValueType),
 		Tags:      secret.Tags,
 		Disabled:  secret.Metadata.Disabled,
 		CreatedAt: secret.Metadata.CreatedAt,
 		UpdatedAt: secret.Metadata.UpdatedAt,
 	}
 
+	if resp.ValueType == "" {
+		resp.ValueType = string(entities.StringSecretValue)
+	}
+
+	if secret.Metadata.RecoveryPeriod > 0 {
+		resp.RecoveryPeriod = &json.Duration{Duration: secret.Metadata.RecoveryPeriod}
+	}
+
 	if !secret.Metadata.DeletedAt.IsZero() {
 		resp.DeletedAt = &secret.Metadata.DeletedAt
 	}
@@ -0,0 +1,41 @@
+package formatters
+
+import (
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func FormatMigrateStoreResponse(items []*entities.MigratedItem) *types.MigrateStoreResponse {
+	return &types.MigrateStoreResponse{Items: formatMigratedItems(items)}
+}
+
+func FormatRestoreStoreResponse(items []*entities.MigratedItem) *types.RestoreStoreResponse {
+	return &types.RestoreStoreResponse{Items: formatMigratedItems(items)}
+}
+
+func FormatBackupEncryption(req *types.BackupStoreRequest) *entities.BackupEncryption {
+	return &entities.BackupEncryption{
+		Passphrase: req.Passphrase,
+		PublicKey:  req.PublicKey,
+	}
+}
+
+func FormatBackupDecryption(req *types.RestoreStoreRequest) *entities.BackupDecryption {
+	return &entities.BackupDecryption{
+		Passphrase: req.Passphrase,
+		PrivateKey: req.PrivateKey,
+	}
+}
+
+func formatMigratedItems(items []*entities.MigratedItem) []*types.MigratedItemResponse {
+	resp := []*types.MigratedItemResponse{}
+	for _, item := range items {
+		resp = append(resp, &types.MigratedItemResponse{
+			ID:     item.ID,
+			Status: string(item.Status),
+			Error:  item.Error,
+		})
+	}
+
+	return resp
+}
@@ -88,7 +88,10 @@ func (h *EthHandler) create(rw http.ResponseWriter, request *http.Request) {
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{Tags: createReq.Tags})
+	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{
+		Tags:     createReq.Tags,
+		Recovery: recovery(createReq.RecoveryPeriod),
+	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -138,7 +141,10 @@ func (h *EthHandler) importAccount(rw http.ResponseWriter, request *http.Request
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Import(ctx, keyID, importReq.PrivateKey, &entities.Attributes{Tags: importReq.Tags})
+	ethAcc, err := ethStore.Import(ctx, keyID, importReq.PrivateKey, &entities.Attributes{
+		Tags:     importReq.Tags,
+		Recovery: recovery(importReq.RecoveryPeriod),
+	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -182,7 +188,11 @@ func (h *EthHandler) update(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	ethAcc, err := ethStore.Update(ctx, getAddress(request), &entities.Attributes{Tags: updateReq.Tags})
+	ethAcc, err := ethStore.Update(ctx, getAddress(request), &entities.Attributes{
+		Tags:     updateReq.Tags,
+		Disabled: updateReq.Disabled,
+		ExpireAt: updateReq.ExpireAt,
+	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -470,15 +480,24 @@ func (h *EthHandler) getOne(rw http.ResponseWriter, request *http.Request) {
 // @Tags         Ethereum
 // @Accept       json
 // @Produce      json
-// @Param        storeName   path      string                   true   "Store ID"
-// @Param        deleted     query     bool                     false  "filter by only deleted accounts"
-// @Param        chain_uuid  query     string                   false  "Chain UUID"
-// @Param        limit       query     int                      false  "page size"
-// @Param        page        query     int                      false  "page number"
-// @Success      200         {array}   infrahttp.PageResponse   "Ethereum Account list"
-// @Failure      401         {object}  infrahttp.ErrorResponse  "Unauthorized"
-// @Failure      403         {object}  infrahttp.ErrorResponse  "Forbidden"
-// @Failure      500         {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Param        storeName       path      string                   true   "Store ID"
+// @Param        deleted         query     bool                     false  "filter by only deleted accounts"
+// @Param        chain_uuid      query     string                   false  "Chain UUID"
+// @Param        limit           query     int                      false  "page size"
+// @Param        page            query     int                      false  "page number"
+// @Param        tag             query     string                   false  "filter by tag key, use tag.{key}={value} to filter by tag value"
+// @Param        created_after   query     string                   false  "filter by creation date (RFC3339)"
+// @Param        created_before  query     string                   false  "filter by creation date (RFC3339)"
+// @Param        updated_after   query     string                   false  "filter by update date (RFC3339)"
+// @Param        updated_before  query     string                   false  "filter by update date (RFC3339)"
+// @Param        sort            query     string                   false  "sort by created_at, updated_at or address, prefixed by - for descending order"
+// @Param        expand          query     bool                     false  "return accounts instead of their addresses"
+// @Param        cursor          query     string                   false  "cursor of the page, returned as previousCursor or nextCursor of the paging"
+// @Param        total           query     bool                     false  "return the total count of accounts matching the filters"
+// @Success      200             {array}   infrahttp.PageResponse   "Ethereum Account list"
+// @Failure      401             {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403             {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500             {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/ethereum [get]
 func (h *EthHandler) list(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -489,25 +508,62 @@ func (h *EthHandler) list(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	limit, offset, err := getLimitOffset(request)
+	searchReq, err := getSearchRequest(request, map[string]string{"address": entities.SortByID})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	getDeleted := request.URL.Query().Get("deleted")
-	var addresses []ethcommon.Address
-	if getDeleted == "" {
-		addresses, err = ethStore.List(ctx, limit, offset)
-	} else {
-		addresses, err = ethStore.ListDeleted(ctx, limit, offset)
+	filter := searchReq.filter
+	if !searchReq.isSearch {
+		var addresses []ethcommon.Address
+		if !filter.Deleted {
+			addresses, err = ethStore.List(ctx, filter.Limit, filter.Offset)
+		} else {
+			addresses, err = ethStore.ListDeleted(ctx, filter.Limit, filter.Offset)
+		}
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+
+		err = infrahttp.WritePagingResponse(rw, request, addresses)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+		}
+		return
 	}
+
+	accounts, err := ethStore.Search(ctx, filter)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	err = infrahttp.WritePagingResponse(rw, request, addresses)
+	var total uint64
+	if searchReq.withTotal {
+		total, err = ethStore.Count(ctx, filter)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+	}
+
+	addresses := []ethcommon.Address{}
+	resp := []*types.EthAccountResponse{}
+	items := []*cursorItem{}
+	for _, account := range accounts {
+		addresses = append(addresses, account.Address)
+		resp = append(resp, formatters.FormatEthAccResponse(account))
+		items = append(items, &cursorItem{id: account.Address.Hex(), createdAt: account.Metadata.CreatedAt, updatedAt: account.Metadata.UpdatedAt})
+	}
+
+	var data interface{} = addresses
+	if searchReq.expand {
+		data = resp
+	}
+
+	err = infrahttp.WriteSearchPagingResponse(rw, request, data, searchReq.paging(items, total))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -561,6 +561,26 @@ func (s *ethHandlerTestSuite) TestList() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should return expanded accounts sorted by address successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/%s/ethereum?tag.env=prod&sort=address&expand=true", ethStoreName), nil).WithContext(s.ctx)
+
+		acc := testutils2.FakeETHAccount()
+		s.ethStore.EXPECT().Search(gomock.Any(), &entities.SearchFilter{
+			Tags:   map[string]string{"env": "prod"},
+			SortBy: entities.SortByID,
+			Limit:  defaultPageSize,
+		}).Return([]*entities.ETHAccount{acc}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(http2.PageResponse{
+			Data: []interface{}{formatters.FormatEthAccResponse(acc)},
+		})
+		assert.JSONEq(s.T(), string(expectedBody), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
 	s.Run("should execute request to get a deleted key successfully", func() {
 		rw := httptest.NewRecorder()
 		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/%s/ethereum?deleted=true", ethStoreName), nil).WithContext(s.ctx)
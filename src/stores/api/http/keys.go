@@ -3,6 +3,7 @@ package http
 import (
 	"encoding/base64"
 	"net/http"
+	"time"
 
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
@@ -32,6 +33,9 @@ func NewKeysHandler(storesConnector stores.Stores) *KeysHandler {
 func (h *KeysHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("/{id}/import").HandlerFunc(h.importKey)
 	r.Methods(http.MethodPost).Path("/{id}/sign").HandlerFunc(h.sign)
+	r.Methods(http.MethodPost).Path("/{id}/verify").HandlerFunc(h.verify)
+	r.Methods(http.MethodPost).Path("/{id}/rotate").HandlerFunc(h.rotate)
+	r.Methods(http.MethodGet).Path("/{id}/versions").HandlerFunc(h.listVersions)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.getOne)
 	r.Methods(http.MethodPatch).Path("/{id}").HandlerFunc(h.update)
@@ -81,7 +85,10 @@ func (h *KeysHandler) create(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities2.Curve(createKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: createKeyRequest.Tags,
+			Tags:           createKeyRequest.Tags,
+			RotationPeriod: rotationPeriod(createKeyRequest.RotationPeriod),
+			Operations:     cryptoOperations(createKeyRequest.Operations),
+			Recovery:       recovery(createKeyRequest.RecoveryPeriod),
 		})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -135,7 +142,10 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities2.Curve(importKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: importKeyRequest.Tags,
+			Tags:           importKeyRequest.Tags,
+			RotationPeriod: rotationPeriod(importKeyRequest.RotationPeriod),
+			Operations:     cryptoOperations(importKeyRequest.Operations),
+			Recovery:       recovery(importKeyRequest.RecoveryPeriod),
 		})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -150,7 +160,7 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 }
 
 // @Summary      Sign random payload
-// @Description  Sign a random payload using the selected key
+// @Description  Sign a random payload using the latest version of the selected key, or the version of the request
 // @Tags         Keys
 // @Accept       json
 // @Produce      json
@@ -180,7 +190,12 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	signature, err := keyStore.Sign(ctx, getID(request), signPayloadRequest.Data, nil)
+	var signature []byte
+	if signPayloadRequest.Version == "" {
+		signature, err = keyStore.Sign(ctx, getID(request), signPayloadRequest.Data, nil)
+	} else {
+		signature, err = keyStore.SignWithVersion(ctx, getID(request), signPayloadRequest.Version, signPayloadRequest.Data, nil)
+	}
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -193,6 +208,117 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+// @Summary      Verify signature
+// @Description  Verify that a signature of a payload belongs to the latest version of the selected key, or to the version of the request
+// @Tags         Keys
+// @Accept       json
+// @Param        storeName  path  string                              true  "Store identifier"
+// @Param        id         path  string                              true  "Key identifier"
+// @Param        request    body  types.VerifyBase64SignatureRequest  true  "Verify signature request"
+// @Success      204        "Successful verification"
+// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      422        {object}  infrahttp.ErrorResponse  "Cannot verify signature"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/verify [post]
+func (h *KeysHandler) verify(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	verifyRequest := &types.VerifyBase64SignatureRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, verifyRequest)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = keyStore.Verify(ctx, getID(request), verifyRequest.Version, verifyRequest.Data, verifyRequest.Signature)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Rotate a key
+// @Description  Create a new version of a key, used to sign from then on. Previous versions can still be used to sign and verify by specifying their version
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {object}  types.KeyResponse        "Rotated key data"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Rotation not supported by the store"
+// @Router       /stores/{storeName}/keys/{id}/rotate [post]
+func (h *KeysHandler) rotate(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	key, err := keyStore.Rotate(ctx, getID(request), nil)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatKeyResponse(key))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      List key versions
+// @Description  List the versions of a key, from the oldest to the latest
+// @Tags         Keys
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store identifier"
+// @Param        id         path      string                   true  "Key identifier"
+// @Success      200        {array}   string                   "List of key versions"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Key not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/keys/{id}/versions [get]
+func (h *KeysHandler) listVersions(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	keyStore, err := h.stores.Key(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	versions, err := keyStore.ListVersions(ctx, getID(request))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, versions)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Get key by ID
 // @Description  Retrieve a key by its ID
 // @Tags         Keys
@@ -201,6 +327,7 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 // @Param        storeName  path      string                   true   "Store identifier"
 // @Param        id         path      string                   true   "Key identifier"
 // @Param        deleted    query     bool                     false  "filter by only deleted keys"
+// @Param        version    query     string                   false  "version of the key, the latest one by default"
 // @Success      200        {object}  types.KeyResponse        "Key data"
 // @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
 // @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
@@ -217,10 +344,14 @@ func (h *KeysHandler) getOne(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	getDeleted := request.URL.Query().Get("deleted")
+	version := request.URL.Query().Get("version")
 	var key *entities.Key
-	if getDeleted == "" {
+	switch {
+	case getDeleted == "" && version != "":
+		key, err = keyStore.GetVersion(ctx, getID(request), version)
+	case getDeleted == "":
 		key, err = keyStore.Get(ctx, getID(request))
-	} else {
+	default:
 		key, err = keyStore.GetDeleted(ctx, getID(request))
 	}
 	if err != nil {
@@ -266,7 +397,10 @@ func (h *KeysHandler) update(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	key, err := keyStore.Update(ctx, getID(request), &entities.Attributes{
-		Tags: updateRequest.Tags,
+		Tags:           updateRequest.Tags,
+		RotationPeriod: rotationPeriod(updateRequest.RotationPeriod),
+		Disabled:       updateRequest.Disabled,
+		ExpireAt:       updateRequest.ExpireAt,
 	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -316,14 +450,25 @@ func (h *KeysHandler) restore(rw http.ResponseWriter, request *http.Request) {
 // @Tags         Keys
 // @Accept       json
 // @Produce      json
-// @Param        storeName  path      string                   true   "Store identifier"
-// @Param        limit      query     int                      false  "page size"
-// @Param        page       query     int                      false  "page number"
-// @Param        deleted    query     bool                     false  "filter by only deleted keys"
-// @Success      200        {array}   infrahttp.PageResponse   "List of key ids"
-// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
-// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
-// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Param        storeName          path      string                   true   "Store identifier"
+// @Param        limit              query     int                      false  "page size"
+// @Param        page               query     int                      false  "page number"
+// @Param        deleted            query     bool                     false  "filter by only deleted keys"
+// @Param        tag                query     string                   false  "filter by tag key, use tag.{key}={value} to filter by tag value"
+// @Param        created_after      query     string                   false  "filter by creation date (RFC3339)"
+// @Param        created_before     query     string                   false  "filter by creation date (RFC3339)"
+// @Param        updated_after      query     string                   false  "filter by update date (RFC3339)"
+// @Param        updated_before     query     string                   false  "filter by update date (RFC3339)"
+// @Param        signing_algorithm  query     string                   false  "filter by signing algorithm"
+// @Param        curve              query     string                   false  "filter by elliptic curve"
+// @Param        sort               query     string                   false  "sort by created_at, updated_at or id, prefixed by - for descending order"
+// @Param        expand             query     bool                     false  "return keys instead of their IDs"
+// @Param        cursor             query     string                   false  "cursor of the page, returned as previousCursor or nextCursor of the paging"
+// @Param        total              query     bool                     false  "return the total count of keys matching the filters"
+// @Success      200                {array}   infrahttp.PageResponse   "List of key ids or keys"
+// @Failure      401                {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403                {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      500                {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/keys [get]
 func (h *KeysHandler) list(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -334,25 +479,64 @@ func (h *KeysHandler) list(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	limit, offset, err := getLimitOffset(request)
+	searchReq, err := getSearchRequest(request, nil)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	getDeleted := request.URL.Query().Get("deleted")
-	var ids []string
-	if getDeleted == "" {
-		ids, err = keyStore.List(ctx, limit, offset)
-	} else {
-		ids, err = keyStore.ListDeleted(ctx, limit, offset)
+	filter := searchReq.filter
+	filter.SigningAlgorithm = request.URL.Query().Get("signing_algorithm")
+	filter.Curve = request.URL.Query().Get("curve")
+	if !searchReq.isSearch && filter.SigningAlgorithm == "" && filter.Curve == "" {
+		var ids []string
+		if !filter.Deleted {
+			ids, err = keyStore.List(ctx, filter.Limit, filter.Offset)
+		} else {
+			ids, err = keyStore.ListDeleted(ctx, filter.Limit, filter.Offset)
+		}
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+
+		err = infrahttp.WritePagingResponse(rw, request, ids)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+		}
+		return
 	}
+
+	keys, err := keyStore.Search(ctx, filter)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	err = infrahttp.WritePagingResponse(rw, request, ids)
+	var total uint64
+	if searchReq.withTotal {
+		total, err = keyStore.Count(ctx, filter)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+	}
+
+	ids := []string{}
+	resp := []*types.KeyResponse{}
+	items := []*cursorItem{}
+	for _, key := range keys {
+		ids = append(ids, key.ID)
+		resp = append(resp, formatters.FormatKeyResponse(key))
+		items = append(items, &cursorItem{id: key.ID, createdAt: key.Metadata.CreatedAt, updatedAt: key.Metadata.UpdatedAt})
+	}
+
+	var data interface{} = ids
+	if searchReq.expand {
+		data = resp
+	}
+
+	err = infrahttp.WriteSearchPagingResponse(rw, request, data, searchReq.paging(items, total))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -424,3 +608,28 @@ func (h *KeysHandler) destroy(rw http.ResponseWriter, request *http.Request) {
 func getID(request *http.Request) string {
 	return mux.Vars(request)["id"]
 }
+
+func rotationPeriod(period *jsonutils.Duration) *time.Duration {
+	if period == nil {
+		return nil
+	}
+
+	return &period.Duration
+}
+
+func recovery(period *jsonutils.Duration) *entities.Recovery {
+	if period == nil {
+		return nil
+	}
+
+	return &entities.Recovery{Period: period.Duration}
+}
+
+func cryptoOperations(ops []string) []entities.CryptoOperation {
+	var operations []entities.CryptoOperation
+	for _, op := range ops {
+		operations = append(operations, entities.CryptoOperation(op))
+	}
+
+	return operations
+}
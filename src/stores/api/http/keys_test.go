@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
@@ -18,6 +19,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
@@ -46,7 +48,7 @@ type keysHandlerTestSuite struct {
 
 	ctrl     *gomock.Controller
 	stores   *mock.MockStores
-	keyStore *mock.MockKeyStore
+	keyStore *mock.MockIndexedKeyStore
 	router   *mux.Router
 	ctx      context.Context
 }
@@ -60,7 +62,7 @@ func (s *keysHandlerTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 
 	s.stores = mock.NewMockStores(s.ctrl)
-	s.keyStore = mock.NewMockKeyStore(s.ctrl)
+	s.keyStore = mock.NewMockIndexedKeyStore(s.ctrl)
 
 	s.stores.EXPECT().Key(gomock.Any(), keyStoreName, keyUserInfo).Return(s.keyStore, nil).AnyTimes()
 
@@ -246,6 +248,23 @@ func (s *keysHandlerTestSuite) TestSign() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should sign with the requested version of the key", func() {
+		signPayloadRequest := testutils.FakeSignBase64PayloadRequest()
+		signPayloadRequest.Version = "1"
+		requestBytes, _ := json.Marshal(signPayloadRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/sign", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		signature := []byte("signature")
+		s.keyStore.EXPECT().SignWithVersion(gomock.Any(), keyID, "1", signPayloadRequest.Data, gomock.Any()).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), base64.URLEncoding.EncodeToString(signature), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		signPayloadRequest := testutils.FakeSignBase64PayloadRequest()
@@ -259,6 +278,112 @@ func (s *keysHandlerTestSuite) TestSign() {
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
 	})
+
+	s.Run("should fail with 403 if the key cannot be used for signing", func() {
+		signPayloadRequest := testutils.FakeSignBase64PayloadRequest()
+		requestBytes, _ := json.Marshal(signPayloadRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/sign", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Sign(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.KeyUsageError("key is disabled"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusForbidden, rw.Code)
+		assert.Contains(s.T(), rw.Body.String(), errors.KeyUsage)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestUpdate() {
+	s.Run("should disable the key and set its expiration date", func() {
+		disabled := true
+		expireAt := time.Now().Add(time.Hour).UTC()
+		updateRequest := &types.UpdateKeyRequest{
+			Tags:     map[string]string{"tag1": "tagValue1"},
+			Disabled: &disabled,
+			ExpireAt: &expireAt,
+		}
+		requestBytes, _ := json.Marshal(updateRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/stores/KeyStore/keys/%s", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		key.Metadata.Disabled = true
+		key.Metadata.ExpireAt = expireAt
+		s.keyStore.EXPECT().Update(gomock.Any(), keyID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, attr *entities.Attributes) (*entities.Key, error) {
+			assert.True(s.T(), *attr.Disabled)
+			assert.True(s.T(), expireAt.Equal(*attr.ExpireAt))
+			return key, nil
+		})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatKeyResponse(key)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestRotate() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/rotate", keyID), nil).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		key.Metadata.Version = "2"
+		s.keyStore.EXPECT().Rotate(gomock.Any(), keyID, nil).Return(key, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatKeyResponse(key)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 501 if the store does not support rotation", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/rotate", keyID), nil).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Rotate(gomock.Any(), keyID, nil).Return(nil, errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestVerify() {
+	s.Run("should verify the signature with the requested version of the key", func() {
+		requestBytes, _ := json.Marshal(&types.VerifyBase64SignatureRequest{
+			Data:      []byte("my data"),
+			Signature: []byte("signature"),
+			Version:   "1",
+		})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/KeyStore/keys/%s/verify", keyID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().Verify(gomock.Any(), keyID, "1", []byte("my data"), []byte("signature")).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+}
+
+func (s *keysHandlerTestSuite) TestListVersions() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/KeyStore/keys/%s/versions", keyID), nil).WithContext(s.ctx)
+
+		s.keyStore.EXPECT().ListVersions(gomock.Any(), keyID).Return([]string{"1", "2"}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), "[\"1\",\"2\"]\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
 }
 
 func (s *keysHandlerTestSuite) TestGet() {
@@ -325,6 +450,107 @@ func (s *keysHandlerTestSuite) TestList() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should search keys successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?tag.env=prod&tag=owner&created_after=2021-01-01T00:00:00Z&curve=secp256k1&sort=-updated_at", nil).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		s.keyStore.EXPECT().Search(gomock.Any(), &entities.SearchFilter{
+			Tags:         map[string]string{"env": "prod"},
+			TagKeys:      []string{"owner"},
+			CreatedAfter: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			Curve:        "secp256k1",
+			SortBy:       entities.SortByUpdatedAt,
+			SortDesc:     true,
+			Limit:        defaultPageSize,
+		}).Return([]*entities.Key{key}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(http2.PageResponse{
+			Data: []interface{}{key.ID},
+		})
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should return expanded keys successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?expand=true", nil).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		s.keyStore.EXPECT().Search(gomock.Any(), &entities.SearchFilter{Limit: defaultPageSize}).Return([]*entities.Key{key}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(http2.PageResponse{
+			Data: []interface{}{formatters.FormatKeyResponse(key)},
+		})
+		assert.JSONEq(s.T(), string(expectedBody), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should list keys by cursor with the total count successfully", func() {
+		cursor := &entities2.Cursor{SortBy: entities.SortByUpdatedAt, Value: "2021-01-01T00:00:00Z", ID: "key0"}
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?limit=1&total=true&cursor="+cursor.Encode(), nil).WithContext(s.ctx)
+
+		key := testutils2.FakeKey()
+		filter := &entities.SearchFilter{SortBy: entities.SortByUpdatedAt, Cursor: cursor, Limit: 1}
+		s.keyStore.EXPECT().Search(gomock.Any(), filter).Return([]*entities.Key{key}, nil)
+		s.keyStore.EXPECT().Count(gomock.Any(), filter).Return(uint64(5), nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		resp := &http2.PageResponse{}
+		err := json.Unmarshal(rw.Body.Bytes(), resp)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+		assert.Equal(s.T(), []interface{}{key.ID}, resp.Data)
+		assert.Equal(s.T(), uint64(5), *resp.Paging.Total)
+
+		next, _ := entities2.DecodeCursor(resp.Paging.NextCursor)
+		assert.Equal(s.T(), &entities2.Cursor{SortBy: entities.SortByUpdatedAt, Value: key.Metadata.UpdatedAt.Format(time.RFC3339Nano), ID: key.ID}, next)
+		previous, _ := entities2.DecodeCursor(resp.Paging.PreviousCursor)
+		assert.Equal(s.T(), key.ID, previous.ID)
+		assert.True(s.T(), previous.Backward)
+		assert.Contains(s.T(), resp.Paging.Next, "cursor="+resp.Paging.NextCursor)
+	})
+
+	s.Run("should fail with 400 if the sort does not match the cursor", func() {
+		cursor := &entities2.Cursor{Value: "2021-01-01T00:00:00Z", ID: "key0"}
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?sort=id&cursor="+cursor.Encode(), nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if the sort order or the value of the cursor is invalid", func() {
+		for _, cursor := range []*entities2.Cursor{
+			{SortBy: "name", Value: "2021-01-01T00:00:00Z", ID: "key0"},
+			{SortBy: entities.SortByCreatedAt, ID: "key0"},
+			{Value: "yesterday", ID: "key0"},
+		} {
+			rw := httptest.NewRecorder()
+			httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?cursor="+cursor.Encode(), nil).WithContext(s.ctx)
+
+			s.router.ServeHTTP(rw, httpRequest)
+			assert.Equal(s.T(), http.StatusBadRequest, rw.Code, cursor.SortBy)
+			assert.Contains(s.T(), rw.Body.String(), "invalid cursor")
+		}
+	})
+
+	s.Run("should fail with 400 if search parameters are invalid", func() {
+		for _, query := range []string{"created_after=yesterday", "sort=name", "expand=maybe", "tag=", "total=maybe", "cursor=invalid", "cursor=eyJpZCI6ImsifQ&page=1"} {
+			rw := httptest.NewRecorder()
+			httpRequest := httptest.NewRequest(http.MethodGet, "/stores/KeyStore/keys?"+query, nil).WithContext(s.ctx)
+
+			s.router.ServeHTTP(rw, httpRequest)
+			assert.Equal(s.T(), http.StatusBadRequest, rw.Code, query)
+		}
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		rw := httptest.NewRecorder()
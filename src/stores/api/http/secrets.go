@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
 
@@ -29,6 +30,10 @@ func NewSecretsHandler(storesConnector stores.Stores) *SecretsHandler {
 func (h *SecretsHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodDelete).Path("/{id}/destroy").HandlerFunc(h.destroy)
 	r.Methods(http.MethodPut).Path("/{id}/restore").HandlerFunc(h.restore)
+	r.Methods(http.MethodGet).Path("/{id}/versions").HandlerFunc(h.listVersions)
+	r.Methods(http.MethodPut).Path("/{id}/versions/{version}/restore").HandlerFunc(h.restoreVersion)
+	r.Methods(http.MethodDelete).Path("/{id}/versions/{version}/destroy").HandlerFunc(h.destroyVersion)
+	r.Methods(http.MethodPost).Path("/{id}/generate").HandlerFunc(h.generate)
 	r.Methods(http.MethodPost).Path("/{id}").HandlerFunc(h.set)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.getOne)
@@ -36,19 +41,22 @@ func (h *SecretsHandler) Register(r *mux.Router) {
 }
 
 // @Summary      Create a secret
-// @Description  Create new secret on selected Store
+// @Description  Create new secret on selected Store. With an If-Match header or an expected version, the secret is only set if its latest version matches, and with If-None-Match: * only if it does not exist
 // @Tags         Secrets
 // @Accept       json
 // @Produce      json
-// @Param        id         path      string                   true  "Secret ID"
-// @Param        storeName  path      string                   true  "Store ID"
-// @Param        request    body      types.SetSecretRequest   true  "Create Secret request"
-// @Success      200        {object}  types.SecretResponse     "Secret data"
-// @Failure      400        {object}  infrahttp.ErrorResponse  "Invalid request format"
-// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
-// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
-// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
-// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Param        id             path      string                   true   "Secret ID"
+// @Param        storeName      path      string                   true   "Store ID"
+// @Param        If-Match       header    string                   false  "expected latest version of the secret"
+// @Param        If-None-Match  header    string                   false  "* to only create the secret if it does not exist"
+// @Param        request        body      types.SetSecretRequest   true   "Create Secret request"
+// @Success      200            {object}  types.SecretResponse     "Secret data"
+// @Failure      400            {object}  infrahttp.ErrorResponse  "Invalid request format"
+// @Failure      401            {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403            {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404            {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      409            {object}  infrahttp.ErrorResponse  "Latest version does not match the expected one"
+// @Failure      500            {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/secrets/{id} [post]
 func (h *SecretsHandler) set(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -67,14 +75,81 @@ func (h *SecretsHandler) set(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	secret, err := secretStore.Set(ctx, id, setSecretRequest.Value, &entities.Attributes{
-		Tags: setSecretRequest.Tags,
+	attr := &entities.Attributes{
+		Tags:      setSecretRequest.Tags,
+		Recovery:  recovery(setSecretRequest.RecoveryPeriod),
+		ValueType: entities.SecretValueType(setSecretRequest.ValueType),
+	}
+
+	var secret *entities.Secret
+	expectedVersion, isCompareAndSet := getExpectedVersion(request, setSecretRequest)
+	if isCompareAndSet {
+		secret, err = secretStore.CompareAndSet(ctx, id, setSecretRequest.Value, expectedVersion, attr)
+	} else {
+		secret, err = secretStore.Set(ctx, id, setSecretRequest.Value, attr)
+	}
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(rw, formatters.FormatSecretResponse(secret))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Generate a secret
+// @Description  Create new secret on selected Store with a value generated by the server. The generated value is not returned and can be retrieved by getting the secret
+// @Tags         Secrets
+// @Accept       json
+// @Produce      json
+// @Param        id         path      string                       true  "Secret ID"
+// @Param        storeName  path      string                       true  "Store ID"
+// @Param        request    body      types.GenerateSecretRequest  true  "Generate Secret request"
+// @Success      200        {object}  types.SecretResponse         "Secret data without value"
+// @Failure      400        {object}  infrahttp.ErrorResponse      "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse      "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse      "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse      "Store not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse      "Internal server error"
+// @Router       /stores/{storeName}/secrets/{id}/generate [post]
+func (h *SecretsHandler) generate(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	id := mux.Vars(request)["id"]
+	generateSecretRequest := &types.GenerateSecretRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, generateSecretRequest)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	secretStore, err := h.stores.Secret(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	secret, err := secretStore.Generate(ctx, id, &entities.SecretPolicy{
+		Generator: entities.SecretGenerator(generateSecretRequest.Generator),
+		Length:    generateSecretRequest.Length,
+		Charset:   generateSecretRequest.Charset,
+		KeySize:   generateSecretRequest.KeySize,
+		Curve:     generateSecretRequest.Curve,
+	}, &entities.Attributes{
+		Tags:     generateSecretRequest.Tags,
+		Recovery: recovery(generateSecretRequest.RecoveryPeriod),
 	})
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
+	// The generated value never leaves the server on creation
+	secret.Value = ""
+
 	err = infrahttp.WriteJSON(rw, formatters.FormatSecretResponse(secret))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
@@ -82,6 +157,48 @@ func (h *SecretsHandler) set(rw http.ResponseWriter, request *http.Request) {
 	}
 }
 
+// @Summary      List the versions of a secret
+// @Description  List every version of a secret, including the deleted ones, without their value
+// @Tags         Secrets
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                   true  "Store ID"
+// @Param        id         path      string                   true  "Secret ID"
+// @Success      200        {array}   types.SecretResponse     "Secret versions"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Secret not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Router       /stores/{storeName}/secrets/{id}/versions [get]
+func (h *SecretsHandler) listVersions(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	id := mux.Vars(request)["id"]
+
+	secretStore, err := h.stores.Secret(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	secrets, err := secretStore.ListVersions(ctx, id)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*types.SecretResponse{}
+	for _, secret := range secrets {
+		response = append(response, formatters.FormatSecretResponse(secret))
+	}
+
+	err = infrahttp.WriteJSON(rw, response)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 // @Summary      Get a secret by id
 // @Description  Retrieve secret information by ID
 // @Tags         Secrets
@@ -134,15 +251,23 @@ func (h *SecretsHandler) getOne(rw http.ResponseWriter, request *http.Request) {
 // @Tags         Secrets
 // @Accept       json
 // @Produce      json
-// @Param        deleted    query     bool                     false  "filter by deleted accounts"
-// @Param        storeName  path      string                   true   "Store ID"
-// @Param        limit      query     int                      false  "page size"
-// @Param        page       query     int                      false  "page number"
-// @Success      200        {array}   infrahttp.PageResponse   "List of Secret IDs"
-// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
-// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
-// @Failure      404        {object}  infrahttp.ErrorResponse  "Store not found"
-// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Param        deleted         query     bool                     false  "filter by deleted accounts"
+// @Param        storeName       path      string                   true   "Store ID"
+// @Param        limit           query     int                      false  "page size"
+// @Param        page            query     int                      false  "page number"
+// @Param        tag             query     string                   false  "filter by tag key, use tag.{key}={value} to filter by tag value"
+// @Param        created_after   query     string                   false  "filter by creation date (RFC3339)"
+// @Param        created_before  query     string                   false  "filter by creation date (RFC3339)"
+// @Param        updated_after   query     string                   false  "filter by update date (RFC3339)"
+// @Param        updated_before  query     string                   false  "filter by update date (RFC3339)"
+// @Param        sort            query     string                   false  "sort by created_at, updated_at or id, prefixed by - for descending order"
+// @Param        cursor          query     string                   false  "cursor of the page, returned as previousCursor or nextCursor of the paging"
+// @Param        total           query     bool                     false  "return the total count of secrets matching the filters"
+// @Success      200             {array}   infrahttp.PageResponse   "List of Secret IDs"
+// @Failure      401             {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403             {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404             {object}  infrahttp.ErrorResponse  "Store not found"
+// @Failure      500             {object}  infrahttp.ErrorResponse  "Internal server error"
 // @Router       /stores/{storeName}/secrets [get]
 func (h *SecretsHandler) list(rw http.ResponseWriter, request *http.Request) {
 	ctx := request.Context()
@@ -153,25 +278,55 @@ func (h *SecretsHandler) list(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	limit, offset, err := getLimitOffset(request)
+	searchReq, err := getSearchRequest(request, nil)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	var ids []string
-	getDeleted := request.URL.Query().Get("deleted")
-	if getDeleted == "" {
-		ids, err = secretStore.List(ctx, limit, offset)
-	} else {
-		ids, err = secretStore.ListDeleted(ctx, limit, offset)
+	filter := searchReq.filter
+	if !searchReq.isSearch {
+		var ids []string
+		if !filter.Deleted {
+			ids, err = secretStore.List(ctx, filter.Limit, filter.Offset)
+		} else {
+			ids, err = secretStore.ListDeleted(ctx, filter.Limit, filter.Offset)
+		}
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+
+		err = infrahttp.WritePagingResponse(rw, request, ids)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+		}
+		return
 	}
+
+	secrets, err := secretStore.Search(ctx, filter)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	err = infrahttp.WritePagingResponse(rw, request, ids)
+	var total uint64
+	if searchReq.withTotal {
+		total, err = secretStore.Count(ctx, filter)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+	}
+
+	ids := []string{}
+	items := []*cursorItem{}
+	for _, secret := range secrets {
+		ids = append(ids, secret.ID)
+		items = append(items, &cursorItem{id: secret.ID, createdAt: secret.Metadata.CreatedAt, updatedAt: secret.Metadata.UpdatedAt})
+	}
+
+	err = infrahttp.WriteSearchPagingResponse(rw, request, ids, searchReq.paging(items, total))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(rw, err)
 		return
@@ -275,3 +430,93 @@ func (h *SecretsHandler) restore(rw http.ResponseWriter, request *http.Request)
 
 	rw.WriteHeader(http.StatusNoContent)
 }
+
+// @Summary      Restore a deleted secret version
+// @Description  Restore a previously deleted version of a secret, if supported by the underlying store
+// @Tags         Secrets
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path  string  true  "Store ID"
+// @Param        id         path  string  true  "Secret ID"
+// @Param        version    path  string  true  "Secret version"
+// @Success      204        "Restored successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Secret version not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Not supported by the store"
+// @Router       /stores/{storeName}/secrets/{id}/versions/{version}/restore [put]
+func (h *SecretsHandler) restoreVersion(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	id := mux.Vars(request)["id"]
+	version := mux.Vars(request)["version"]
+
+	secretStore, err := h.stores.Secret(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = secretStore.RestoreVersion(ctx, id, version)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Destroy a secret version
+// @Description  Permanently delete a version of a secret, if supported by the underlying store
+// @Tags         Secrets
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path  string  true  "Store ID"
+// @Param        id         path  string  true  "Secret ID"
+// @Param        version    path  string  true  "Secret version"
+// @Success      204        "Destroyed successfully"
+// @Failure      401        {object}  infrahttp.ErrorResponse  "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse  "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse  "Store/Secret version not found"
+// @Failure      500        {object}  infrahttp.ErrorResponse  "Internal server error"
+// @Failure      501        {object}  infrahttp.ErrorResponse  "Not supported by the store"
+// @Router       /stores/{storeName}/secrets/{id}/versions/{version}/destroy [delete]
+func (h *SecretsHandler) destroyVersion(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	id := mux.Vars(request)["id"]
+	version := mux.Vars(request)["version"]
+
+	secretStore, err := h.stores.Secret(ctx, StoreNameFromContext(ctx), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = secretStore.DestroyVersion(ctx, id, version)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// getExpectedVersion returns the version the latest version of the secret must match, the If-Match header taking precedence over the request.
+// If-None-Match: * expects the secret not to exist
+func getExpectedVersion(request *http.Request, setSecretRequest *types.SetSecretRequest) (expectedVersion string, isCompareAndSet bool) {
+	if ifMatch := request.Header.Get("If-Match"); ifMatch != "" {
+		return strings.Trim(ifMatch, `"`), true
+	}
+
+	if request.Header.Get("If-None-Match") == "*" {
+		return "", true
+	}
+
+	if setSecretRequest.ExpectedVersion != "" {
+		return setSecretRequest.ExpectedVersion, true
+	}
+
+	return "", false
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
@@ -42,7 +43,7 @@ type secretsHandlerTestSuite struct {
 
 	ctrl        *gomock.Controller
 	stores      *mock.MockStores
-	secretStore *mock.MockSecretStore
+	secretStore *mock.MockIndexedSecretStore
 	router      *mux.Router
 	ctx         context.Context
 }
@@ -56,7 +57,7 @@ func (s *secretsHandlerTestSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 
 	s.stores = mock.NewMockStores(s.ctrl)
-	s.secretStore = mock.NewMockSecretStore(s.ctrl)
+	s.secretStore = mock.NewMockIndexedSecretStore(s.ctrl)
 
 	s.stores.EXPECT().Secret(gomock.Any(), secretStoreName, secretUserInfo).Return(s.secretStore, nil).AnyTimes()
 
@@ -93,6 +94,55 @@ func (s *secretsHandlerTestSuite) TestSet() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should only set the secret if its latest version matches the If-Match header", func() {
+		setSecretRequest := testutils.FakeSetSecretRequest()
+		setSecretRequest.ExpectedVersion = "1"
+		requestBytes, _ := json.Marshal(setSecretRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/SecretStore/secrets/"+secretID, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+		httpRequest.Header.Set("If-Match", `"2"`)
+		secret := testutils2.FakeSecret()
+
+		s.secretStore.EXPECT().CompareAndSet(gomock.Any(), secretID, setSecretRequest.Value, "2", &entities.Attributes{
+			Tags: setSecretRequest.Tags,
+		}).Return(secret, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should only set the secret if its latest version matches the expected version", func() {
+		setSecretRequest := testutils.FakeSetSecretRequest()
+		setSecretRequest.ExpectedVersion = "1"
+		requestBytes, _ := json.Marshal(setSecretRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/SecretStore/secrets/"+secretID, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().CompareAndSet(gomock.Any(), secretID, setSecretRequest.Value, "1", gomock.Any()).Return(nil, errors.StatusConflictError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusConflict, rw.Code)
+	})
+
+	s.Run("should only create the secret if it does not exist with If-None-Match", func() {
+		setSecretRequest := testutils.FakeSetSecretRequest()
+		requestBytes, _ := json.Marshal(setSecretRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/SecretStore/secrets/"+secretID, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+		httpRequest.Header.Set("If-None-Match", "*")
+
+		s.secretStore.EXPECT().CompareAndSet(gomock.Any(), secretID, setSecretRequest.Value, "", gomock.Any()).Return(testutils2.FakeSecret(), nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		setSecretRequest := testutils.FakeSetSecretRequest()
@@ -108,6 +158,46 @@ func (s *secretsHandlerTestSuite) TestSet() {
 	})
 }
 
+func (s *secretsHandlerTestSuite) TestGenerate() {
+	s.Run("should execute request successfully without returning the value", func() {
+		generateSecretRequest := &types.GenerateSecretRequest{
+			Generator: "string",
+			Length:    16,
+			Tags:      map[string]string{"tag1": "tagValue1"},
+		}
+		requestBytes, _ := json.Marshal(generateSecretRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/SecretStore/secrets/%s/generate", secretID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+		secret := testutils2.FakeSecret()
+
+		s.secretStore.EXPECT().Generate(gomock.Any(), secretID, &entities.SecretPolicy{
+			Generator: entities.StringGenerator,
+			Length:    16,
+		}, &entities.Attributes{
+			Tags: generateSecretRequest.Tags,
+		}).Return(secret, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := &types.SecretResponse{}
+		_ = json.Unmarshal(rw.Body.Bytes(), response)
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+		assert.Equal(s.T(), secret.ID, response.ID)
+		assert.Empty(s.T(), response.Value)
+	})
+
+	s.Run("should fail with 400 if the generator is not supported", func() {
+		requestBytes, _ := json.Marshal(&types.GenerateSecretRequest{Generator: "invalid"})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/SecretStore/secrets/%s/generate", secretID), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+}
+
 func (s *secretsHandlerTestSuite) TestGet() {
 	s.Run("should execute request successfully with version", func() {
 		version := "1"
@@ -270,6 +360,80 @@ func (s *secretsHandlerTestSuite) TestDestroy() {
 	})
 }
 
+func (s *secretsHandlerTestSuite) TestListVersions() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/SecretStore/secrets/%s/versions", secretID), nil).WithContext(s.ctx)
+
+		secretV1 := testutils2.FakeSecret()
+		secretV1.Value = ""
+		secretV2 := testutils2.FakeSecret()
+		secretV2.Value = ""
+		secretV2.Metadata.Version = "2"
+		s.secretStore.EXPECT().ListVersions(gomock.Any(), secretID).Return([]*entities.Secret{secretV1, secretV2}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*types.SecretResponse{formatters.FormatSecretResponse(secretV1), formatters.FormatSecretResponse(secretV2)})
+		assert.Equal(s.T(), string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with correct error code if use case fails", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/stores/SecretStore/secrets/%s/versions", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().ListVersions(gomock.Any(), secretID).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *secretsHandlerTestSuite) TestRestoreVersion() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/SecretStore/secrets/%s/versions/2/restore", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().RestoreVersion(gomock.Any(), secretID, "2").Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should fail with correct error code if the store does not support it", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/stores/SecretStore/secrets/%s/versions/2/restore", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().RestoreVersion(gomock.Any(), secretID, "2").Return(errors.NotSupportedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotImplemented, rw.Code)
+	})
+}
+
+func (s *secretsHandlerTestSuite) TestDestroyVersion() {
+	s.Run("should execute request successfully", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/stores/SecretStore/secrets/%s/versions/2/destroy", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().DestroyVersion(gomock.Any(), secretID, "2").Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNoContent, rw.Code)
+	})
+
+	s.Run("should fail with correct error code if use case fails", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/stores/SecretStore/secrets/%s/versions/2/destroy", secretID), nil).WithContext(s.ctx)
+
+		s.secretStore.EXPECT().DestroyVersion(gomock.Any(), secretID, "2").Return(errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusNotFound, rw.Code)
+	})
+}
+
 func (s *secretsHandlerTestSuite) TestList() {
 	s.Run("should execute request successfully", func() {
 		rw := httptest.NewRecorder()
@@ -3,14 +3,23 @@ package http
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	auth "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/api/formatters"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/gorilla/mux"
 )
 
 type StoresHandler struct {
+	stores  stores.Stores
 	secrets *SecretsHandler
 	keys    *KeysHandler
 	eth     *EthHandler
@@ -19,6 +28,7 @@ type StoresHandler struct {
 // NewStoresHandler creates a http.Handler to be served on /stores
 func NewStoresHandler(s stores.Stores) *StoresHandler {
 	return &StoresHandler{
+		stores:  s,
 		secrets: NewSecretsHandler(s),
 		keys:    NewKeysHandler(s),
 		eth:     NewEthHandler(s),
@@ -32,6 +42,9 @@ func (h *StoresHandler) Register(router *mux.Router) {
 	// Create subrouter for /stores/{storeName}
 	storeSubrouter := storesSubrouter.PathPrefix("/{storeName}").Subrouter()
 	storeSubrouter.Use(storeSelector)
+	storeSubrouter.Methods(http.MethodPost).Path("/migrate").HandlerFunc(h.migrate)
+	storeSubrouter.Methods(http.MethodPost).Path("/backup").HandlerFunc(h.backup)
+	storeSubrouter.Methods(http.MethodPost).Path("/restore").HandlerFunc(h.restore)
 
 	// Register secrets handler on /stores/{storeName}/secrets
 	secretsSubrouter := storeSubrouter.PathPrefix("/secrets").Subrouter()
@@ -46,6 +59,123 @@ func (h *StoresHandler) Register(router *mux.Router) {
 	h.eth.Register(ethSubrouter)
 }
 
+// @Summary      Migrate a store
+// @Description  Copy the items of a store into another store of the same type, preserving their IDs, tags and addresses. Keys and Ethereum accounts can only be migrated from key stores whose private keys are exportable
+// @Tags         Stores
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                      true  "Store ID"
+// @Param        request    body      types.MigrateStoreRequest   true  "Migrate store request"
+// @Success      200        {object}  types.MigrateStoreResponse  "Result of the migration of each item"
+// @Failure      400        {object}  infrahttp.ErrorResponse     "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse     "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse     "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse     "Store not found"
+// @Failure      422        {object}  infrahttp.ErrorResponse     "Stores of different types"
+// @Failure      501        {object}  infrahttp.ErrorResponse     "Items of the store cannot be exported"
+// @Failure      500        {object}  infrahttp.ErrorResponse     "Internal server error"
+// @Router       /stores/{storeName}/migrate [post]
+func (h *StoresHandler) migrate(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	migrateRequest := &types.MigrateStoreRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, migrateRequest)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	items, err := h.stores.Migrate(ctx, StoreNameFromContext(ctx), migrateRequest.Destination, migrateRequest.DryRun, migrateRequest.DeleteSource, auth.UserInfoFromContext(ctx))
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = http2.WriteJSON(rw, formatters.FormatMigrateStoreResponse(items))
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Back up a store
+// @Description  Create an archive of the items of a store along with their index, encrypted with a passphrase or for the secp256k1 public key of a recipient. Keys and Ethereum accounts can only be backed up from key stores whose private keys are exportable
+// @Tags         Stores
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                     true  "Store ID"
+// @Param        request    body      types.BackupStoreRequest   true  "Backup store request"
+// @Success      200        {object}  types.BackupStoreResponse  "Encrypted archive of the store"
+// @Failure      400        {object}  infrahttp.ErrorResponse    "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse    "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse    "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse    "Store not found"
+// @Failure      422        {object}  infrahttp.ErrorResponse    "Invalid passphrase or public key"
+// @Failure      501        {object}  infrahttp.ErrorResponse    "Items of the store cannot be exported"
+// @Failure      500        {object}  infrahttp.ErrorResponse    "Internal server error"
+// @Router       /stores/{storeName}/backup [post]
+func (h *StoresHandler) backup(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	backupRequest := &types.BackupStoreRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, backupRequest)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	archive, err := h.stores.Backup(ctx, StoreNameFromContext(ctx), formatters.FormatBackupEncryption(backupRequest), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = http2.WriteJSON(rw, &types.BackupStoreResponse{Archive: archive})
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
+// @Summary      Restore a store
+// @Description  Restore the items of an archive into an empty store of the same type, the archive is decrypted with its passphrase or with the secp256k1 private key of its recipient
+// @Tags         Stores
+// @Accept       json
+// @Produce      json
+// @Param        storeName  path      string                      true  "Store ID"
+// @Param        request    body      types.RestoreStoreRequest   true  "Restore store request"
+// @Success      200        {object}  types.RestoreStoreResponse  "Result of the restoration of each item"
+// @Failure      400        {object}  infrahttp.ErrorResponse     "Invalid request format"
+// @Failure      401        {object}  infrahttp.ErrorResponse     "Unauthorized"
+// @Failure      403        {object}  infrahttp.ErrorResponse     "Forbidden"
+// @Failure      404        {object}  infrahttp.ErrorResponse     "Store not found"
+// @Failure      409        {object}  infrahttp.ErrorResponse     "Store is not empty"
+// @Failure      422        {object}  infrahttp.ErrorResponse     "Archive cannot be decrypted or is of another store type"
+// @Failure      500        {object}  infrahttp.ErrorResponse     "Internal server error"
+// @Router       /stores/{storeName}/restore [post]
+func (h *StoresHandler) restore(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	restoreRequest := &types.RestoreStoreRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, restoreRequest)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	items, err := h.stores.RestoreBackup(ctx, StoreNameFromContext(ctx), restoreRequest.Archive, formatters.FormatBackupDecryption(restoreRequest), auth.UserInfoFromContext(ctx))
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	err = http2.WriteJSON(rw, formatters.FormatRestoreStoreResponse(items))
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+}
+
 func storeSelector(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		h.ServeHTTP(w, r.WithContext(WithStoreName(r.Context(), mux.Vars(r)["storeName"])))
@@ -77,3 +207,209 @@ func getLimitOffset(request *http.Request) (rLimit, rOffset uint64, err error) {
 
 	return rLimit, rOffset, nil
 }
+
+const tagParamPrefix = "tag."
+
+// searchParams are the query parameters requiring the list to be served by a search on the index of the store
+var searchParams = []string{"tag", "created_after", "created_before", "updated_after", "updated_before", "sort", "expand", "cursor", "total"}
+
+// searchRequest is a list request served by a search on the index of the store
+type searchRequest struct {
+	filter *entities.SearchFilter
+	// isSearch is false when no search parameter is set and the list can be served by the store
+	isSearch  bool
+	withTotal bool
+	expand    bool
+}
+
+// cursorItem is the position of a listed item in the sort order
+type cursorItem struct {
+	id        string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// getSearchRequest parses the search query parameters.
+// sortAliases maps additional sort values to the sort fields of the filter
+func getSearchRequest(request *http.Request, sortAliases map[string]string) (*searchRequest, error) {
+	query := request.URL.Query()
+	req := &searchRequest{
+		filter: &entities.SearchFilter{
+			Deleted: query.Get("deleted") != "",
+		},
+	}
+
+	for param := range query {
+		if strings.HasPrefix(param, tagParamPrefix) {
+			req.isSearch = true
+		}
+	}
+	for _, param := range searchParams {
+		if _, ok := query[param]; ok {
+			req.isSearch = true
+		}
+	}
+
+	var err error
+	filter := req.filter
+	filter.Limit, filter.Offset, err = getLimitOffset(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for param, values := range query {
+		if !strings.HasPrefix(param, tagParamPrefix) {
+			continue
+		}
+		key := strings.TrimPrefix(param, tagParamPrefix)
+		if key == "" || len(values) != 1 {
+			return nil, errors.InvalidFormatError("invalid tag filter %s", param)
+		}
+		if filter.Tags == nil {
+			filter.Tags = map[string]string{}
+		}
+		filter.Tags[key] = values[0]
+	}
+
+	for _, key := range query["tag"] {
+		if key == "" {
+			return nil, errors.InvalidFormatError("invalid tag value")
+		}
+		filter.TagKeys = append(filter.TagKeys, key)
+	}
+
+	for param, dst := range map[string]*time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+		"updated_after":  &filter.UpdatedAfter,
+		"updated_before": &filter.UpdatedBefore,
+	} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		*dst, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid %s value, expected RFC3339 date", param)
+		}
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		if strings.HasPrefix(sort, "-") {
+			filter.SortDesc = true
+			sort = strings.TrimPrefix(sort, "-")
+		}
+		if alias, ok := sortAliases[sort]; ok {
+			sort = alias
+		}
+		switch sort {
+		case entities.SortByCreatedAt, entities.SortByUpdatedAt, entities.SortByID:
+			filter.SortBy = sort
+		default:
+			return nil, errors.InvalidFormatError("invalid sort value %s", sort)
+		}
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		if query.Get("page") != "" {
+			return nil, errors.InvalidFormatError("cursor and page cannot be used together")
+		}
+
+		filter.Cursor, err = entities2.DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// The sort order is carried by the cursor
+		if query.Get("sort") != "" && (sortField(filter.SortBy) != sortField(filter.Cursor.SortBy) || filter.SortDesc != filter.Cursor.SortDesc) {
+			return nil, errors.InvalidFormatError("sort does not match the sort order of the cursor")
+		}
+		filter.SortBy, filter.SortDesc = filter.Cursor.SortBy, filter.Cursor.SortDesc
+	}
+
+	req.withTotal, err = getBool(request, "total")
+	if err != nil {
+		return nil, err
+	}
+
+	req.expand, err = getBool(request, "expand")
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// paging returns the cursors of the previous and next pages of the listed items and the total count if requested
+func (r *searchRequest) paging(items []*cursorItem, total uint64) http2.PagePagingResponse {
+	paging := http2.PagePagingResponse{}
+	if r.withTotal {
+		paging.Total = &total
+	}
+
+	paging.PreviousCursor, paging.NextCursor = entities2.PageCursors(r.filter.Cursor, len(items), r.filter.Limit, func(i int) *entities2.Cursor {
+		return r.cursor(items[i])
+	})
+
+	return paging
+}
+
+func (r *searchRequest) cursor(item *cursorItem) *entities2.Cursor {
+	cursor := &entities2.Cursor{
+		SortBy:   r.filter.SortBy,
+		SortDesc: r.filter.SortDesc,
+		ID:       item.id,
+	}
+
+	switch sortField(r.filter.SortBy) {
+	case entities.SortByCreatedAt:
+		cursor.Value = item.createdAt.Format(time.RFC3339Nano)
+	case entities.SortByUpdatedAt:
+		cursor.Value = item.updatedAt.Format(time.RFC3339Nano)
+	}
+
+	return cursor
+}
+
+// checkCursor checks the sort order and value of a cursor, which are sent back by clients, as the sort parameter
+func checkCursor(cursor *entities2.Cursor) error {
+	switch sortField(cursor.SortBy) {
+	case entities.SortByCreatedAt, entities.SortByUpdatedAt:
+		if _, err := time.Parse(time.RFC3339Nano, cursor.Value); err != nil {
+			return errors.InvalidFormatError("invalid cursor")
+		}
+	case entities.SortByID:
+	default:
+		return errors.InvalidFormatError("invalid cursor")
+	}
+
+	return nil
+}
+
+// sortField returns the sort field of the filter, items are sorted by creation date by default
+func sortField(sortBy string) string {
+	if sortBy == "" {
+		return entities.SortByCreatedAt
+	}
+
+	return sortBy
+}
+
+func getBool(request *http.Request, param string) (bool, error) {
+	value := request.URL.Query().Get(param)
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.InvalidFormatError("invalid %s value", param)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,162 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authapi "github.com/consensys/quorum-key-manager/src/auth/api/http"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stores := mock.NewMockStores(ctrl)
+	ctx := authapi.WithUserInfo(context.Background(), secretUserInfo)
+
+	router := mux.NewRouter()
+	NewStoresHandler(stores).Register(router)
+
+	t.Run("should return the result of the migration of each item", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&types.MigrateStoreRequest{Destination: "dest-store", DryRun: true})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/migrate", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		stores.EXPECT().Migrate(gomock.Any(), "my-store", "dest-store", true, false, secretUserInfo).Return([]*entities.MigratedItem{
+			{ID: "my-key", Status: entities.MigrationStatusPending},
+			{ID: "my-other-key", Status: entities.MigrationStatusSkipped, Error: "item already exists in the destination store"},
+		}, nil)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		require.Equal(t, http.StatusOK, rw.Code)
+		assert.JSONEq(t, `{"items":[{"id":"my-key","status":"pending"},{"id":"my-other-key","status":"skipped","error":"item already exists in the destination store"}]}`, rw.Body.String())
+	})
+
+	t.Run("should fail with 400 if the destination store is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/migrate", bytes.NewReader([]byte(`{"dryRun":true}`))).WithContext(ctx)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	t.Run("should fail with 501 if the items of the store cannot be exported", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&types.MigrateStoreRequest{Destination: "dest-store"})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/migrate", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		stores.EXPECT().Migrate(gomock.Any(), "my-store", "dest-store", false, false, secretUserInfo).Return(nil, errors.NotSupportedError("error"))
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotImplemented, rw.Code)
+	})
+}
+
+func TestBackup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stores := mock.NewMockStores(ctrl)
+	ctx := authapi.WithUserInfo(context.Background(), secretUserInfo)
+
+	router := mux.NewRouter()
+	NewStoresHandler(stores).Register(router)
+
+	t.Run("should return the encrypted archive of the store", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/backup", bytes.NewReader([]byte(`{"passphrase":"my-passphrase"}`))).WithContext(ctx)
+
+		stores.EXPECT().Backup(gomock.Any(), "my-store", &entities.BackupEncryption{Passphrase: "my-passphrase"}, secretUserInfo).Return([]byte("my-archive"), nil)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		require.Equal(t, http.StatusOK, rw.Code)
+		assert.JSONEq(t, `{"archive":"bXktYXJjaGl2ZQ=="}`, rw.Body.String())
+	})
+
+	t.Run("should fail with 400 if the public key is not hex encoded", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/backup", bytes.NewReader([]byte(`{"publicKey":"invalid"}`))).WithContext(ctx)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	t.Run("should fail with 501 if the items of the store cannot be exported", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/backup", bytes.NewReader([]byte(`{"publicKey":"0x0102"}`))).WithContext(ctx)
+
+		stores.EXPECT().Backup(gomock.Any(), "my-store", &entities.BackupEncryption{PublicKey: []byte{1, 2}}, secretUserInfo).Return(nil, errors.NotSupportedError("error"))
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotImplemented, rw.Code)
+	})
+}
+
+func TestRestore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stores := mock.NewMockStores(ctrl)
+	ctx := authapi.WithUserInfo(context.Background(), secretUserInfo)
+
+	router := mux.NewRouter()
+	NewStoresHandler(stores).Register(router)
+
+	t.Run("should return the result of the restoration of each item", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&types.RestoreStoreRequest{Archive: []byte("my-archive"), Passphrase: "my-passphrase"})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/restore", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		stores.EXPECT().RestoreBackup(gomock.Any(), "my-store", []byte("my-archive"), &entities.BackupDecryption{Passphrase: "my-passphrase"}, secretUserInfo).Return([]*entities.MigratedItem{
+			{ID: "my-secret", Status: entities.MigrationStatusRestored},
+		}, nil)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		require.Equal(t, http.StatusOK, rw.Code)
+		assert.JSONEq(t, `{"items":[{"id":"my-secret","status":"restored"}]}`, rw.Body.String())
+	})
+
+	t.Run("should fail with 400 if the archive is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/restore", bytes.NewReader([]byte(`{"passphrase":"my-passphrase"}`))).WithContext(ctx)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	t.Run("should fail with 409 if the store is not empty", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&types.RestoreStoreRequest{Archive: []byte("my-archive"), Passphrase: "my-passphrase"})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/my-store/restore", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		stores.EXPECT().RestoreBackup(gomock.Any(), "my-store", []byte("my-archive"), gomock.Any(), secretUserInfo).Return(nil, errors.StatusConflictError("error"))
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
@@ -53,7 +53,7 @@ func (h *StoresHandler) CreateSecret(ctx context.Context, name string, allowedTe
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.stores.CreateSecret(ctx, name, createReq.Vault, allowedTenants, h.userInfo)
+	err = h.stores.CreateSecret(ctx, name, createReq.Vault, createReq.PathPrefix, allowedTenants, createReq.RecoveryPeriod, h.userInfo)
 	if err != nil {
 		return err
 	}
@@ -68,7 +68,7 @@ func (h *StoresHandler) CreateKey(ctx context.Context, name string, allowedTenan
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.stores.CreateKey(ctx, name, createReq.Vault, createReq.SecretStore, allowedTenants, h.userInfo)
+	err = h.stores.CreateKey(ctx, name, createReq.Vault, createReq.SecretStore, createReq.ReplicaRegions, allowedTenants, createReq.RecoveryPeriod, createReq.MasterKey, createReq.PreviousMasterKey, h.userInfo)
 	if err != nil {
 		return err
 	}
@@ -83,7 +83,7 @@ func (h *StoresHandler) CreateEthereum(ctx context.Context, name string, allowed
 		return errors.InvalidFormatError(err.Error())
 	}
 
-	err = h.stores.CreateEthereum(ctx, name, createReq.KeyStore, allowedTenants, h.userInfo)
+	err = h.stores.CreateEthereum(ctx, name, createReq.KeyStore, allowedTenants, createReq.RecoveryPeriod, h.userInfo)
 	if err != nil {
 		return err
 	}
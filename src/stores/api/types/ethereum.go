@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	"github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,18 +18,22 @@ const (
 )
 
 type CreateEthAccountRequest struct {
-	KeyID string            `json:"keyId,omitempty" example:"my-key-account"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	KeyID          string            `json:"keyId,omitempty" example:"my-key-account"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	RecoveryPeriod *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h" swaggertype:"string"`
 }
 
 type ImportEthAccountRequest struct {
-	KeyID      string            `json:"keyId,omitempty" example:"my-imported-key-account"`
-	PrivateKey hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
-	Tags       map[string]string `json:"tags,omitempty"`
+	KeyID          string            `json:"keyId,omitempty" example:"my-imported-key-account"`
+	PrivateKey     hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	RecoveryPeriod *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h" swaggertype:"string"`
 }
 
 type UpdateEthAccountRequest struct {
-	Tags map[string]string `json:"tags,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty"`
+	Disabled *bool             `json:"disabled,omitempty" example:"true"`
+	ExpireAt *time.Time        `json:"expireAt,omitempty" example:"2021-07-09T12:35:42.115395Z"`
 }
 
 type SignMessageRequest struct {
@@ -101,4 +106,6 @@ type EthAccountResponse struct {
 	Tags                map[string]string `json:"tags,omitempty"`
 	Address             common.Address    `json:"address" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6" swaggertype:"string"`
 	Disabled            bool              `json:"disabled" example:"false"`
+	ExpireAt            *time.Time        `json:"expireAt,omitempty" example:"2021-07-09T12:35:42.115395Z"`
+	RecoveryPeriod      *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h0m0s" swaggertype:"string"`
 }
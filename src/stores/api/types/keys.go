@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	"github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
@@ -10,6 +11,9 @@ type CreateKeyRequest struct {
 	Curve            string            `json:"curve" validate:"required,isCurve" example:"secp256k1" enums:"babyjubjub,secp256k1"`
 	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
 	Tags             map[string]string `json:"tags,omitempty"`
+	RotationPeriod   *json.Duration    `json:"rotationPeriod,omitempty" example:"2160h" swaggertype:"string"`
+	Operations       []string          `json:"operations,omitempty" validate:"omitempty,dive,oneof=signing encryption" example:"signing"`
+	RecoveryPeriod   *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h" swaggertype:"string"`
 }
 
 type ImportKeyRequest struct {
@@ -17,14 +21,27 @@ type ImportKeyRequest struct {
 	SigningAlgorithm string            `json:"signingAlgorithm" validate:"required,isSigningAlgorithm" example:"ecdsa" enums:"ecdsa,eddsa"`
 	PrivateKey       []byte            `json:"privateKey" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
 	Tags             map[string]string `json:"tags,omitempty"`
+	RotationPeriod   *json.Duration    `json:"rotationPeriod,omitempty" example:"2160h" swaggertype:"string"`
+	Operations       []string          `json:"operations,omitempty" validate:"omitempty,dive,oneof=signing encryption" example:"signing"`
+	RecoveryPeriod   *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h" swaggertype:"string"`
 }
 
 type UpdateKeyRequest struct {
-	Tags map[string]string `json:"tags,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	RotationPeriod *json.Duration    `json:"rotationPeriod,omitempty" example:"2160h" swaggertype:"string"`
+	Disabled       *bool             `json:"disabled,omitempty" example:"true"`
+	ExpireAt       *time.Time        `json:"expireAt,omitempty" example:"2021-07-09T12:35:42.115395Z"`
 }
 
 type SignBase64PayloadRequest struct {
-	Data []byte `json:"data" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
+	Data    []byte `json:"data" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
+	Version string `json:"version,omitempty" example:"2"`
+}
+
+type VerifyBase64SignatureRequest struct {
+	Data      []byte `json:"data" validate:"required" example:"bXkgc2lnbmVkIG1lc3NhZ2U=" swaggertype:"string"`
+	Signature []byte `json:"signature" validate:"required" example:"tjThYhKSFSKKvsR8Pji6EJ+FYAcf8TNUdAQnM7MSwZEEaPvFhpr1SuGpX5uOcYUrb3pBA8cLk8xcbKtvZ56qWA==" swaggertype:"string"`
+	Version   string `json:"version,omitempty" example:"2"`
 }
 
 type KeyResponse struct {
@@ -34,7 +51,13 @@ type KeyResponse struct {
 	SigningAlgorithm string               `json:"signingAlgorithm" example:"ecdsa"`
 	Tags             map[string]string    `json:"tags,omitempty"`
 	Annotations      *entities.Annotation `json:"annotations,omitempty"`
+	Operations       []string             `json:"operations,omitempty" example:"signing"`
+	Version          string               `json:"version,omitempty" example:"2"`
+	RotationPeriod   *json.Duration       `json:"rotationPeriod,omitempty" example:"2160h0m0s" swaggertype:"string"`
+	NextRotationAt   *time.Time           `json:"nextRotationAt,omitempty" example:"2020-10-07T12:35:42.115395Z"`
 	Disabled         bool                 `json:"disabled" example:"false"`
+	ExpireAt         *time.Time           `json:"expireAt,omitempty" example:"2021-07-09T12:35:42.115395Z"`
+	RecoveryPeriod   *json.Duration       `json:"recoveryPeriod,omitempty" example:"720h0m0s" swaggertype:"string"`
 	CreatedAt        time.Time            `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt        time.Time            `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 	DeletedAt        *time.Time           `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
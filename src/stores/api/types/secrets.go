@@ -1,19 +1,38 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/json"
+)
 
 type SetSecretRequest struct {
-	Value string            `json:"value" validate:"required" example:"my-value"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	Value           string            `json:"value" validate:"required" example:"my-value"`
+	ValueType       string            `json:"valueType,omitempty" validate:"omitempty,oneof=string base64 json" example:"string"`
+	Tags            map[string]string `json:"tags,omitempty"`
+	RecoveryPeriod  *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h" swaggertype:"string"`
+	ExpectedVersion string            `json:"expectedVersion,omitempty" example:"1"`
+}
+
+type GenerateSecretRequest struct {
+	Generator      string            `json:"generator" validate:"required,oneof=string bytes uuid rsa ecdsa" example:"string"`
+	Length         int               `json:"length,omitempty" validate:"omitempty,min=1,max=4096" example:"32"`
+	Charset        string            `json:"charset,omitempty" example:"abcdef0123456789"`
+	KeySize        int               `json:"keySize,omitempty" validate:"omitempty,oneof=2048 3072 4096" example:"2048"`
+	Curve          string            `json:"curve,omitempty" validate:"omitempty,oneof=P-256 P-384 P-521" example:"P-256"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	RecoveryPeriod *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h" swaggertype:"string"`
 }
 
 type SecretResponse struct {
-	ID        string            `json:"id" example:"my-secret"`
-	Value     string            `json:"value" example:"my-value"`
-	Tags      map[string]string `json:"tags,omitempty"`
-	Version   string            `json:"version" example:"1"`
-	Disabled  bool              `json:"disabled" example:"false"`
-	CreatedAt time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
-	UpdatedAt time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
-	DeletedAt *time.Time        `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	ID             string            `json:"id" example:"my-secret"`
+	Value          string            `json:"value,omitempty" example:"my-value"`
+	ValueType      string            `json:"valueType" example:"string"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Version        string            `json:"version" example:"1"`
+	Disabled       bool              `json:"disabled" example:"false"`
+	RecoveryPeriod *json.Duration    `json:"recoveryPeriod,omitempty" example:"720h0m0s" swaggertype:"string"`
+	CreatedAt      time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt      time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+	DeletedAt      *time.Time        `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
 }
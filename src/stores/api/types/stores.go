@@ -1,14 +1,66 @@
 package types
 
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
 type CreateSecretStoreRequest struct {
-	Vault string `json:"vault" validate:"required" yaml:"vault" example:"hashicorp-kv-v2"`
+	Vault          string        `json:"vault" validate:"required" yaml:"vault" example:"hashicorp-kv-v2"`
+	PathPrefix     string        `json:"pathPrefix,omitempty" yaml:"path_prefix,omitempty" example:"team-a/secrets"`
+	RecoveryPeriod time.Duration `json:"recoveryPeriod,omitempty" yaml:"recovery_period,omitempty" example:"720h" swaggertype:"string"`
 }
 
 type CreateKeyStoreRequest struct {
-	SecretStore string `json:"secretStore,omitempty" yaml:"secret_store,omitempty" example:"my-secret-store"`
-	Vault       string `json:"vault,omitempty" yaml:"vault,omitempty" example:"hashicorp-quorum"`
+	SecretStore    string        `json:"secretStore,omitempty" yaml:"secret_store,omitempty" example:"my-secret-store"`
+	Vault          string        `json:"vault,omitempty" yaml:"vault,omitempty" example:"hashicorp-quorum"`
+	ReplicaRegions []string      `json:"replicaRegions,omitempty" yaml:"replica_regions,omitempty" example:"eu-west-1"`
+	RecoveryPeriod time.Duration `json:"recoveryPeriod,omitempty" yaml:"recovery_period,omitempty" example:"720h" swaggertype:"string"`
+	// MasterKey wraps the key encrypting the private keys of a local key store
+	MasterKey         *entities.MasterKeyConfig `json:"masterKey,omitempty" yaml:"master_key,omitempty"`
+	PreviousMasterKey *entities.MasterKeyConfig `json:"previousMasterKey,omitempty" yaml:"previous_master_key,omitempty"`
 }
 
 type CreateEthereumStoreRequest struct {
-	KeyStore string `json:"keyStore" yaml:"key_store" validate:"required" example:"my-key-store"`
+	KeyStore       string        `json:"keyStore" yaml:"key_store" validate:"required" example:"my-key-store"`
+	RecoveryPeriod time.Duration `json:"recoveryPeriod,omitempty" yaml:"recovery_period,omitempty" example:"720h" swaggertype:"string"`
+}
+
+type MigrateStoreRequest struct {
+	Destination  string `json:"destination" validate:"required" example:"my-prod-store"`
+	DryRun       bool   `json:"dryRun,omitempty" example:"true"`
+	DeleteSource bool   `json:"deleteSource,omitempty" example:"false"`
+}
+
+type MigrateStoreResponse struct {
+	Items []*MigratedItemResponse `json:"items"`
+}
+
+type MigratedItemResponse struct {
+	ID     string `json:"id" example:"my-key"`
+	Status string `json:"status" example:"migrated" enums:"migrated,pending,skipped,failed,restored"`
+	Error  string `json:"error,omitempty" example:"item already exists in the destination store"`
+}
+
+type BackupStoreRequest struct {
+	// Passphrase encrypting the archive, exclusive with publicKey
+	Passphrase string `json:"passphrase,omitempty" example:"correct horse battery staple"`
+	// PublicKey is the secp256k1 public key of the recipient of the archive, exclusive with passphrase
+	PublicKey hexutil.Bytes `json:"publicKey,omitempty" example:"0x04555214986a521f43409c1265f4b4e9...4e5aee4" swaggertype:"string"`
+}
+
+type BackupStoreResponse struct {
+	Archive []byte `json:"archive" example:"eyJ2ZXJzaW9uIjoxLCJlbmNyeXB0aW9uIjoi..." swaggertype:"string" format:"base64"`
+}
+
+type RestoreStoreRequest struct {
+	Archive    []byte        `json:"archive" validate:"required" example:"eyJ2ZXJzaW9uIjoxLCJlbmNyeXB0aW9uIjoi..." swaggertype:"string" format:"base64"`
+	Passphrase string        `json:"passphrase,omitempty" example:"correct horse battery staple"`
+	PrivateKey hexutil.Bytes `json:"privateKey,omitempty" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
+}
+
+type RestoreStoreResponse struct {
+	Items []*MigratedItemResponse `json:"items"`
 }
@@ -21,6 +21,11 @@ func (c Connector) Decrypt(ctx context.Context, addr common.Address, data []byte
 		return nil, err
 	}
 
+	err = c.checkUsage(acc)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := c.store.Decrypt(ctx, acc.KeyID, data)
 	if err != nil {
 		return nil, err
@@ -21,6 +21,11 @@ func (c Connector) Encrypt(ctx context.Context, addr ethcommon.Address, data []b
 		return nil, err
 	}
 
+	err = c.checkUsage(acc)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := c.store.Encrypt(ctx, acc.KeyID, data)
 	if err != nil {
 		return nil, err
@@ -1,11 +1,15 @@
 package eth
 
 import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
 type Connector struct {
@@ -30,3 +34,19 @@ func NewConnector(store stores.KeyStore, db database.ETHAccounts, authorizator a
 		authorizator: authorizator,
 	}
 }
+
+// checkUsage verifies that the account is enabled and not expired
+func (c Connector) checkUsage(acc *storesentities.ETHAccount) error {
+	var err error
+	switch {
+	case acc.Metadata.Disabled:
+		err = errors.KeyUsageError("account is disabled")
+	case acc.Metadata.IsExpired():
+		err = errors.KeyUsageError("account expired on %s", acc.Metadata.ExpireAt.Format(time.RFC3339))
+	default:
+		return nil
+	}
+
+	c.logger.With("address", acc.Address.Hex()).Warn(err.Error())
+	return err
+}
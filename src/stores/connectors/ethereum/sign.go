@@ -213,6 +213,11 @@ func (c Connector) sign(ctx context.Context, addr common.Address, data []byte) (
 		return nil, err
 	}
 
+	err = c.checkUsage(acc)
+	if err != nil {
+		return nil, err
+	}
+
 	signature, err := c.store.Sign(ctx, acc.KeyID, data, ethAlgo)
 	if err != nil {
 		return nil, err
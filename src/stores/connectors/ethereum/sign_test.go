@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	common2 "github.com/consensys/quorum-key-manager/pkg/common"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
@@ -123,6 +125,30 @@ func TestSignMessage(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
 	})
+
+	t.Run("should fail with KeyUsageError if account is disabled", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		acc.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+
+		_, err := connector.SignMessage(ctx, acc.Address, data)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
+
+	t.Run("should fail with KeyUsageError if account is expired", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		acc.Metadata.ExpireAt = time.Now().Add(-time.Hour)
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+
+		_, err := connector.SignMessage(ctx, acc.Address, data)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
 }
 
 func TestSignTransaction(t *testing.T) {
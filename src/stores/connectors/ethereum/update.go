@@ -25,6 +25,12 @@ func (c Connector) Update(ctx context.Context, addr ethcommon.Address, attr *ent
 		return nil, err
 	}
 	acc.Tags = attr.Tags
+	if attr.Disabled != nil {
+		acc.Metadata.Disabled = *attr.Disabled
+	}
+	if attr.ExpireAt != nil {
+		acc.Metadata.ExpireAt = *attr.ExpireAt
+	}
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
 		acc, err = dbtx.Update(ctx, acc)
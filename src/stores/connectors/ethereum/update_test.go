@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
@@ -54,6 +55,25 @@ func TestUpdateKey(t *testing.T) {
 		assert.Equal(t, rAcc, acc)
 	})
 
+	t.Run("should disable ethAccount and set its expiration date", func(t *testing.T) {
+		disabled := true
+		expireAt := time.Now().Add(time.Hour)
+		updateAttr := testutils2.FakeAttributes()
+		updateAttr.Disabled = &disabled
+		updateAttr.ExpireAt = &expireAt
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		db.EXPECT().Update(gomock.Any(), acc).Return(acc, nil)
+		store.EXPECT().Update(gomock.Any(), acc.KeyID, updateAttr).Return(nil, errors.NotSupportedError("not supported"))
+
+		rAcc, err := connector.Update(ctx, acc.Address, updateAttr)
+
+		assert.NoError(t, err)
+		assert.True(t, rAcc.Metadata.Disabled)
+		assert.Equal(t, expireAt, rAcc.Metadata.ExpireAt)
+	})
+
 	t.Run("should update key successfully, ignoring not supported error", func(t *testing.T) {
 		rErr := errors.NotSupportedError("not supported")
 
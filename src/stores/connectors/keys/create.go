@@ -35,6 +35,14 @@ func (c Connector) Create(ctx context.Context, id string, alg *entities2.Algorit
 		return nil, err
 	}
 
+	key.Operations = attr.Operations
+	if attr.Recovery != nil {
+		key.Metadata.RecoveryPeriod = attr.Recovery.Period
+	}
+	if attr.RotationPeriod != nil {
+		key.SetRotationPeriod(*attr.RotationPeriod)
+	}
+
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
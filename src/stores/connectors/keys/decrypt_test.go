@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -35,6 +38,7 @@ func TestDecryptKey(t *testing.T) {
 
 	t.Run("should decrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(result, nil)
 
 		rResult, err := connector.Decrypt(ctx, key.ID, data)
@@ -54,6 +58,7 @@ func TestDecryptKey(t *testing.T) {
 
 	t.Run("should fail to decrypt data if decrypt fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
 
 		_, err := connector.Decrypt(ctx, key.ID, data)
@@ -61,4 +66,16 @@ func TestDecryptKey(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
 	})
+
+	t.Run("should fail with KeyUsageError if key is not allowed for encryption", func(t *testing.T) {
+		signingKey := testutils2.FakeKey()
+		signingKey.Operations = []storesentities.CryptoOperation{storesentities.Signing}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionEncrypt, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(signingKey, nil)
+
+		_, err := connector.Decrypt(ctx, key.ID, data)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
 }
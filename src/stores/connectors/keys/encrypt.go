@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
 func (c Connector) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
@@ -14,6 +15,16 @@ func (c Connector) Encrypt(ctx context.Context, id string, data []byte) ([]byte,
 		return nil, err
 	}
 
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkUsage(key, storesentities.Encryption)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := c.store.Encrypt(ctx, id, data)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,30 @@
+package keys
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+)
+
+// Export exports the private key of a key, private keys are only exported to be migrated to another store
+func (c Connector) Export(ctx context.Context, id string) ([]byte, error) {
+	logger := c.logger.With("id", id)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionMigrate, Resource: authentities.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := c.store.Export(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("key exported successfully")
+	return privKey, nil
+}
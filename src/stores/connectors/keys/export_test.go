@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+	migrateOp := &entities.Operation{Action: entities.ActionMigrate, Resource: entities.ResourceStore}
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+	key := testutils2.FakeKey()
+
+	t.Run("should export the private key of a key successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(migrateOp).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Export(gomock.Any(), key.ID).Return([]byte("private-key"), nil)
+
+		privKey, err := connector.Export(ctx, key.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("private-key"), privKey)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(migrateOp).Return(expectedErr)
+
+		_, err := connector.Export(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if the key is not found", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(migrateOp).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, expectedErr)
+
+		_, err := connector.Export(ctx, key.ID)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with NotSupportedError if the store cannot export keys", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(migrateOp).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Export(gomock.Any(), key.ID).Return(nil, errors.NotSupportedError("error"))
+
+		_, err := connector.Export(ctx, key.ID)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+}
@@ -41,6 +41,14 @@ func (c Connector) Import(ctx context.Context, id string, privKey []byte, alg *e
 		return nil, err
 	}
 
+	key.Operations = attr.Operations
+	if attr.Recovery != nil {
+		key.Metadata.RecoveryPeriod = attr.Recovery.Period
+	}
+	if attr.RotationPeriod != nil {
+		key.SetRotationPeriod(*attr.RotationPeriod)
+	}
+
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
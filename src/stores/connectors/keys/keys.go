@@ -1,11 +1,15 @@
 package keys
 
 import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
 type Connector struct {
@@ -15,7 +19,7 @@ type Connector struct {
 	authorizator auth.Authorizator
 }
 
-var _ stores.KeyStore = Connector{}
+var _ stores.IndexedKeyStore = Connector{}
 
 func NewConnector(store stores.KeyStore, db database.Keys, authorizator auth.Authorizator, logger log.Logger) *Connector {
 	return &Connector{
@@ -41,3 +45,21 @@ func isSupportedAlgo(alg *entities.Algorithm) bool {
 
 	return false
 }
+
+// checkUsage verifies that the key is enabled, not expired and allowed for the crypto operation
+func (c Connector) checkUsage(key *storesentities.Key, op storesentities.CryptoOperation) error {
+	var err error
+	switch {
+	case key.Metadata.Disabled:
+		err = errors.KeyUsageError("key is disabled")
+	case key.Metadata.IsExpired():
+		err = errors.KeyUsageError("key expired on %s", key.Metadata.ExpireAt.Format(time.RFC3339))
+	case !key.AllowsOperation(op):
+		err = errors.KeyUsageError("key is not allowed for %s", op)
+	default:
+		return nil
+	}
+
+	c.logger.With("id", key.ID).Warn(err.Error())
+	return err
+}
@@ -0,0 +1,58 @@
+package keys
+
+import (
+	"context"
+
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	logger := c.logger.With("id", id)
+	logger.Debug("rotating key")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	var key *entities.Key
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
+		current, derr := dbtx.Get(ctx, id)
+		if derr != nil {
+			return derr
+		}
+
+		if alg == nil {
+			alg = current.Algo
+		}
+
+		rotatedKey, derr := c.store.Rotate(ctx, id, alg)
+		if derr != nil {
+			return derr
+		}
+
+		current.PublicKey = rotatedKey.PublicKey
+		current.Metadata.Version = rotatedKey.Metadata.Version
+		current.Metadata.UpdatedAt = rotatedKey.Metadata.UpdatedAt
+		current.SetRotationPeriod(current.RotationPeriod)
+
+		key, derr = dbtx.AddVersion(ctx, current)
+		if derr != nil {
+			logger.WithError(derr).Error("key rotated in the store but its new version could not be indexed", "version", rotatedKey.Metadata.Version)
+			return derr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("key rotated successfully", "version", key.Metadata.Version)
+	return key, nil
+}
@@ -0,0 +1,97 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should rotate key successfully and schedule its next rotation", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.SetRotationPeriod(time.Hour)
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.PublicKey = []byte("new-public-key")
+		rotatedKey.Metadata.Version = "2"
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(rotatedKey, nil)
+		db.EXPECT().AddVersion(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *entities2.Key) (*entities2.Key, error) {
+			return k, nil
+		})
+
+		rKey, err := connector.Rotate(ctx, key.ID, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2", rKey.Metadata.Version)
+		assert.Equal(t, rotatedKey.PublicKey, rKey.PublicKey)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), rKey.NextRotationAt, time.Minute)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Rotate(ctx, "my-key", nil)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if the store does not support rotation", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		notSupportedErr := errors.NotSupportedError("error")
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(nil, notSupportedErr)
+
+		_, err := connector.Rotate(ctx, key.ID, nil)
+
+		assert.Equal(t, err, notSupportedErr)
+	})
+
+	t.Run("should fail with same error if the new version cannot be indexed", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(testutils2.FakeKey(), nil)
+		db.EXPECT().AddVersion(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		_, err := connector.Rotate(ctx, key.ID, nil)
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
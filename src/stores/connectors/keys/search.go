@@ -0,0 +1,38 @@
+package keys
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Key, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.db.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("keys searched successfully", "count", len(result))
+	return result, nil
+}
+
+func (c Connector) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := c.db.Count(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	c.logger.Debug("keys counted successfully", "count", count)
+	return count, nil
+}
@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	filter := &entities.SearchFilter{
+		Tags:     map[string]string{"env": "prod"},
+		SortBy:   entities.SortByUpdatedAt,
+		SortDesc: true,
+		Limit:    10,
+	}
+
+	t.Run("should search keys successfully", func(t *testing.T) {
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey}).Return(nil)
+		db.EXPECT().Search(gomock.Any(), filter).Return([]*entities.Key{key}, nil)
+
+		keys, err := connector.Search(ctx, filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.Key{key}, keys)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Search(ctx, filter)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail to search keys if db fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey}).Return(nil)
+		db.EXPECT().Search(gomock.Any(), filter).Return(nil, expectedErr)
+
+		_, err := connector.Search(ctx, filter)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
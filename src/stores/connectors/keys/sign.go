@@ -6,6 +6,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/entities"
 
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
 func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
@@ -16,12 +17,17 @@ func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entit
 		return nil, err
 	}
 
-	if algo == nil {
-		key, derr := c.db.Get(ctx, id)
-		if derr != nil {
-			return nil, derr
-		}
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 
+	err = c.checkUsage(key, storesentities.Signing)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo == nil {
 		algo = key.Algo
 	}
 
@@ -33,3 +39,45 @@ func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entit
 	logger.Debug("payload signed successfully")
 	return result, nil
 }
+
+func (c Connector) SignWithVersion(ctx context.Context, id, version string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	logger := c.logger.With("id", id, "version", version)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionSign, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkUsage(key, storesentities.Signing)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo == nil {
+		algo = key.Algo
+	}
+
+	var result []byte
+	if version == "" || version == key.Metadata.Version {
+		result, err = c.store.Sign(ctx, id, data, algo)
+	} else {
+		// Fails with a not found error if the version does not exist
+		_, err = c.db.GetVersion(ctx, id, version)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err = c.store.SignWithVersion(ctx, id, version, data, algo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("payload signed successfully")
+	return result, nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -36,6 +40,7 @@ func TestSignKey(t *testing.T) {
 
 	t.Run("should sign data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(result, nil)
 
 		rResult, err := connector.Sign(ctx, key.ID, data, algo)
@@ -66,6 +71,7 @@ func TestSignKey(t *testing.T) {
 
 	t.Run("should fail to sign data if sign fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(nil, expectedErr)
 
 		_, err := connector.Sign(ctx, key.ID, data, algo)
@@ -83,4 +89,104 @@ func TestSignKey(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, err, expectedErr)
 	})
+
+	t.Run("should fail with KeyUsageError if key is disabled", func(t *testing.T) {
+		disabledKey := testutils2.FakeKey()
+		disabledKey.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(disabledKey, nil)
+
+		_, err := connector.Sign(ctx, key.ID, data, algo)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
+
+	t.Run("should fail with KeyUsageError if key is expired", func(t *testing.T) {
+		expiredKey := testutils2.FakeKey()
+		expiredKey.Metadata.ExpireAt = time.Now().Add(-time.Hour)
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(expiredKey, nil)
+
+		_, err := connector.Sign(ctx, key.ID, data, algo)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
+
+	t.Run("should fail with KeyUsageError if key is not allowed for signing", func(t *testing.T) {
+		encryptionKey := testutils2.FakeKey()
+		encryptionKey.Operations = []storesentities.CryptoOperation{storesentities.Encryption}
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(encryptionKey, nil)
+
+		_, err := connector.Sign(ctx, key.ID, data, algo)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
+}
+
+func TestSignWithVersionKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := []byte("0x123")
+	result := []byte("0x456")
+	key := testutils2.FakeKey()
+	key.Metadata.Version = "2"
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should sign data with a previous version successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().GetVersion(gomock.Any(), key.ID, "1").Return(key, nil)
+		store.EXPECT().SignWithVersion(gomock.Any(), key.ID, "1", data, key.Algo).Return(result, nil)
+
+		rResult, err := connector.SignWithVersion(ctx, key.ID, "1", data, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rResult, result)
+	})
+
+	t.Run("should sign data with the latest version successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		store.EXPECT().Sign(gomock.Any(), key.ID, data, key.Algo).Return(result, nil)
+
+		rResult, err := connector.SignWithVersion(ctx, key.ID, "2", data, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rResult, result)
+	})
+
+	t.Run("should fail with same error if the version does not exist", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().GetVersion(gomock.Any(), key.ID, "3").Return(nil, expectedErr)
+
+		_, err := connector.SignWithVersion(ctx, key.ID, "3", data, nil)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with KeyUsageError if key is disabled", func(t *testing.T) {
+		disabledKey := testutils2.FakeKey()
+		disabledKey.Metadata.Disabled = true
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionSign, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(disabledKey, nil)
+
+		_, err := connector.SignWithVersion(ctx, key.ID, "1", data, nil)
+
+		assert.True(t, errors.IsKeyUsageError(err))
+	})
 }
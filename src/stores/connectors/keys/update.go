@@ -25,6 +25,15 @@ func (c Connector) Update(ctx context.Context, id string, attr *entities.Attribu
 		return nil, err
 	}
 	key.Tags = attr.Tags
+	if attr.RotationPeriod != nil {
+		key.SetRotationPeriod(*attr.RotationPeriod)
+	}
+	if attr.Disabled != nil {
+		key.Metadata.Disabled = *attr.Disabled
+	}
+	if attr.ExpireAt != nil {
+		key.Metadata.ExpireAt = *attr.ExpireAt
+	}
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
 		key, err = dbtx.Update(ctx, key)
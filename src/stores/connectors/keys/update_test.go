@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/entities"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
@@ -12,6 +13,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -54,6 +56,28 @@ func TestUpdateKey(t *testing.T) {
 		assert.Equal(t, rKey, updatedKey)
 	})
 
+	t.Run("should disable key and set its expiration date", func(t *testing.T) {
+		disabled := true
+		expireAt := time.Now().Add(time.Hour)
+		updateAttr := testutils2.FakeAttributes()
+		updateAttr.Disabled = &disabled
+		updateAttr.ExpireAt = &expireAt
+		key := testutils2.FakeKey()
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		db.EXPECT().Update(gomock.Any(), key).DoAndReturn(func(_ context.Context, k *storesentities.Key) (*storesentities.Key, error) {
+			assert.True(t, k.Metadata.Disabled)
+			assert.Equal(t, expireAt, k.Metadata.ExpireAt)
+			return k, nil
+		})
+		store.EXPECT().Update(gomock.Any(), key.ID, updateAttr).Return(key, nil)
+
+		_, err := connector.Update(ctx, key.ID, updateAttr)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("should update key successfully, ignoring not supported error", func(t *testing.T) {
 		rErr := errors.NotSupportedError("not supported")
 
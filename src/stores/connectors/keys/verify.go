@@ -0,0 +1,30 @@
+package keys
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/utils/service/utils"
+)
+
+func (c Connector) Verify(ctx context.Context, id, version string, data, sig []byte) error {
+	logger := c.logger.With("id", id, "version", version)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return err
+	}
+
+	key, err := c.db.GetVersion(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = utils.New(c.logger).Verify(key.PublicKey, data, sig, key.Algo)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("signature verified successfully")
+	return nil
+}
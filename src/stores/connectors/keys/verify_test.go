@@ -0,0 +1,67 @@
+package keys
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/eddsa"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := []byte("my data")
+	privKey, pubKey, err := eddsa.CreateED25519(nil)
+	require.NoError(t, err)
+	signature, err := eddsa.SignED25519(privKey, data)
+	require.NoError(t, err)
+
+	key := testutils2.FakeKey()
+	key.PublicKey = pubKey
+	key.Algo = &entities2.Algorithm{Type: entities2.Eddsa, EllipticCurve: entities2.Curve25519}
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should verify a signature of a version successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetVersion(gomock.Any(), key.ID, "1").Return(key, nil)
+
+		err := connector.Verify(ctx, key.ID, "1", data, signature)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if the signature belongs to another version", func(t *testing.T) {
+		_, otherPubKey, err := eddsa.CreateED25519(nil)
+		require.NoError(t, err)
+		otherKey := testutils2.FakeKey()
+		otherKey.PublicKey = otherPubKey
+		otherKey.Algo = key.Algo
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceKey}).Return(nil)
+		db.EXPECT().GetVersion(gomock.Any(), key.ID, "").Return(otherKey, nil)
+
+		err = connector.Verify(ctx, key.ID, "", data, signature)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
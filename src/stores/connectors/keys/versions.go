@@ -0,0 +1,49 @@
+package keys
+
+import (
+	"context"
+
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	logger := c.logger.With("id", id, "version", version)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.GetVersion(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("key version retrieved successfully")
+	return key, nil
+}
+
+func (c Connector) ListVersions(ctx context.Context, id string) ([]string, error) {
+	logger := c.logger.With("id", id)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	// Fails with a not found error if the key does not exist
+	_, err = c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := c.db.ListVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("key versions listed successfully")
+	return versions, nil
+}
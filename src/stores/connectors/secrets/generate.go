@@ -0,0 +1,185 @@
+package secrets
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+const (
+	defaultSecretLength = 32
+	defaultCharset      = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	defaultRSAKeySize   = 2048
+	defaultCurve        = "P-256"
+)
+
+// keyPair is the value of the secrets generated as RSA or ECDSA key pairs
+type keyPair struct {
+	PrivateKey string `json:"privateKey"`
+	PublicKey  string `json:"publicKey"`
+}
+
+func (c Connector) Generate(ctx context.Context, id string, policy *entities.SecretPolicy, attr *entities.Attributes) (*entities.Secret, error) {
+	logger := c.logger.With("id", id, "generator", policy.Generator)
+	logger.Debug("generating secret")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceSecret})
+	if err != nil {
+		return nil, err
+	}
+
+	value, valueType, err := generateValue(policy)
+	if err != nil {
+		logger.WithError(err).Error("failed to generate secret value")
+		return nil, err
+	}
+
+	generatedAttr := *attr
+	generatedAttr.ValueType = valueType
+	secret, err := c.set(ctx, c.db, id, value, &generatedAttr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("secret generated successfully", "version", secret.Metadata.Version)
+	return secret, nil
+}
+
+func generateValue(policy *entities.SecretPolicy) (string, entities.SecretValueType, error) {
+	length := policy.Length
+	if length == 0 {
+		length = defaultSecretLength
+	}
+
+	switch policy.Generator {
+	case entities.StringGenerator:
+		charset := policy.Charset
+		if charset == "" {
+			charset = defaultCharset
+		}
+		value, err := randomString(length, []rune(charset))
+		return value, entities.StringSecretValue, err
+	case entities.BytesGenerator:
+		value, err := randomBytes(length)
+		return base64.StdEncoding.EncodeToString(value), entities.Base64SecretValue, err
+	case entities.UUIDGenerator:
+		value, err := randomUUID()
+		return value, entities.StringSecretValue, err
+	case entities.RSAGenerator:
+		value, err := rsaKeyPair(policy.KeySize)
+		return value, entities.JSONSecretValue, err
+	case entities.ECDSAGenerator:
+		value, err := ecdsaKeyPair(policy.Curve)
+		return value, entities.JSONSecretValue, err
+	default:
+		return "", "", errors.InvalidParameterError("unsupported secret generator %q", policy.Generator)
+	}
+}
+
+func randomString(length int, charset []rune) (string, error) {
+	value := make([]rune, length)
+	max := big.NewInt(int64(len(charset)))
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.CryptoOperationError("failed to generate random string")
+		}
+		value[i] = charset[n.Int64()]
+	}
+
+	return string(value), nil
+}
+
+func randomBytes(length int) ([]byte, error) {
+	value := make([]byte, length)
+	_, err := rand.Read(value)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to generate random bytes")
+	}
+
+	return value, nil
+}
+
+// randomUUID generates a version 4 UUID as defined in RFC 4122
+func randomUUID() (string, error) {
+	b, err := randomBytes(16)
+	if err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+func rsaKeyPair(keySize int) (string, error) {
+	if keySize == 0 {
+		keySize = defaultRSAKeySize
+	}
+	if keySize != 2048 && keySize != 3072 && keySize != 4096 {
+		return "", errors.InvalidParameterError("unsupported RSA key size %d", keySize)
+	}
+
+	privKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return "", errors.CryptoOperationError("failed to generate RSA key pair")
+	}
+
+	return pemKeyPair(privKey, &privKey.PublicKey)
+}
+
+func ecdsaKeyPair(curveName string) (string, error) {
+	var curve elliptic.Curve
+	switch curveName {
+	case "", defaultCurve:
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return "", errors.InvalidParameterError("unsupported ECDSA curve %q", curveName)
+	}
+
+	privKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return "", errors.CryptoOperationError("failed to generate ECDSA key pair")
+	}
+
+	return pemKeyPair(privKey, &privKey.PublicKey)
+}
+
+// pemKeyPair encodes a key pair as a JSON document holding the PKCS #8 private key and the PKIX public key in PEM format
+func pemKeyPair(privKey, pubKey interface{}) (string, error) {
+	privKeyDER, err := x509.MarshalPKCS8PrivateKey(privKey)
+	if err != nil {
+		return "", errors.CryptoOperationError("failed to marshal private key")
+	}
+
+	pubKeyDER, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return "", errors.CryptoOperationError("failed to marshal public key")
+	}
+
+	value, err := json.Marshal(&keyPair{
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privKeyDER})),
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKeyDER})),
+	})
+	if err != nil {
+		return "", errors.EncodingError("failed to encode key pair")
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,109 @@
+package secrets
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecret(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockSecrets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	generate := func(t *testing.T, policy *storesentities.SecretPolicy) *storesentities.Secret {
+		secret := testutils2.FakeSecret()
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		store.EXPECT().Set(gomock.Any(), secret.ID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, value string, _ *storesentities.Attributes) (*storesentities.Secret, error) {
+				secret.Value = value
+				return secret, nil
+			})
+		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		rSecret, err := connector.Generate(ctx, secret.ID, policy, &storesentities.Attributes{})
+		require.NoError(t, err)
+
+		return rSecret
+	}
+
+	t.Run("should generate a random string from the charset", func(t *testing.T) {
+		secret := generate(t, &storesentities.SecretPolicy{Generator: storesentities.StringGenerator, Length: 64, Charset: "ab"})
+
+		assert.Regexp(t, regexp.MustCompile("^[ab]{64}$"), secret.Value)
+		assert.Equal(t, storesentities.StringSecretValue, secret.ValueType)
+	})
+
+	t.Run("should generate random bytes encoded in base64", func(t *testing.T) {
+		secret := generate(t, &storesentities.SecretPolicy{Generator: storesentities.BytesGenerator})
+
+		value, err := base64.StdEncoding.DecodeString(secret.Value)
+		require.NoError(t, err)
+		assert.Len(t, value, defaultSecretLength)
+		assert.Equal(t, storesentities.Base64SecretValue, secret.ValueType)
+	})
+
+	t.Run("should generate a UUID", func(t *testing.T) {
+		secret := generate(t, &storesentities.SecretPolicy{Generator: storesentities.UUIDGenerator})
+
+		assert.Regexp(t, regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"), secret.Value)
+	})
+
+	t.Run("should generate an ECDSA key pair in PEM format", func(t *testing.T) {
+		secret := generate(t, &storesentities.SecretPolicy{Generator: storesentities.ECDSAGenerator, Curve: "P-384"})
+
+		assert.Equal(t, storesentities.JSONSecretValue, secret.ValueType)
+		pair := &keyPair{}
+		require.NoError(t, json.Unmarshal([]byte(secret.Value), pair))
+		block, _ := pem.Decode([]byte(pair.PrivateKey))
+		require.NotNil(t, block)
+		_, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		assert.NoError(t, err)
+		block, _ = pem.Decode([]byte(pair.PublicKey))
+		require.NotNil(t, block)
+		_, err = x509.ParsePKIXPublicKey(block.Bytes)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if the policy is not supported", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+
+		_, err := connector.Generate(ctx, "my-secret", &storesentities.SecretPolicy{Generator: storesentities.RSAGenerator, KeySize: 1024}, &storesentities.Attributes{})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(expectedErr)
+
+		_, err := connector.Generate(ctx, "my-secret", &storesentities.SecretPolicy{Generator: storesentities.UUIDGenerator}, &storesentities.Attributes{})
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
@@ -36,6 +36,10 @@ func (c Connector) Get(ctx context.Context, id, version string) (*entities.Secre
 		return nil, err
 	}
 	secret.Value = secretVault.Value
+	// The value type stored in the vault prevails, secrets indexed before value types were stored default to string
+	if secretVault.ValueType != "" {
+		secret.ValueType = secretVault.ValueType
+	}
 
 	logger.Debug("secret retrieved successfully")
 	return secret, nil
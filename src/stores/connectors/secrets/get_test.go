@@ -10,10 +10,12 @@ import (
 
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetSecret(t *testing.T) {
@@ -42,6 +44,22 @@ func TestGetSecret(t *testing.T) {
 		assert.Equal(t, secret, rSecret)
 	})
 
+	t.Run("should get the value type of the secret from the store", func(t *testing.T) {
+		indexedSecret := testutils2.FakeSecret()
+		indexedSecret.ValueType = entities2.StringSecretValue
+		vaultSecret := testutils2.FakeSecret()
+		vaultSecret.ValueType = entities2.JSONSecretValue
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(indexedSecret, nil)
+		store.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(vaultSecret, nil)
+
+		rSecret, err := connector.Get(ctx, secret.ID, secret.Metadata.Version)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities2.JSONSecretValue, rSecret.ValueType)
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(expectedErr)
 
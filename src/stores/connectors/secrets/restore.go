@@ -40,6 +40,14 @@ func (c Connector) Restore(ctx context.Context, id string) error {
 			return err
 		}
 
+		// Stores not supporting restoration are only restored in the index if they still hold the secret
+		if err != nil {
+			if _, gErr := c.store.Get(ctx, secret.ID, secret.Metadata.Version); gErr != nil {
+				logger.WithError(gErr).Error("secret cannot be restored, it was removed from the store")
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -74,12 +74,30 @@ func TestRestoreSecret(t *testing.T) {
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
 		db.EXPECT().Restore(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), secret.ID).Return(rErr)
+		store.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
 
 		err := connector.Restore(ctx, secret.ID)
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("should fail with NotSupportedError if the store does not support restoring and no longer holds the secret", func(t *testing.T) {
+		rErr := errors.NotSupportedError("not supported")
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(nil)
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, errors.NotFoundError("error"))
+		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
+		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		db.EXPECT().Restore(gomock.Any(), secret.ID).Return(nil)
+		store.EXPECT().Restore(gomock.Any(), secret.ID).Return(rErr)
+		store.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, errors.NotFoundError("error"))
+
+		err := connector.Restore(ctx, secret.ID)
+
+		assert.Equal(t, rErr, err)
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(expectedErr)
 
@@ -14,7 +14,7 @@ type Connector struct {
 	authorizator auth.Authorizator
 }
 
-var _ stores.SecretStore = &Connector{}
+var _ stores.IndexedSecretStore = &Connector{}
 
 func NewConnector(store stores.SecretStore, db database.Secrets, authorizator auth.Authorizator, logger log.Logger) *Connector {
 	return &Connector{
@@ -2,8 +2,11 @@ package secrets
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
 
 	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
 
@@ -19,6 +22,67 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
+	err = c.checkValue(value, attr.ValueType)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := c.set(ctx, c.db, id, value, attr)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("secret created successfully", "version", secret.Metadata.Version)
+	return secret, nil
+}
+
+// CompareAndSet creates a new version of the secret only if its latest version is the expected one.
+// An empty expected version requires the secret not to exist
+func (c Connector) CompareAndSet(ctx context.Context, id, value, expectedVersion string, attr *entities.Attributes) (*entities.Secret, error) {
+	logger := c.logger.With("id", id, "expected_version", expectedVersion)
+	logger.Debug("creating secret if its version matches")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionWrite, Resource: authentities.ResourceSecret})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkValue(value, attr.ValueType)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret *entities.Secret
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
+		// Concurrent writes of the secret wait for the transaction to end before reading its latest version
+		derr := dbtx.Lock(ctx, id)
+		if derr != nil {
+			return derr
+		}
+
+		latestVersion, derr := dbtx.GetLatestVersion(ctx, id, false)
+		if derr != nil && !errors.IsNotFoundError(derr) {
+			return derr
+		}
+
+		if latestVersion != expectedVersion {
+			errMessage := "latest secret version does not match the expected one"
+			logger.Error(errMessage, "version", latestVersion)
+			return errors.StatusConflictError(errMessage)
+		}
+
+		secret, derr = c.set(ctx, dbtx, id, value, attr)
+		return derr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("secret created successfully", "version", secret.Metadata.Version)
+	return secret, nil
+}
+
+func (c Connector) set(ctx context.Context, db database.Secrets, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
 	secret, err := c.store.Set(ctx, id, value, attr)
 	if err != nil && errors.IsAlreadyExistsError(err) {
 		secret, err = c.store.Get(ctx, id, "")
@@ -27,11 +91,45 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
-	_, err = c.db.Add(ctx, secret)
+	secret.ValueType = attr.ValueType
+	if secret.ValueType == "" {
+		secret.ValueType = entities.StringSecretValue
+	}
+
+	if attr.Recovery != nil {
+		secret.Metadata.RecoveryPeriod = attr.Recovery.Period
+	}
+
+	_, err = db.Add(ctx, secret)
 	if err != nil {
 		return nil, err
 	}
 
-	logger.Info("secret created successfully", "version", secret.Metadata.Version)
 	return secret, nil
 }
+
+// checkValue verifies that the value of a secret is encoded as its type states
+func (c Connector) checkValue(value string, valueType entities.SecretValueType) error {
+	var errMessage string
+	switch valueType {
+	case "", entities.StringSecretValue:
+		return nil
+	case entities.Base64SecretValue:
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			errMessage = "secret value is not valid base64"
+		}
+	case entities.JSONSecretValue:
+		if !json.Valid([]byte(value)) {
+			errMessage = "secret value is not valid JSON"
+		}
+	default:
+		errMessage = "unsupported secret value type"
+	}
+
+	if errMessage != "" {
+		c.logger.Error(errMessage, "value_type", valueType)
+		return errors.InvalidParameterError(errMessage)
+	}
+
+	return nil
+}
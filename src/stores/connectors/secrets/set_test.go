@@ -11,7 +11,9 @@ import (
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
@@ -57,6 +59,32 @@ func TestSetSecret(t *testing.T) {
 		assert.Equal(t, rSecret, secret)
 	})
 
+	t.Run("should set secret successfully with a typed value", func(t *testing.T) {
+		jsonAttributes := testutils2.FakeAttributes()
+		jsonAttributes.ValueType = storesentities.JSONSecretValue
+		value := `{"key":"value"}`
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		store.EXPECT().Set(gomock.Any(), secret.ID, value, jsonAttributes).Return(secret, nil)
+		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		rSecret, err := connector.Set(ctx, secret.ID, value, jsonAttributes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, storesentities.JSONSecretValue, rSecret.ValueType)
+	})
+
+	t.Run("should fail with InvalidParameterError if the value does not match its type", func(t *testing.T) {
+		base64Attributes := testutils2.FakeAttributes()
+		base64Attributes.ValueType = storesentities.Base64SecretValue
+
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+
+		_, err := connector.Set(ctx, secret.ID, "not base64!", base64Attributes)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(expectedErr)
 
@@ -87,3 +115,99 @@ func TestSetSecret(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+func TestCompareAndSetSecret(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := testutils2.FakeSecret()
+	attributes := testutils2.FakeAttributes()
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockSecrets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Secrets) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should set secret successfully if the latest version is the expected one", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Lock(gomock.Any(), secret.ID).Return(nil)
+		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return("1", nil)
+		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(secret, nil)
+		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		rSecret, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "1", attributes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rSecret, secret)
+	})
+
+	t.Run("should set secret successfully if it does not exist and no version is expected", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Lock(gomock.Any(), secret.ID).Return(nil)
+		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return("", errors.NotFoundError("error"))
+		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(secret, nil)
+		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		rSecret, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "", attributes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rSecret, secret)
+	})
+
+	t.Run("should fail with StatusConflictError if the latest version is not the expected one", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Lock(gomock.Any(), secret.ID).Return(nil)
+		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return("2", nil)
+
+		_, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "1", attributes)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with StatusConflictError if the secret does not exist", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Lock(gomock.Any(), secret.ID).Return(nil)
+		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return("", errors.NotFoundError("error"))
+
+		_, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "1", attributes)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(expectedErr)
+
+		_, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "1", attributes)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if the secret cannot be locked", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Lock(gomock.Any(), secret.ID).Return(expectedErr)
+
+		_, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "1", attributes)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if store fails to set", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionWrite, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().Lock(gomock.Any(), secret.ID).Return(nil)
+		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return("1", nil)
+		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(nil, expectedErr)
+
+		_, err := connector.CompareAndSet(ctx, secret.ID, secret.Value, "1", attributes)
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
@@ -0,0 +1,118 @@
+package secrets
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authentities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) ListVersions(ctx context.Context, id string) ([]*entities.Secret, error) {
+	logger := c.logger.With("id", id)
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionRead, Resource: authentities.ResourceSecret})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := c.db.GetAllVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(secrets) == 0 {
+		errMessage := "secret not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	logger.Debug("secret versions listed successfully")
+	return secrets, nil
+}
+
+func (c Connector) RestoreVersion(ctx context.Context, id, version string) error {
+	logger := c.logger.With("id", id, "version", version)
+	logger.Debug("restoring secret version")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionDelete, Resource: authentities.ResourceSecret})
+	if err != nil {
+		return err
+	}
+
+	secret, err := c.getVersion(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	// If the version is not deleted, exit without any action
+	if secret.Metadata.DeletedAt.IsZero() {
+		return nil
+	}
+
+	// Unlike whole secrets, versions are only restored when the underlying store supports it
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
+		derr := dbtx.RestoreVersion(ctx, id, version)
+		if derr != nil {
+			return derr
+		}
+
+		return c.store.RestoreVersion(ctx, id, version)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("secret version restored successfully")
+	return nil
+}
+
+func (c Connector) DestroyVersion(ctx context.Context, id, version string) error {
+	logger := c.logger.With("id", id, "version", version)
+	logger.Debug("permanently deleting secret version")
+
+	err := c.authorizator.CheckPermission(&authentities.Operation{Action: authentities.ActionDestroy, Resource: authentities.ResourceSecret})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.getVersion(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	// Unlike whole secrets, versions are only destroyed when the underlying store supports it
+	err = c.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
+		derr := dbtx.PurgeVersion(ctx, id, version)
+		if derr != nil {
+			return derr
+		}
+
+		return c.store.DestroyVersion(ctx, id, version)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("secret version was permanently deleted")
+	return nil
+}
+
+// getVersion returns the given version of a secret, whether it is deleted or not
+func (c Connector) getVersion(ctx context.Context, id, version string) (*entities.Secret, error) {
+	secrets, err := c.db.GetAllVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		if secret.Metadata.Version == version {
+			return secret, nil
+		}
+	}
+
+	errMessage := "secret version not found"
+	c.logger.Error(errMessage, "id", id, "version", version)
+	return nil, errors.NotFoundError(errMessage)
+}
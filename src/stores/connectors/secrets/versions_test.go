@@ -0,0 +1,201 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecretVersions(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secretV1 := testutils2.FakeSecret()
+	secretV2 := testutils2.FakeSecret()
+	secretV2.ID = secretV1.ID
+	secretV2.Metadata.Version = "2"
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockSecrets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	t.Run("should list secret versions successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secretV1.ID).Return([]*storesentities.Secret{secretV1, secretV2}, nil)
+
+		secrets, err := connector.ListVersions(ctx, secretV1.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*storesentities.Secret{secretV1, secretV2}, secrets)
+	})
+
+	t.Run("should fail with NotFoundError if the secret has no version", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secretV1.ID).Return(nil, nil)
+
+		_, err := connector.ListVersions(ctx, secretV1.ID)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionRead, Resource: entities.ResourceSecret}).Return(expectedErr)
+
+		_, err := connector.ListVersions(ctx, secretV1.ID)
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
+
+func TestRestoreSecretVersion(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := testutils2.FakeSecret()
+	deletedSecret := testutils2.FakeSecret()
+	deletedSecret.ID = secret.ID
+	deletedSecret.Metadata.Version = "2"
+	deletedSecret.Metadata.DeletedAt = time.Now()
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockSecrets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Secrets) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should restore secret version successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret, deletedSecret}, nil)
+		db.EXPECT().RestoreVersion(gomock.Any(), secret.ID, "2").Return(nil)
+		store.EXPECT().RestoreVersion(gomock.Any(), secret.ID, "2").Return(nil)
+
+		err := connector.RestoreVersion(ctx, secret.ID, "2")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should be idempotent if secret version is not deleted", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret, deletedSecret}, nil)
+
+		err := connector.RestoreVersion(ctx, secret.ID, secret.Metadata.Version)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with NotFoundError if secret version does not exist", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret}, nil)
+
+		err := connector.RestoreVersion(ctx, secret.ID, "2")
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with NotSupportedError if store does not support restoring versions", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret, deletedSecret}, nil)
+		db.EXPECT().RestoreVersion(gomock.Any(), secret.ID, "2").Return(nil)
+		store.EXPECT().RestoreVersion(gomock.Any(), secret.ID, "2").Return(errors.NotSupportedError("error"))
+
+		err := connector.RestoreVersion(ctx, secret.ID, "2")
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDelete, Resource: entities.ResourceSecret}).Return(expectedErr)
+
+		err := connector.RestoreVersion(ctx, secret.ID, "2")
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
+
+func TestDestroySecretVersion(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := testutils2.FakeSecret()
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockSecretStore(ctrl)
+	db := mock2.NewMockSecrets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+
+	connector := NewConnector(store, db, auth, logger)
+
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Secrets) error) error {
+			return persist(db)
+		}).AnyTimes()
+
+	t.Run("should destroy secret version successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret}, nil)
+		db.EXPECT().PurgeVersion(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil)
+		store.EXPECT().DestroyVersion(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil)
+
+		err := connector.DestroyVersion(ctx, secret.ID, secret.Metadata.Version)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with NotSupportedError if store does not support destroying versions", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret}, nil)
+		db.EXPECT().PurgeVersion(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil)
+		store.EXPECT().DestroyVersion(gomock.Any(), secret.ID, secret.Metadata.Version).Return(errors.NotSupportedError("error"))
+
+		err := connector.DestroyVersion(ctx, secret.ID, secret.Metadata.Version)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+
+	t.Run("should fail with same error if db fails to purge", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceSecret}).Return(nil)
+		db.EXPECT().GetAllVersions(gomock.Any(), secret.ID).Return([]*storesentities.Secret{secret}, nil)
+		db.EXPECT().PurgeVersion(gomock.Any(), secret.ID, secret.Metadata.Version).Return(expectedErr)
+
+		err := connector.DestroyVersion(ctx, secret.ID, secret.Metadata.Version)
+
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&entities.Operation{Action: entities.ActionDestroy, Resource: entities.ResourceSecret}).Return(expectedErr)
+
+		err := connector.DestroyVersion(ctx, secret.ID, secret.Metadata.Version)
+
+		assert.Equal(t, err, expectedErr)
+	})
+}
@@ -0,0 +1,409 @@
+package stores
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/archive"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	eth "github.com/consensys/quorum-key-manager/src/stores/connectors/ethereum"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/keys"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/secrets"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Backup creates an archive of the items of a store along with their index, encrypted with a passphrase or for the
+// public key of a recipient. Only the latest version of the items is backed up and keys and Ethereum accounts are only
+// backed up from key stores whose private keys can be exported
+func (c *Connector) Backup(ctx context.Context, storeName string, encryption *entities.BackupEncryption, userInfo *authtypes.UserInfo) ([]byte, error) {
+	logger := c.logger.With("store_name", storeName)
+	logger.Debug("backing up store")
+
+	if (encryption.Passphrase == "") == (len(encryption.PublicKey) == 0) {
+		errMessage := "either a passphrase or a recipient public key must be provided"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	storeInfo, resolver, err := c.getBackupStore(ctx, storeName, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := &entities.Backup{StoreType: storeInfo.StoreType}
+	switch storeInfo.StoreType {
+	case entities.SecretStoreType:
+		backup.Secrets, err = c.backupSecrets(ctx, storeInfo, resolver)
+	case entities.KeyStoreType:
+		backup.Keys, err = c.backupKeys(ctx, storeInfo, resolver, logger)
+	case entities.EthereumStoreType:
+		backup.ETHAccounts, err = c.backupEthAccounts(ctx, storeInfo, resolver, logger)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := json.Marshal(backup)
+	if err != nil {
+		errMessage := "failed to encode backup"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.EncodingError(errMessage)
+	}
+
+	var data []byte
+	if encryption.Passphrase != "" {
+		data, err = archive.SealWithPassphrase(plaintext, encryption.Passphrase)
+	} else {
+		data, err = archive.SealForRecipient(plaintext, encryption.PublicKey)
+	}
+	if err != nil {
+		errMessage := "failed to encrypt backup"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError("%s. %s", errMessage, err.Error())
+	}
+
+	// The archive is only returned once the backup is recorded in the audit trail
+	record := &entities.BackupRecord{
+		StoreName: storeName,
+		StoreType: storeInfo.StoreType,
+		Tenant:    userInfo.Tenant,
+		Username:  userInfo.Username,
+		AuthMode:  userInfo.AuthMode,
+		Items:     len(backup.Secrets) + len(backup.Keys) + len(backup.ETHAccounts),
+		CreatedAt: time.Now().UTC(),
+	}
+	if len(encryption.PublicKey) > 0 {
+		record.Recipient = hexutil.Encode(encryption.PublicKey)
+	}
+
+	err = c.db.Backups().Add(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("store backed up successfully", "items", record.Items, "tenant", record.Tenant, "username", record.Username, "recipient", record.Recipient)
+	return data, nil
+}
+
+// RestoreBackup restores the items of an archive into an empty store of the same type and returns the result of the
+// restoration of each item
+func (c *Connector) RestoreBackup(ctx context.Context, storeName string, data []byte, decryption *entities.BackupDecryption, userInfo *authtypes.UserInfo) ([]*entities.MigratedItem, error) {
+	logger := c.logger.With("store_name", storeName)
+	logger.Debug("restoring store backup")
+
+	if (decryption.Passphrase == "") == (len(decryption.PrivateKey) == 0) {
+		errMessage := "either a passphrase or a recipient private key must be provided"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	storeInfo, resolver, err := c.getBackupStore(ctx, storeName, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var plaintext []byte
+	if decryption.Passphrase != "" {
+		plaintext, err = archive.OpenWithPassphrase(data, decryption.Passphrase)
+	} else {
+		plaintext, err = archive.OpenAsRecipient(data, decryption.PrivateKey)
+	}
+	if err != nil {
+		errMessage := "failed to decrypt backup"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError("%s. %s", errMessage, err.Error())
+	}
+
+	backup := &entities.Backup{}
+	err = json.Unmarshal(plaintext, backup)
+	if err != nil {
+		errMessage := "failed to decode backup"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	if backup.StoreType != storeInfo.StoreType {
+		errMessage := "backup is not of the same type as the store"
+		logger.Error(errMessage, "backup_type", backup.StoreType)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	var items []*entities.MigratedItem
+	switch storeInfo.StoreType {
+	case entities.SecretStoreType:
+		items, err = c.restoreSecrets(ctx, storeInfo, resolver, backup.Secrets, logger)
+	case entities.KeyStoreType:
+		items, err = c.restoreKeys(ctx, storeInfo, resolver, backup.Keys, logger)
+	case entities.EthereumStoreType:
+		items, err = c.restoreEthAccounts(ctx, storeInfo, resolver, backup.ETHAccounts, logger)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("store backup restored successfully", "items", len(items))
+	return items, nil
+}
+
+func (c *Connector) getBackupStore(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (*entities.Store, auth.Authorizator, error) {
+	permissions := c.roles.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(ctx, permissions, userInfo.Tenant, c.logger)
+
+	// Backups export the private material of the store, the permission must be granted explicitly and not through a
+	// wildcard or a conditional wildcard rule
+	if !explicitlyGranted(permissions, authtypes.BackupStore) {
+		errMessage := "backups require the permission to be granted explicitly"
+		c.logger.With("permission", authtypes.BackupStore).Error(errMessage)
+		return nil, nil, errors.ForbiddenError(errMessage)
+	}
+
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionBackup, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storeInfo, err := c.getStore(ctx, storeName, resolver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return storeInfo, resolver, nil
+}
+
+// explicitlyGranted indicates whether the permission is held as such or through a conditional permission on it
+func explicitlyGranted(permissions []authtypes.Permission, permission authtypes.Permission) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+
+		if !authtypes.IsPermissionRule(p) {
+			continue
+		}
+
+		rule, err := authtypes.ParsePermissionRule(p)
+		if err == nil && !rule.Deny && rule.Permission() == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Connector) backupSecrets(ctx context.Context, storeInfo *entities.Store, resolver auth.Authorizator) ([]*entities.Secret, error) {
+	src := secrets.NewConnector(storeInfo.Store.(stores.SecretStore), c.db.Secrets(storeInfo.Name), resolver, c.logger)
+
+	ids, err := src.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*entities.Secret{}
+	for _, id := range ids {
+		secret, err := src.Get(ctx, id, "")
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, secret)
+	}
+
+	return items, nil
+}
+
+func (c *Connector) backupKeys(ctx context.Context, storeInfo *entities.Store, resolver auth.Authorizator, logger log.Logger) ([]*entities.BackedUpKey, error) {
+	keyStore := storeInfo.Store.(stores.KeyStore)
+	src := keys.NewConnector(keyStore, c.db.Keys(storeInfo.Name), resolver, c.logger)
+
+	ids, err := src.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*entities.BackedUpKey{}
+	for _, id := range ids {
+		key, err := src.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		privKey, err := exportPrivateKey(ctx, keyStore, id, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &entities.BackedUpKey{Key: key, PrivateKey: privKey})
+	}
+
+	return items, nil
+}
+
+func (c *Connector) backupEthAccounts(ctx context.Context, storeInfo *entities.Store, resolver auth.Authorizator, logger log.Logger) ([]*entities.BackedUpETHAccount, error) {
+	keyStore := storeInfo.Store.(stores.KeyStore)
+	src := eth.NewConnector(keyStore, c.db.ETHAccounts(storeInfo.Name), resolver, c.logger)
+
+	addresses, err := src.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []*entities.BackedUpETHAccount{}
+	for _, address := range addresses {
+		acc, err := src.Get(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		privKey, err := exportPrivateKey(ctx, keyStore, acc.KeyID, logger)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &entities.BackedUpETHAccount{ETHAccount: acc, PrivateKey: privKey})
+	}
+
+	return items, nil
+}
+
+// exportPrivateKey exports a private key from the underlying key store, backing up stores is already authorized
+func exportPrivateKey(ctx context.Context, keyStore stores.KeyStore, id string, logger log.Logger) ([]byte, error) {
+	privKey, err := keyStore.Export(ctx, id)
+	if err != nil && errors.IsNotSupportedError(err) {
+		errMessage := "items of the store cannot be exported"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return privKey, nil
+}
+
+func (c *Connector) restoreSecrets(
+	ctx context.Context,
+	storeInfo *entities.Store,
+	resolver auth.Authorizator,
+	backedUpSecrets []*entities.Secret,
+	logger log.Logger,
+) ([]*entities.MigratedItem, error) {
+	dest := secrets.NewConnector(storeInfo.Store.(stores.SecretStore), c.db.Secrets(storeInfo.Name), resolver, c.logger)
+
+	ids, err := dest.List(ctx, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkEmptyStore(len(ids), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entities.MigratedItem
+	for _, secret := range backedUpSecrets {
+		attr := &entities.Attributes{Tags: secret.Tags, ValueType: secret.ValueType}
+		if secret.Metadata.RecoveryPeriod > 0 {
+			attr.Recovery = &entities.Recovery{Period: secret.Metadata.RecoveryPeriod}
+		}
+
+		items = append(items, restoreItem(secret.ID, logger, func() error {
+			_, err := dest.Set(ctx, secret.ID, secret.Value, attr)
+			return err
+		}))
+	}
+
+	return items, nil
+}
+
+func (c *Connector) restoreKeys(
+	ctx context.Context,
+	storeInfo *entities.Store,
+	resolver auth.Authorizator,
+	backedUpKeys []*entities.BackedUpKey,
+	logger log.Logger,
+) ([]*entities.MigratedItem, error) {
+	dest := keys.NewConnector(storeInfo.Store.(stores.KeyStore), c.db.Keys(storeInfo.Name), resolver, c.logger)
+
+	ids, err := dest.List(ctx, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkEmptyStore(len(ids), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entities.MigratedItem
+	for _, key := range backedUpKeys {
+		items = append(items, restoreItem(key.ID, logger, func() error {
+			return importKey(ctx, dest, key.Key, key.PrivateKey)
+		}))
+	}
+
+	return items, nil
+}
+
+func (c *Connector) restoreEthAccounts(
+	ctx context.Context,
+	storeInfo *entities.Store,
+	resolver auth.Authorizator,
+	backedUpAccounts []*entities.BackedUpETHAccount,
+	logger log.Logger,
+) ([]*entities.MigratedItem, error) {
+	dest := eth.NewConnector(storeInfo.Store.(stores.KeyStore), c.db.ETHAccounts(storeInfo.Name), resolver, c.logger)
+
+	addresses, err := dest.List(ctx, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkEmptyStore(len(addresses), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entities.MigratedItem
+	for _, acc := range backedUpAccounts {
+		items = append(items, restoreItem(acc.Address.Hex(), logger, func() error {
+			return importETHAccount(ctx, dest, acc.ETHAccount, acc.PrivateKey)
+		}))
+	}
+
+	return items, nil
+}
+
+// checkEmptyStore fails if the store holds items, backups are only restored into empty stores
+func checkEmptyStore(nbItems int, logger log.Logger) error {
+	if nbItems > 0 {
+		errMessage := "store must be empty to restore a backup"
+		logger.Error(errMessage)
+		return errors.StatusConflictError(errMessage)
+	}
+
+	return nil
+}
+
+func restoreItem(id string, logger log.Logger, restore func() error) *entities.MigratedItem {
+	logger = logger.With("id", id)
+	item := &entities.MigratedItem{ID: id}
+
+	err := restore()
+	if err != nil {
+		logger.WithError(err).Error("failed to restore item")
+		item.Status = entities.MigrationStatusFailed
+		item.Error = errors.FromError(err).GetMessage()
+		return item
+	}
+
+	logger.Info("item restored")
+	item.Status = entities.MigrationStatusRestored
+	return item
+}
@@ -0,0 +1,212 @@
+package stores
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	keysDB := mock2.NewMockKeys(ctrl)
+	destKeysDB := mock2.NewMockKeys(ctrl)
+	secretsDB := mock2.NewMockSecrets(ctrl)
+	backupsDB := mock2.NewMockBackups(ctrl)
+	keyStore := mock5.NewMockKeyStore(ctrl)
+	destKeyStore := mock5.NewMockKeyStore(ctrl)
+	secretStore := mock5.NewMockSecretStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock3.NewMockRoles(ctrl)
+	userInfo := &authtypes.UserInfo{AuthMode: "jwt", Tenant: "tenant", Username: "alice"}
+
+	connector := NewConnector(roles, db, mock4.NewMockVaults(ctrl), logger)
+	connector.createStore("key-store", storesentities.KeyStoreType, keyStore, nil, 0)
+	connector.createStore("dest-key-store", storesentities.KeyStoreType, destKeyStore, nil, 0)
+	connector.createStore("secret-store", storesentities.SecretStoreType, secretStore, nil, 0)
+
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(append(authtypes.ListPermissions(), authtypes.BackupStore)).AnyTimes()
+	db.EXPECT().Keys("key-store").Return(keysDB).AnyTimes()
+	db.EXPECT().Keys("dest-key-store").Return(destKeysDB).AnyTimes()
+	db.EXPECT().Secrets("secret-store").Return(secretsDB).AnyTimes()
+	db.EXPECT().Backups().Return(backupsDB).AnyTimes()
+
+	key := testutils2.FakeKey()
+	privKey := []byte("private-key")
+	recipientKey, _ := crypto.GenerateKey()
+
+	backupKeys := func(t *testing.T, encryption *storesentities.BackupEncryption) []byte {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+		backupsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+
+		archive, err := connector.Backup(ctx, "key-store", encryption, userInfo)
+		require.NoError(t, err)
+		assert.NotContains(t, string(archive), key.ID)
+
+		return archive
+	}
+
+	t.Run("should back up the keys and restore them into an empty store with a passphrase", func(t *testing.T) {
+		archive := backupKeys(t, &storesentities.BackupEncryption{Passphrase: "my-passphrase"})
+
+		destKeysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(1), uint64(0)).Return([]string{}, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, &storesentities.Attributes{Tags: key.Tags}).Return(key, nil)
+		destKeysDB.EXPECT().Add(gomock.Any(), key).Return(key, nil)
+
+		items, err := connector.RestoreBackup(ctx, "dest-key-store", archive, &storesentities.BackupDecryption{Passphrase: "my-passphrase"}, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, key.ID, items[0].ID)
+		assert.Equal(t, storesentities.MigrationStatusRestored, items[0].Status)
+	})
+
+	t.Run("should back up the keys and restore them into an empty store with the private key of the recipient", func(t *testing.T) {
+		archive := backupKeys(t, &storesentities.BackupEncryption{PublicKey: crypto.FromECDSAPub(&recipientKey.PublicKey)})
+
+		destKeysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(1), uint64(0)).Return([]string{}, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, gomock.Any()).Return(nil, errors.DependencyFailureError("error"))
+
+		items, err := connector.RestoreBackup(ctx, "dest-key-store", archive, &storesentities.BackupDecryption{PrivateKey: crypto.FromECDSA(recipientKey)}, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, storesentities.MigrationStatusFailed, items[0].Status)
+		assert.Equal(t, "error", items[0].Error)
+	})
+
+	t.Run("should fail with InvalidParameterError if the passphrase is wrong", func(t *testing.T) {
+		archive := backupKeys(t, &storesentities.BackupEncryption{Passphrase: "my-passphrase"})
+
+		_, err := connector.RestoreBackup(ctx, "dest-key-store", archive, &storesentities.BackupDecryption{Passphrase: "wrong-passphrase"}, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with StatusConflictError if the store is not empty", func(t *testing.T) {
+		archive := backupKeys(t, &storesentities.BackupEncryption{Passphrase: "my-passphrase"})
+
+		destKeysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(1), uint64(0)).Return([]string{"my-key"}, nil)
+
+		_, err := connector.RestoreBackup(ctx, "dest-key-store", archive, &storesentities.BackupDecryption{Passphrase: "my-passphrase"}, userInfo)
+
+		assert.True(t, errors.IsStatusConflictError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the backup is of another store type", func(t *testing.T) {
+		archive := backupKeys(t, &storesentities.BackupEncryption{Passphrase: "my-passphrase"})
+
+		_, err := connector.RestoreBackup(ctx, "secret-store", archive, &storesentities.BackupDecryption{Passphrase: "my-passphrase"}, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should back up the secrets with their value", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		secretsDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{secret.ID}, nil)
+		secretsDB.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
+		secretsDB.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(&storesentities.Secret{ID: secret.ID, Tags: secret.Tags, Metadata: secret.Metadata}, nil)
+		secretStore.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
+		backupsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+
+		archive, err := connector.Backup(ctx, "secret-store", &storesentities.BackupEncryption{Passphrase: "my-passphrase"}, userInfo)
+		require.NoError(t, err)
+
+		secretsDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(1), uint64(0)).Return([]string{}, nil)
+		secretStore.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, &storesentities.Attributes{Tags: secret.Tags}).Return(secret, nil)
+		secretsDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		items, err := connector.RestoreBackup(ctx, "secret-store", archive, &storesentities.BackupDecryption{Passphrase: "my-passphrase"}, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, storesentities.MigrationStatusRestored, items[0].Status)
+	})
+
+	t.Run("should fail with NotSupportedError if the keys of the store cannot be exported", func(t *testing.T) {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(nil, errors.NotSupportedError("error"))
+
+		_, err := connector.Backup(ctx, "key-store", &storesentities.BackupEncryption{Passphrase: "my-passphrase"}, userInfo)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if neither a passphrase nor a public key is provided", func(t *testing.T) {
+		_, err := connector.Backup(ctx, "key-store", &storesentities.BackupEncryption{}, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if the user is not allowed to back up stores", func(t *testing.T) {
+		unauthorizedUser := &authtypes.UserInfo{Tenant: "tenant", Username: "unauthorized"}
+		roles.EXPECT().UserPermissions(gomock.Any(), unauthorizedUser).Return([]authtypes.Permission{authtypes.ReadKey, authtypes.WriteKey})
+
+		_, err := connector.Backup(ctx, "key-store", &storesentities.BackupEncryption{Passphrase: "my-passphrase"}, unauthorizedUser)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should record the backup in the audit trail", func(t *testing.T) {
+		publicKey := crypto.FromECDSAPub(&recipientKey.PublicKey)
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+		backupsDB.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, record *storesentities.BackupRecord) error {
+			assert.Equal(t, "key-store", record.StoreName)
+			assert.Equal(t, storesentities.KeyStoreType, record.StoreType)
+			assert.Equal(t, "tenant", record.Tenant)
+			assert.Equal(t, "alice", record.Username)
+			assert.Equal(t, "jwt", record.AuthMode)
+			assert.Equal(t, hexutil.Encode(publicKey), record.Recipient)
+			assert.Equal(t, 1, record.Items)
+			assert.False(t, record.CreatedAt.IsZero())
+			return nil
+		})
+
+		_, err := connector.Backup(ctx, "key-store", &storesentities.BackupEncryption{PublicKey: publicKey}, userInfo)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should not return the archive if the backup cannot be recorded", func(t *testing.T) {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+		backupsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(errors.PostgresError("error"))
+
+		archive, err := connector.Backup(ctx, "key-store", &storesentities.BackupEncryption{Passphrase: "my-passphrase"}, userInfo)
+
+		assert.Nil(t, archive)
+		assert.True(t, errors.IsPostgresError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if the backup permission is only granted by a wildcard", func(t *testing.T) {
+		wildcardUser := &authtypes.UserInfo{Tenant: "tenant", Username: "wildcard"}
+		roles.EXPECT().UserPermissions(gomock.Any(), wildcardUser).Return([]authtypes.Permission{"*:*", "backup:*?auth_mode=jwt"})
+
+		_, err := connector.Backup(ctx, "key-store", &storesentities.BackupEncryption{Passphrase: "my-passphrase"}, wildcardUser)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
@@ -2,6 +2,8 @@ package stores
 
 import (
 	"context"
+	"crypto/cipher"
+	"time"
 
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
@@ -21,7 +23,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 )
 
-func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore string, allowedTenants []string, userInfo *authtypes.UserInfo) error {
+func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore string, replicaRegions, allowedTenants []string, recoveryPeriod time.Duration, masterKey, previousMasterKey *entities2.MasterKeyConfig, userInfo *authtypes.UserInfo) error {
 	logger := c.logger.With("name", name, "vault", vaultName, "secret_store", secretStore)
 	logger.Debug("creating key store")
 
@@ -31,9 +33,15 @@ func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore
 		return errors.InvalidParameterError(errMessage)
 	}
 
+	if masterKey != nil && secretStore == "" {
+		errMessage := "master keys are only supported by local key stores"
+		logger.Error(errMessage)
+		return errors.InvalidParameterError(errMessage)
+	}
+
 	// TODO: Uncomment when authManager no longer a runnable
 	// permissions := c.authManager.UserPermissions(userInfo)
-	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, userInfo.Permissions, userInfo.Tenant, c.logger)
 
 	// If vault is specified, it is a remote key store, otherwise it's a local key store
 	var store stores.KeyStore
@@ -44,13 +52,19 @@ func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore
 			return err
 		}
 
+		if len(replicaRegions) > 0 && vault.VaultType != entities2.AWSVaultType {
+			errMessage := "replica regions are only supported by AWS key stores"
+			logger.Error(errMessage)
+			return errors.InvalidParameterError(errMessage)
+		}
+
 		switch vault.VaultType {
 		case entities2.HashicorpVaultType:
 			store, err = hashicorp.New(vault.Client.(hashicorpinfra.PluginClient), logger), nil
 		case entities2.AzureVaultType:
 			store, err = akv.New(vault.Client.(akvinfra.KeysClient), logger), nil
 		case entities2.AWSVaultType:
-			store, err = aws.New(vault.Client.(awsinfra.KmsClient), logger), nil
+			store, err = aws.New(vault.Client.(awsinfra.KmsClient), replicaRegions, logger), nil
 		default:
 			errMessage := "invalid vault for key store"
 			logger.Error(errMessage)
@@ -65,15 +79,29 @@ func (c *Connector) CreateKey(ctx context.Context, name, vaultName, secretStore
 			return err
 		}
 
-		store = localkeys.New(secretstore, c.db.Secrets(secretStore), c.logger)
+		// Without master key, private keys are stored unencrypted in the secret store
+		var aead cipher.AEAD
+		if masterKey != nil {
+			aead, err = c.vaults.DataEncryptionKey(ctx, keyStoreDataEncryptionKey(name), masterKey, previousMasterKey, userInfo)
+			if err != nil {
+				return err
+			}
+		}
+
+		store = localkeys.New(secretstore, c.db.Secrets(secretStore), aead, c.logger)
 	default:
 		errMessage := "either vault or secret store must be specified. Please choose one option"
 		logger.Error(errMessage)
 		return errors.InvalidParameterError(errMessage)
 	}
 
-	c.createStore(name, entities.KeyStoreType, store, allowedTenants)
+	c.createStore(name, entities.KeyStoreType, store, allowedTenants, recoveryPeriod)
 
 	logger.Info("key store created successfully")
 	return nil
 }
+
+// keyStoreDataEncryptionKey names the data encryption key of a local key store apart from the ones of the postgres vaults
+func keyStoreDataEncryptionKey(storeName string) string {
+	return "key-stores/" + storeName
+}
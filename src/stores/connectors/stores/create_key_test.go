@@ -0,0 +1,69 @@
+package stores
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLocalKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	vaults := mock4.NewMockVaults(ctrl)
+	userInfo := authtypes.NewWildcardUser()
+
+	connector := NewConnector(mock3.NewMockRoles(ctrl), db, vaults, logger)
+	connector.createStore("secret-store", storesentities.SecretStoreType, mock5.NewMockSecretStore(ctrl), nil, 0)
+
+	masterKey := &entities2.MasterKeyConfig{Env: "QKM_KEY_STORE_MASTER_KEY"}
+	previousMasterKey := &entities2.MasterKeyConfig{Env: "QKM_KEY_STORE_PREVIOUS_MASTER_KEY"}
+
+	t.Run("should create a local key store encrypting its private keys successfully", func(t *testing.T) {
+		dek, _ := aes.NewKey()
+		aead, err := aes.NewGCM(dek)
+		require.NoError(t, err)
+
+		db.EXPECT().Secrets("secret-store").Return(mock2.NewMockSecrets(ctrl))
+		vaults.EXPECT().DataEncryptionKey(gomock.Any(), "key-stores/key-store", masterKey, previousMasterKey, userInfo).Return(aead, nil)
+
+		err = connector.CreateKey(ctx, "key-store", "", "secret-store", nil, nil, 0, masterKey, previousMasterKey, userInfo)
+		require.NoError(t, err)
+
+		require.Contains(t, connector.stores, "key-store")
+		assert.Equal(t, storesentities.KeyStoreType, connector.stores["key-store"].StoreType)
+	})
+
+	t.Run("should fail with InvalidParameterError if a master key is given to a remote key store", func(t *testing.T) {
+		err := connector.CreateKey(ctx, "key-store", "akv-vault", "", nil, nil, 0, masterKey, nil, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if the data encryption key cannot be loaded", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		vaults.EXPECT().DataEncryptionKey(gomock.Any(), "key-stores/other-key-store", masterKey, nil, userInfo).Return(nil, expectedErr)
+
+		err := connector.CreateKey(ctx, "other-key-store", "", "secret-store", nil, nil, 0, masterKey, nil, userInfo)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -2,6 +2,8 @@ package stores
 
 import (
 	"context"
+	"crypto/cipher"
+	"time"
 
 	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
@@ -12,13 +14,14 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/akv"
 	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/aws"
 	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/stores/store/secrets/postgres"
 
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 )
 
-func (c *Connector) CreateSecret(ctx context.Context, name, vaultName string, allowedTenants []string, userInfo *auth.UserInfo) error {
+func (c *Connector) CreateSecret(ctx context.Context, name, vaultName, pathPrefix string, allowedTenants []string, recoveryPeriod time.Duration, userInfo *auth.UserInfo) error {
 	logger := c.logger.With("name", name, "vault", vaultName)
 	logger.Debug("creating secret store")
 
@@ -30,11 +33,24 @@ func (c *Connector) CreateSecret(ctx context.Context, name, vaultName string, al
 	var store stores.SecretStore
 	switch vault.VaultType {
 	case entities2.HashicorpVaultType:
-		store, err = hashicorp.New(vault.Client.(hashicorpinfra.Kvv2Client), c.db.Secrets(name), logger), nil
+		client := vault.Client.(hashicorpinfra.Client)
+		if client.KVVersion() == 1 {
+			store, err = hashicorp.NewKvv1(client, pathPrefix, logger), nil
+		} else {
+			store, err = hashicorp.New(client, c.db.Secrets(name), pathPrefix, logger), nil
+		}
 	case entities2.AzureVaultType:
+		if vault.Client.(akvinfra.Client).IsManagedHSM() {
+			errMessage := "managed HSM vaults do not support secrets"
+			logger.Error(errMessage)
+			return errors.InvalidParameterError(errMessage)
+		}
+
 		store, err = akv.New(vault.Client.(akvinfra.SecretClient), logger), nil
 	case entities2.AWSVaultType:
 		store, err = aws.New(vault.Client.(awsinfra.SecretsManagerClient), logger), nil
+	case entities2.PostgresVaultType:
+		store, err = postgres.New(vault.Client.(cipher.AEAD), c.db.EncryptedSecrets(vault.Name), logger), nil
 	default:
 		errMessage := "invalid vault for secret store"
 		logger.Error(errMessage)
@@ -44,7 +60,7 @@ func (c *Connector) CreateSecret(ctx context.Context, name, vaultName string, al
 		return err
 	}
 
-	c.createStore(name, entities.SecretStoreType, store, allowedTenants)
+	c.createStore(name, entities.SecretStoreType, store, allowedTenants, recoveryPeriod)
 
 	logger.Info("secret store created successfully")
 	return nil
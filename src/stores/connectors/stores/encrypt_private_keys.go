@@ -0,0 +1,48 @@
+package stores
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// privateKeysEncrypter is implemented by the key stores encrypting the private keys they keep in a secret store
+type privateKeysEncrypter interface {
+	Encrypted() bool
+	EncryptPrivateKeys(ctx context.Context) (int, error)
+}
+
+// EncryptPrivateKeys encrypts the private keys stored before a master key was configured on the local key stores
+// defining one, it returns the number of private keys encrypted
+func (c *Connector) EncryptPrivateKeys(ctx context.Context) (int, error) {
+	c.mux.RLock()
+	encrypters := make(map[string]privateKeysEncrypter)
+	for name, store := range c.stores {
+		if store.StoreType != entities.KeyStoreType {
+			continue
+		}
+
+		if encrypter, ok := store.Store.(privateKeysEncrypter); ok && encrypter.Encrypted() {
+			encrypters[name] = encrypter
+		}
+	}
+	c.mux.RUnlock()
+
+	var nEncrypted int
+	var lastErr error
+	for name, encrypter := range encrypters {
+		logger := c.logger.With("store_name", name)
+
+		n, err := encrypter.EncryptPrivateKeys(ctx)
+		nEncrypted += n
+		if err != nil {
+			logger.WithError(err).Error("failed to encrypt private keys", "n_encrypted", n)
+			lastErr = err
+			continue
+		}
+
+		logger.Info("private keys encrypted successfully", "n_encrypted", n)
+	}
+
+	return nEncrypted, lastErr
+}
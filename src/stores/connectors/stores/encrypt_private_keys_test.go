@@ -0,0 +1,56 @@
+package stores
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	localkeys "github.com/consensys/quorum-key-manager/src/stores/store/keys/local"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptPrivateKeys(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	secretsDB := mock2.NewMockSecrets(ctrl)
+	secretStore := mock5.NewMockSecretStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	dek, _ := aes.NewKey()
+	aead, err := aes.NewGCM(dek)
+	require.NoError(t, err)
+
+	connector := NewConnector(mock3.NewMockRoles(ctrl), db, mock4.NewMockVaults(ctrl), logger)
+	connector.createStore("encrypted-store", storesentities.KeyStoreType, localkeys.New(secretStore, secretsDB, aead, logger), nil, 0)
+	// Neither the key stores without master key nor the key stores of vaults are affected
+	connector.createStore("plain-store", storesentities.KeyStoreType, localkeys.New(mock5.NewMockSecretStore(ctrl), mock2.NewMockSecrets(ctrl), nil, logger), nil, 0)
+	connector.createStore("vault-store", storesentities.KeyStoreType, mock5.NewMockKeyStore(ctrl), nil, 0)
+
+	t.Run("should encrypt the private keys of the local key stores with a master key", func(t *testing.T) {
+		secret := testutils2.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString([]byte("private-key"))
+
+		secretsDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{secret.ID}, nil)
+		secretStore.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
+		secretStore.EXPECT().Set(gomock.Any(), secret.ID, gomock.Any(), gomock.Any()).Return(secret, nil)
+		secretsDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		n, err := connector.EncryptPrivateKeys(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+}
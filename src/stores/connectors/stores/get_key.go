@@ -14,9 +14,9 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores"
 )
 
-func (c *Connector) Key(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.KeyStore, error) {
+func (c *Connector) Key(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.IndexedKeyStore, error) {
 	permissions := c.roles.UserPermissions(ctx, userInfo)
-	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, permissions, userInfo.Tenant, c.logger)
 
 	store, err := c.getKeyStore(ctx, storeName, resolver)
 	if err != nil {
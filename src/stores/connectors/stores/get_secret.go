@@ -13,9 +13,9 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/secrets"
 )
 
-func (c *Connector) Secret(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.SecretStore, error) {
+func (c *Connector) Secret(ctx context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.IndexedSecretStore, error) {
 	permissions := c.roles.UserPermissions(ctx, userInfo)
-	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, permissions, userInfo.Tenant, c.logger)
 
 	store, err := c.getSecretStore(ctx, storeName, resolver)
 	if err != nil {
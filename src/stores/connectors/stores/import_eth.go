@@ -17,7 +17,7 @@ func (c *Connector) ImportEthereum(ctx context.Context, storeName string, userIn
 
 	// TODO: Uncomment when authManager no longer a runnable
 	// permissions := c.authManager.UserPermissions(userInfo)
-	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, userInfo.Permissions, userInfo.Tenant, c.logger)
 
 	store, err := c.getEthStore(ctx, storeName, resolver)
 	if err != nil {
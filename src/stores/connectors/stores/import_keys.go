@@ -15,7 +15,7 @@ func (c *Connector) ImportKeys(ctx context.Context, storeName string, userInfo *
 
 	// TODO: Uncomment when authManager no longer a runnable
 	// permissions := c.authManager.UserPermissions(userInfo)
-	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, userInfo.Permissions, userInfo.Tenant, c.logger)
 
 	store, err := c.getKeyStore(ctx, storeName, resolver)
 	if err != nil {
@@ -15,7 +15,7 @@ func (c *Connector) ImportSecrets(ctx context.Context, storeName string, userInf
 
 	// TODO: Uncomment when authManager no longer a runnable
 	// permissions := c.authManager.UserPermissions(userInfo)
-	resolver := authorizator.New(userInfo.Permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, userInfo.Permissions, userInfo.Tenant, c.logger)
 
 	store, err := c.getSecretStore(ctx, storeName, resolver)
 	if err != nil {
@@ -2,8 +2,10 @@ package stores
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 
 	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
@@ -21,7 +23,7 @@ func (c *Connector) List(ctx context.Context, storeType string, userInfo *authty
 		}
 
 		permissions := c.roles.UserPermissions(ctx, userInfo)
-		resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
+		resolver := authorizator.New(ctx, permissions, userInfo.Tenant, c.logger)
 
 		if err := resolver.CheckAccess(storeInfo.AllowedTenants); err != nil {
 			continue
@@ -33,6 +35,9 @@ func (c *Connector) List(ctx context.Context, storeType string, userInfo *authty
 	return storeNames, nil
 }
 
+// accountsPageSize is the number of accounts queried at once when listing all the accounts of a store
+const accountsPageSize = 1000
+
 func (c *Connector) ListAllAccounts(ctx context.Context, userInfo *authtypes.UserInfo) ([]common.Address, error) {
 	c.mux.RLock()
 	defer c.mux.RUnlock()
@@ -49,11 +54,28 @@ func (c *Connector) ListAllAccounts(ctx context.Context, userInfo *authtypes.Use
 			return nil, err
 		}
 
-		storeAccs, err := store.List(ctx, 0, 0)
-		if err != nil {
-			return nil, err
+		// Accounts are listed page by page with a cursor to keep the order stable while accounts are added
+		filter := &entities.SearchFilter{Limit: accountsPageSize}
+		for {
+			storeAccs, err := store.Search(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, acc := range storeAccs {
+				accs = append(accs, acc.Address)
+			}
+
+			if len(storeAccs) < accountsPageSize {
+				break
+			}
+
+			last := storeAccs[len(storeAccs)-1]
+			filter.Cursor = &entities2.Cursor{
+				Value: last.Metadata.CreatedAt.Format(time.RFC3339Nano),
+				ID:    last.Address.Hex(),
+			}
 		}
-		accs = append(accs, storeAccs...)
 	}
 
 	return accs, nil
@@ -0,0 +1,64 @@
+package stores
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAllAccounts(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	ethDB := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock3.NewMockRoles(ctrl)
+	vaults := mock4.NewMockVaults(ctrl)
+
+	connector := NewConnector(roles, db, vaults, logger)
+	connector.createStore("eth-store", storesentities.EthereumStoreType, mock5.NewMockKeyStore(ctrl), nil, 0)
+
+	userInfo := entities.NewWildcardUser()
+
+	t.Run("should list all accounts page by page successfully", func(t *testing.T) {
+		firstPage := make([]*storesentities.ETHAccount, accountsPageSize)
+		for i := range firstPage {
+			firstPage[i] = testutils2.FakeETHAccount()
+		}
+		last := firstPage[accountsPageSize-1]
+		lastAcc := testutils2.FakeETHAccount()
+		lastAcc.Address = common.HexToAddress("0xfe3b557e8fb62b89f4916b721be55ceb828dbd73")
+
+		roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(entities.ListPermissions()).AnyTimes()
+		db.EXPECT().ETHAccounts("eth-store").Return(ethDB)
+		ethDB.EXPECT().Search(gomock.Any(), &storesentities.SearchFilter{Limit: accountsPageSize}).Return(firstPage, nil)
+		ethDB.EXPECT().Search(gomock.Any(), &storesentities.SearchFilter{
+			Limit: accountsPageSize,
+			Cursor: &entities2.Cursor{
+				Value: last.Metadata.CreatedAt.Format(time.RFC3339Nano),
+				ID:    last.Address.Hex(),
+			},
+		}).Return([]*storesentities.ETHAccount{lastAcc}, nil)
+
+		accounts, err := connector.ListAllAccounts(ctx, userInfo)
+
+		assert.NoError(t, err)
+		assert.Len(t, accounts, accountsPageSize+1)
+		assert.Equal(t, lastAcc.Address, accounts[accountsPageSize])
+	})
+}
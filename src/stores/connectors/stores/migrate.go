@@ -0,0 +1,356 @@
+package stores
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	eth "github.com/consensys/quorum-key-manager/src/stores/connectors/ethereum"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/keys"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/secrets"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// Migrate copies the items of a store into another store of the same type, preserving their IDs, tags, addresses and
+// whether they are disabled or expire.
+// Only the latest version of the items is copied and items already existing in the destination store are skipped.
+// Keys and Ethereum accounts are only migrated from key stores whose private keys can be exported.
+// In dry run mode, the items are returned without being copied
+func (c *Connector) Migrate(ctx context.Context, storeName, destStoreName string, dryRun, deleteSource bool, userInfo *authtypes.UserInfo) ([]*entities.MigratedItem, error) {
+	logger := c.logger.With("store_name", storeName, "destination", destStoreName, "dry_run", dryRun)
+	logger.Debug("migrating store")
+
+	permissions := c.roles.UserPermissions(ctx, userInfo)
+	resolver := authorizator.New(ctx, permissions, userInfo.Tenant, c.logger)
+
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionMigrate, Resource: authtypes.ResourceStore})
+	if err != nil {
+		return nil, err
+	}
+
+	if storeName == destStoreName {
+		errMessage := "source and destination stores must be different"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	storeInfo, err := c.getStore(ctx, storeName, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	destStoreInfo, err := c.getStore(ctx, destStoreName, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	if storeInfo.StoreType != destStoreInfo.StoreType {
+		errMessage := "source and destination stores must be of the same type"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	var items []*entities.MigratedItem
+	switch storeInfo.StoreType {
+	case entities.SecretStoreType:
+		items, err = c.migrateSecrets(ctx, storeInfo, destStoreInfo, resolver, dryRun, deleteSource, logger)
+	case entities.KeyStoreType:
+		items, err = c.migrateKeys(ctx, storeInfo, destStoreInfo, resolver, dryRun, deleteSource, logger)
+	case entities.EthereumStoreType:
+		items, err = c.migrateEthAccounts(ctx, storeInfo, destStoreInfo, resolver, dryRun, deleteSource, logger)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("store migrated successfully", "items", len(items))
+	return items, nil
+}
+
+func (c *Connector) migrateSecrets(
+	ctx context.Context,
+	storeInfo, destStoreInfo *entities.Store,
+	resolver auth.Authorizator,
+	dryRun, deleteSource bool,
+	logger log.Logger,
+) ([]*entities.MigratedItem, error) {
+	src := secrets.NewConnector(storeInfo.Store.(stores.SecretStore), c.db.Secrets(storeInfo.Name), resolver, c.logger)
+	dest := secrets.NewConnector(destStoreInfo.Store.(stores.SecretStore), c.db.Secrets(destStoreInfo.Name), resolver, c.logger)
+
+	ids, err := src.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entities.MigratedItem
+	for _, id := range ids {
+		var secret *entities.Secret
+		item, err := migrateItem(id, dryRun, deleteSource, logger, itemMigration{
+			get: func() (err error) {
+				_, err = dest.Get(ctx, id, "")
+				return err
+			},
+			read: func() (err error) {
+				secret, err = src.Get(ctx, id, "")
+				return err
+			},
+			write: func() error {
+				_, err := dest.Set(ctx, id, secret.Value, &entities.Attributes{Tags: secret.Tags, ValueType: secret.ValueType})
+				return err
+			},
+			delete: func() error {
+				return src.Delete(ctx, id)
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (c *Connector) migrateKeys(
+	ctx context.Context,
+	storeInfo, destStoreInfo *entities.Store,
+	resolver auth.Authorizator,
+	dryRun, deleteSource bool,
+	logger log.Logger,
+) ([]*entities.MigratedItem, error) {
+	src := keys.NewConnector(storeInfo.Store.(stores.KeyStore), c.db.Keys(storeInfo.Name), resolver, c.logger)
+	dest := keys.NewConnector(destStoreInfo.Store.(stores.KeyStore), c.db.Keys(destStoreInfo.Name), resolver, c.logger)
+
+	ids, err := src.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entities.MigratedItem
+	for _, id := range ids {
+		var key *entities.Key
+		var privKey []byte
+		item, err := migrateItem(id, dryRun, deleteSource, logger, itemMigration{
+			get: func() (err error) {
+				_, err = dest.Get(ctx, id)
+				return err
+			},
+			read: func() (err error) {
+				key, err = src.Get(ctx, id)
+				if err != nil {
+					return err
+				}
+
+				privKey, err = src.Export(ctx, id)
+				return err
+			},
+			write: func() error {
+				return importKey(ctx, dest, key, privKey)
+			},
+			delete: func() error {
+				return src.Delete(ctx, id)
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (c *Connector) migrateEthAccounts(
+	ctx context.Context,
+	storeInfo, destStoreInfo *entities.Store,
+	resolver auth.Authorizator,
+	dryRun, deleteSource bool,
+	logger log.Logger,
+) ([]*entities.MigratedItem, error) {
+	keyStore := storeInfo.Store.(stores.KeyStore)
+	src := eth.NewConnector(keyStore, c.db.ETHAccounts(storeInfo.Name), resolver, c.logger)
+	dest := eth.NewConnector(destStoreInfo.Store.(stores.KeyStore), c.db.ETHAccounts(destStoreInfo.Name), resolver, c.logger)
+
+	addresses, err := src.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entities.MigratedItem
+	for _, address := range addresses {
+		var acc *entities.ETHAccount
+		var privKey []byte
+		item, err := migrateItem(address.Hex(), dryRun, deleteSource, logger, itemMigration{
+			get: func() (err error) {
+				_, err = dest.Get(ctx, address)
+				return err
+			},
+			read: func() (err error) {
+				acc, err = src.Get(ctx, address)
+				if err != nil {
+					return err
+				}
+
+				// Migrating stores is already authorized, the private key is exported from the underlying key store
+				privKey, err = keyStore.Export(ctx, acc.KeyID)
+				return err
+			},
+			write: func() error {
+				return importETHAccount(ctx, dest, acc, privKey)
+			},
+			delete: func() error {
+				return src.Delete(ctx, address)
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// importKey imports a key into a store with the attributes of the original key. Keys cannot be imported disabled or
+// expiring, these attributes are then set once imported
+func importKey(ctx context.Context, dest stores.KeyStore, key *entities.Key, privKey []byte) error {
+	attr := &entities.Attributes{Tags: key.Tags, Operations: key.Operations}
+	if key.Metadata.RecoveryPeriod > 0 {
+		attr.Recovery = &entities.Recovery{Period: key.Metadata.RecoveryPeriod}
+	}
+	if key.RotationPeriod > 0 {
+		attr.RotationPeriod = &key.RotationPeriod
+	}
+
+	importedKey, err := dest.Import(ctx, key.ID, privKey, key.Algo, attr)
+	if err != nil {
+		return err
+	}
+
+	// The destination vault may already hold another key with the same ID
+	if !bytes.Equal(importedKey.PublicKey, key.PublicKey) {
+		return errors.StatusConflictError("another key with the same ID exists in the destination store")
+	}
+
+	if !key.Metadata.Disabled && key.Metadata.ExpireAt.IsZero() {
+		return nil
+	}
+
+	_, err = dest.Update(ctx, key.ID, lifecycleAttributes(key.Tags, key.Metadata))
+	return err
+}
+
+// importETHAccount imports an Ethereum account into a store with the attributes of the original account, including
+// whether it is disabled and when it expires
+func importETHAccount(ctx context.Context, dest stores.EthStore, acc *entities.ETHAccount, privKey []byte) error {
+	attr := &entities.Attributes{Tags: acc.Tags}
+	if acc.Metadata.RecoveryPeriod > 0 {
+		attr.Recovery = &entities.Recovery{Period: acc.Metadata.RecoveryPeriod}
+	}
+
+	importedAcc, err := dest.Import(ctx, acc.KeyID, privKey, attr)
+	if err != nil {
+		return err
+	}
+
+	// The destination vault may already hold another key with the same ID
+	if importedAcc.Address != acc.Address {
+		return errors.StatusConflictError("another key with the same ID exists in the destination store")
+	}
+
+	if !acc.Metadata.Disabled && acc.Metadata.ExpireAt.IsZero() {
+		return nil
+	}
+
+	_, err = dest.Update(ctx, acc.Address, lifecycleAttributes(acc.Tags, acc.Metadata))
+	return err
+}
+
+// lifecycleAttributes returns the attributes disabling an imported item or setting its expiry as in its metadata
+func lifecycleAttributes(tags map[string]string, metadata *entities.Metadata) *entities.Attributes {
+	return &entities.Attributes{
+		Tags:     tags,
+		Disabled: &metadata.Disabled,
+		ExpireAt: &metadata.ExpireAt,
+	}
+}
+
+// itemMigration binds the steps of the migration of an item to the source and destination stores
+type itemMigration struct {
+	// get gets the item from the destination store
+	get func() error
+	// read reads the item from the source store, including its private key or value
+	read func() error
+	// write writes the read item to the destination store
+	write func() error
+	// delete deletes the item from the source store once migrated
+	delete func() error
+}
+
+// migrateItem migrates an item and returns its result. It only fails when the source store cannot export its items,
+// which is the case of every item of the store
+func migrateItem(id string, dryRun, deleteSource bool, logger log.Logger, m itemMigration) (*entities.MigratedItem, error) {
+	logger = logger.With("id", id)
+	item := &entities.MigratedItem{ID: id}
+
+	err := m.get()
+	switch {
+	case err == nil:
+		logger.Debug("item already exists in destination store, skipping")
+		item.Status = entities.MigrationStatusSkipped
+		item.Error = "item already exists in the destination store"
+		return item, nil
+	case !errors.IsNotFoundError(err):
+		return failedMigration(item, err, logger), nil
+	}
+
+	err = m.read()
+	if err != nil && errors.IsNotSupportedError(err) {
+		errMessage := "items of the source store cannot be exported"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+	if err != nil {
+		return failedMigration(item, err, logger), nil
+	}
+
+	if dryRun {
+		logger.Info("item would be migrated")
+		item.Status = entities.MigrationStatusPending
+		return item, nil
+	}
+
+	err = m.write()
+	if err != nil {
+		return failedMigration(item, err, logger), nil
+	}
+
+	if deleteSource {
+		err = m.delete()
+		if err != nil {
+			logger.WithError(err).Error("item migrated but failed to delete it from the source store")
+			item.Status = entities.MigrationStatusFailed
+			item.Error = "item migrated but failed to delete it from the source store: " + errors.FromError(err).GetMessage()
+			return item, nil
+		}
+	}
+
+	logger.Info("item migrated")
+	item.Status = entities.MigrationStatusMigrated
+	return item, nil
+}
+
+func failedMigration(item *entities.MigratedItem, err error, logger log.Logger) *entities.MigratedItem {
+	logger.WithError(err).Error("failed to migrate item")
+	item.Status = entities.MigrationStatusFailed
+	item.Error = errors.FromError(err).GetMessage()
+	return item
+}
@@ -0,0 +1,239 @@
+package stores
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	keysDB := mock2.NewMockKeys(ctrl)
+	destKeysDB := mock2.NewMockKeys(ctrl)
+	keyStore := mock5.NewMockKeyStore(ctrl)
+	destKeyStore := mock5.NewMockKeyStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock3.NewMockRoles(ctrl)
+	userInfo := &authtypes.UserInfo{Tenant: "tenant"}
+
+	connector := NewConnector(roles, db, mock4.NewMockVaults(ctrl), logger)
+	connector.createStore("key-store", storesentities.KeyStoreType, keyStore, nil, 0)
+	connector.createStore("dest-key-store", storesentities.KeyStoreType, destKeyStore, nil, 0)
+	connector.createStore("secret-store", storesentities.SecretStoreType, mock5.NewMockSecretStore(ctrl), nil, 0)
+
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(append(authtypes.ListPermissions(), authtypes.MigrateStore)).AnyTimes()
+	db.EXPECT().Keys("key-store").Return(keysDB).AnyTimes()
+	db.EXPECT().Keys("dest-key-store").Return(destKeysDB).AnyTimes()
+	keysDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(keysDB)
+		}).AnyTimes()
+
+	key := testutils2.FakeKey()
+	existingKey := testutils2.FakeKey()
+	privKey := []byte("private-key")
+
+	t.Run("should migrate the keys and skip the ones already existing in the destination store", func(t *testing.T) {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID, existingKey.ID}, nil)
+
+		destKeysDB.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil).Times(2)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, &storesentities.Attributes{Tags: key.Tags}).Return(key, nil)
+		destKeysDB.EXPECT().Add(gomock.Any(), key).Return(key, nil)
+
+		destKeysDB.EXPECT().Get(gomock.Any(), existingKey.ID).Return(existingKey, nil)
+
+		items, err := connector.Migrate(ctx, "key-store", "dest-key-store", false, false, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, key.ID, items[0].ID)
+		assert.Equal(t, storesentities.MigrationStatusMigrated, items[0].Status)
+		assert.Equal(t, existingKey.ID, items[1].ID)
+		assert.Equal(t, storesentities.MigrationStatusSkipped, items[1].Status)
+	})
+
+	t.Run("should migrate the keys disabled", func(t *testing.T) {
+		disabledKey := testutils2.FakeKey()
+		disabledKey.Metadata.Disabled = true
+		importedKey := testutils2.FakeKey()
+		importedKey.ID = disabledKey.ID
+		importedKey.PublicKey = disabledKey.PublicKey
+
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{disabledKey.ID}, nil)
+		destKeysDB.EXPECT().Get(gomock.Any(), disabledKey.ID).Return(nil, errors.NotFoundError("error"))
+		keysDB.EXPECT().Get(gomock.Any(), disabledKey.ID).Return(disabledKey, nil).Times(2)
+		keyStore.EXPECT().Export(gomock.Any(), disabledKey.ID).Return(privKey, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), disabledKey.ID, privKey, disabledKey.Algo, gomock.Any()).Return(importedKey, nil)
+		destKeysDB.EXPECT().Add(gomock.Any(), importedKey).Return(importedKey, nil)
+
+		destKeysDB.EXPECT().Get(gomock.Any(), disabledKey.ID).Return(importedKey, nil)
+		destKeysDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+				return persist(destKeysDB)
+			})
+		destKeysDB.EXPECT().Update(gomock.Any(), importedKey).DoAndReturn(func(_ context.Context, key *storesentities.Key) (*storesentities.Key, error) {
+			assert.True(t, key.Metadata.Disabled)
+			return key, nil
+		})
+		destKeyStore.EXPECT().Update(gomock.Any(), disabledKey.ID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, attr *storesentities.Attributes) (*storesentities.Key, error) {
+			assert.True(t, *attr.Disabled)
+			return importedKey, nil
+		})
+
+		items, err := connector.Migrate(ctx, "key-store", "dest-key-store", false, false, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, storesentities.MigrationStatusMigrated, items[0].Status)
+	})
+
+	t.Run("should not copy the keys in dry run mode", func(t *testing.T) {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		destKeysDB.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil).Times(2)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+
+		items, err := connector.Migrate(ctx, "key-store", "dest-key-store", true, true, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, storesentities.MigrationStatusPending, items[0].Status)
+	})
+
+	t.Run("should delete the migrated keys from the source store", func(t *testing.T) {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		destKeysDB.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil).Times(2)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, gomock.Any()).Return(key, nil)
+		destKeysDB.EXPECT().Add(gomock.Any(), key).Return(key, nil)
+		keysDB.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
+		keyStore.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
+
+		items, err := connector.Migrate(ctx, "key-store", "dest-key-store", false, true, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, storesentities.MigrationStatusMigrated, items[0].Status)
+	})
+
+	t.Run("should report the keys failing to be migrated", func(t *testing.T) {
+		otherKey := testutils2.FakeKey()
+		otherKey.PublicKey = []byte("other-public-key")
+
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		destKeysDB.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil).Times(2)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(privKey, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, gomock.Any()).Return(otherKey, nil)
+		destKeysDB.EXPECT().Add(gomock.Any(), otherKey).Return(otherKey, nil)
+
+		items, err := connector.Migrate(ctx, "key-store", "dest-key-store", false, false, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, storesentities.MigrationStatusFailed, items[0].Status)
+		assert.Equal(t, "another key with the same ID exists in the destination store", items[0].Error)
+	})
+
+	t.Run("should fail with NotSupportedError if the keys of the source store cannot be exported", func(t *testing.T) {
+		keysDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{key.ID}, nil)
+		destKeysDB.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil).Times(2)
+		keyStore.EXPECT().Export(gomock.Any(), key.ID).Return(nil, errors.NotSupportedError("error"))
+
+		_, err := connector.Migrate(ctx, "key-store", "dest-key-store", false, false, userInfo)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the stores are not of the same type", func(t *testing.T) {
+		_, err := connector.Migrate(ctx, "key-store", "secret-store", false, false, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the source and destination stores are the same", func(t *testing.T) {
+		_, err := connector.Migrate(ctx, "key-store", "key-store", false, false, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with NotFoundError if the destination store does not exist", func(t *testing.T) {
+		_, err := connector.Migrate(ctx, "key-store", "inexistent-store", false, false, userInfo)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if the user is not allowed to migrate stores", func(t *testing.T) {
+		unauthorizedUser := &authtypes.UserInfo{Tenant: "tenant", Username: "unauthorized"}
+		roles.EXPECT().UserPermissions(gomock.Any(), unauthorizedUser).Return([]authtypes.Permission{authtypes.ReadKey, authtypes.WriteKey})
+
+		_, err := connector.Migrate(ctx, "key-store", "dest-key-store", false, false, unauthorizedUser)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
+
+func TestMigrateEthAccounts(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	accountsDB := mock2.NewMockETHAccounts(ctrl)
+	destAccountsDB := mock2.NewMockETHAccounts(ctrl)
+	keyStore := mock5.NewMockKeyStore(ctrl)
+	destKeyStore := mock5.NewMockKeyStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock3.NewMockRoles(ctrl)
+	userInfo := authtypes.NewWildcardUser()
+
+	connector := NewConnector(roles, db, mock4.NewMockVaults(ctrl), logger)
+	connector.createStore("eth-store", storesentities.EthereumStoreType, keyStore, nil, 0)
+	connector.createStore("dest-eth-store", storesentities.EthereumStoreType, destKeyStore, nil, 0)
+
+	roles.EXPECT().UserPermissions(gomock.Any(), userInfo).Return(userInfo.Permissions).AnyTimes()
+	db.EXPECT().ETHAccounts("eth-store").Return(accountsDB).AnyTimes()
+	db.EXPECT().ETHAccounts("dest-eth-store").Return(destAccountsDB).AnyTimes()
+
+	t.Run("should migrate the accounts preserving their address", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+		key := testutils2.FakeKey()
+		key.ID = acc.KeyID
+		privKey := []byte("private-key")
+
+		accountsDB.EXPECT().SearchAddresses(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{acc.Address.Hex()}, nil)
+		destAccountsDB.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError("error"))
+		accountsDB.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		keyStore.EXPECT().Export(gomock.Any(), acc.KeyID).Return(privKey, nil)
+		destKeyStore.EXPECT().Import(gomock.Any(), acc.KeyID, privKey, gomock.Any(), &storesentities.Attributes{Tags: acc.Tags}).Return(key, nil)
+		destAccountsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(acc, nil)
+
+		items, err := connector.Migrate(ctx, "eth-store", "dest-eth-store", false, false, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, acc.Address.Hex(), items[0].ID)
+		assert.Equal(t, storesentities.MigrationStatusMigrated, items[0].Status)
+	})
+}
@@ -0,0 +1,191 @@
+package stores
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	eth "github.com/consensys/quorum-key-manager/src/stores/connectors/ethereum"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/keys"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/secrets"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// PurgeDeleted destroys the deleted items of all the stores whose recovery period has elapsed and returns them.
+// The recovery period of an item takes precedence over the one of its store, items are kept when neither is set.
+// In dry run mode, the items are returned without being destroyed
+func (c *Connector) PurgeDeleted(ctx context.Context, dryRun bool) ([]*entities.PurgedItem, error) {
+	c.mux.RLock()
+	storesInfo := make([]*entities.Store, 0, len(c.stores))
+	for _, store := range c.stores {
+		storesInfo = append(storesInfo, store)
+	}
+	c.mux.RUnlock()
+
+	// Purges are not performed on behalf of a user, they are allowed regardless of the store tenants
+	resolver := authorizator.New(ctx, authtypes.ListPermissions(), "", c.logger)
+	now := time.Now().UTC()
+
+	var purgedItems []*entities.PurgedItem
+	var lastErr error
+	for _, storeInfo := range storesInfo {
+		var items []*entities.PurgedItem
+		var err error
+		switch storeInfo.StoreType {
+		case entities.SecretStoreType:
+			items, err = c.purgeSecrets(ctx, storeInfo, resolver, now, dryRun)
+		case entities.KeyStoreType:
+			items, err = c.purgeKeys(ctx, storeInfo, resolver, now, dryRun)
+		case entities.EthereumStoreType:
+			items, err = c.purgeEthAccounts(ctx, storeInfo, resolver, now, dryRun)
+		}
+		if err != nil {
+			lastErr = err
+		}
+
+		purgedItems = append(purgedItems, items...)
+	}
+
+	return purgedItems, lastErr
+}
+
+func (c *Connector) purgeSecrets(ctx context.Context, storeInfo *entities.Store, resolver auth.Authorizator, now time.Time, dryRun bool) ([]*entities.PurgedItem, error) {
+	db := c.db.Secrets(storeInfo.Name)
+	deletedSecrets, err := db.GetAllDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	connector := secrets.NewConnector(storeInfo.Store.(stores.SecretStore), db, resolver, c.logger)
+
+	var purgedItems []*entities.PurgedItem
+	var lastErr error
+	purgedIDs := make(map[string]bool)
+	for _, secret := range deletedSecrets {
+		// Every version of a deleted secret is returned, the secret is destroyed at once
+		if purgedIDs[secret.ID] || !isPurgeable(secret.Metadata, storeInfo.RecoveryPeriod, now) {
+			continue
+		}
+		purgedIDs[secret.ID] = true
+
+		if !dryRun {
+			err = connector.Destroy(ctx, secret.ID)
+			if err != nil {
+				c.logger.WithError(err).Error("failed to purge deleted secret", "store_name", storeInfo.Name, "id", secret.ID)
+				lastErr = err
+				continue
+			}
+		}
+
+		item, err := c.recordPurge(ctx, storeInfo, secret.ID, secret.Metadata.DeletedAt, dryRun)
+		if err != nil {
+			lastErr = err
+		}
+		purgedItems = append(purgedItems, item)
+	}
+
+	return purgedItems, lastErr
+}
+
+func (c *Connector) purgeKeys(ctx context.Context, storeInfo *entities.Store, resolver auth.Authorizator, now time.Time, dryRun bool) ([]*entities.PurgedItem, error) {
+	db := c.db.Keys(storeInfo.Name)
+	deletedKeys, err := db.GetAllDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	connector := keys.NewConnector(storeInfo.Store.(stores.KeyStore), db, resolver, c.logger)
+
+	var purgedItems []*entities.PurgedItem
+	var lastErr error
+	for _, key := range deletedKeys {
+		if !isPurgeable(key.Metadata, storeInfo.RecoveryPeriod, now) {
+			continue
+		}
+
+		if !dryRun {
+			err = connector.Destroy(ctx, key.ID)
+			if err != nil {
+				c.logger.WithError(err).Error("failed to purge deleted key", "store_name", storeInfo.Name, "id", key.ID)
+				lastErr = err
+				continue
+			}
+		}
+
+		item, err := c.recordPurge(ctx, storeInfo, key.ID, key.Metadata.DeletedAt, dryRun)
+		if err != nil {
+			lastErr = err
+		}
+		purgedItems = append(purgedItems, item)
+	}
+
+	return purgedItems, lastErr
+}
+
+func (c *Connector) purgeEthAccounts(ctx context.Context, storeInfo *entities.Store, resolver auth.Authorizator, now time.Time, dryRun bool) ([]*entities.PurgedItem, error) {
+	db := c.db.ETHAccounts(storeInfo.Name)
+	deletedAccounts, err := db.GetAllDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	connector := eth.NewConnector(storeInfo.Store.(stores.KeyStore), db, resolver, c.logger)
+
+	var purgedItems []*entities.PurgedItem
+	var lastErr error
+	for _, acc := range deletedAccounts {
+		if !isPurgeable(acc.Metadata, storeInfo.RecoveryPeriod, now) {
+			continue
+		}
+
+		if !dryRun {
+			err = connector.Destroy(ctx, acc.Address)
+			if err != nil {
+				c.logger.WithError(err).Error("failed to purge deleted ethereum account", "store_name", storeInfo.Name, "address", acc.Address.Hex())
+				lastErr = err
+				continue
+			}
+		}
+
+		item, err := c.recordPurge(ctx, storeInfo, acc.Address.Hex(), acc.Metadata.DeletedAt, dryRun)
+		if err != nil {
+			lastErr = err
+		}
+		purgedItems = append(purgedItems, item)
+	}
+
+	return purgedItems, lastErr
+}
+
+// recordPurge returns a purged item and, unless in dry run mode, persists it in the audit trail of the purges. The item
+// is returned even if it fails to be recorded as it is already destroyed
+func (c *Connector) recordPurge(ctx context.Context, storeInfo *entities.Store, id string, deletedAt time.Time, dryRun bool) (*entities.PurgedItem, error) {
+	logger := c.logger.With("store_name", storeInfo.Name, "store_type", storeInfo.StoreType, "id", id, "deleted_at", deletedAt)
+	item := &entities.PurgedItem{
+		StoreName: storeInfo.Name,
+		StoreType: storeInfo.StoreType,
+		ID:        id,
+		DeletedAt: deletedAt,
+	}
+
+	if dryRun {
+		logger.Info("deleted item would be purged")
+		return item, nil
+	}
+
+	item.PurgedAt = time.Now().UTC()
+	logger.Info("deleted item purged")
+	return item, c.db.PurgedItems().Add(ctx, item)
+}
+
+func isPurgeable(metadata *entities.Metadata, storeRecoveryPeriod time.Duration, now time.Time) bool {
+	recoveryPeriod := metadata.RecoveryPeriod
+	if recoveryPeriod == 0 {
+		recoveryPeriod = storeRecoveryPeriod
+	}
+
+	return recoveryPeriod > 0 && !metadata.DeletedAt.IsZero() && !metadata.DeletedAt.Add(recoveryPeriod).After(now)
+}
@@ -0,0 +1,201 @@
+package stores
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	keysDB := mock2.NewMockKeys(ctrl)
+	purgedItemsDB := mock2.NewMockPurgedItems(ctrl)
+	keyStore := mock5.NewMockKeyStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock3.NewMockRoles(ctrl)
+	vaults := mock4.NewMockVaults(ctrl)
+
+	connector := NewConnector(roles, db, vaults, logger)
+	// Purges apply to the stores restricted to tenants as well
+	connector.createStore("key-store", storesentities.KeyStoreType, keyStore, []string{"tenant"}, 24*time.Hour)
+
+	keysDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(keysDB)
+		}).AnyTimes()
+	db.EXPECT().PurgedItems().Return(purgedItemsDB).AnyTimes()
+
+	expiredKey := testutils2.FakeKey()
+	expiredKey.Metadata.DeletedAt = time.Now().Add(-48 * time.Hour)
+	recoverableKey := testutils2.FakeKey()
+	recoverableKey.Metadata.DeletedAt = time.Now().Add(-time.Hour)
+	// The recovery period of the key takes precedence over the one of its store
+	shortRecoveryKey := testutils2.FakeKey()
+	shortRecoveryKey.Metadata.DeletedAt = time.Now().Add(-time.Hour)
+	shortRecoveryKey.Metadata.RecoveryPeriod = time.Minute
+
+	t.Run("should purge the deleted keys whose recovery period has elapsed", func(t *testing.T) {
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().GetAllDeleted(gomock.Any()).Return([]*storesentities.Key{expiredKey, recoverableKey, shortRecoveryKey}, nil)
+		for _, key := range []*storesentities.Key{expiredKey, shortRecoveryKey} {
+			keysDB.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+			keysDB.EXPECT().Purge(gomock.Any(), key.ID).Return(nil)
+			keyStore.EXPECT().Destroy(gomock.Any(), key.ID).Return(nil)
+		}
+		purgedItemsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+		purgedItems, err := connector.PurgeDeleted(ctx, false)
+
+		require.NoError(t, err)
+		require.Len(t, purgedItems, 2)
+		assert.Equal(t, expiredKey.ID, purgedItems[0].ID)
+		assert.Equal(t, "key-store", purgedItems[0].StoreName)
+		assert.Equal(t, storesentities.KeyStoreType, purgedItems[0].StoreType)
+		assert.Equal(t, expiredKey.Metadata.DeletedAt, purgedItems[0].DeletedAt)
+		assert.False(t, purgedItems[0].PurgedAt.IsZero())
+		assert.Equal(t, shortRecoveryKey.ID, purgedItems[1].ID)
+	})
+
+	t.Run("should record the purged keys and fail if they cannot be recorded", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().GetAllDeleted(gomock.Any()).Return([]*storesentities.Key{expiredKey}, nil)
+		keysDB.EXPECT().GetDeleted(gomock.Any(), expiredKey.ID).Return(expiredKey, nil)
+		keysDB.EXPECT().Purge(gomock.Any(), expiredKey.ID).Return(nil)
+		keyStore.EXPECT().Destroy(gomock.Any(), expiredKey.ID).Return(nil)
+		purgedItemsDB.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, item *storesentities.PurgedItem) error {
+			assert.Equal(t, "key-store", item.StoreName)
+			assert.Equal(t, storesentities.KeyStoreType, item.StoreType)
+			assert.Equal(t, expiredKey.ID, item.ID)
+			assert.Equal(t, expiredKey.Metadata.DeletedAt, item.DeletedAt)
+			assert.False(t, item.PurgedAt.IsZero())
+			return expectedErr
+		})
+
+		purgedItems, err := connector.PurgeDeleted(ctx, false)
+
+		assert.Equal(t, expectedErr, err)
+		// The key is destroyed even though its purge is not recorded
+		assert.Len(t, purgedItems, 1)
+	})
+
+	t.Run("should only purge in DB the keys whose store does not support destroying", func(t *testing.T) {
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().GetAllDeleted(gomock.Any()).Return([]*storesentities.Key{expiredKey}, nil)
+		keysDB.EXPECT().GetDeleted(gomock.Any(), expiredKey.ID).Return(expiredKey, nil)
+		keysDB.EXPECT().Purge(gomock.Any(), expiredKey.ID).Return(nil)
+		keyStore.EXPECT().Destroy(gomock.Any(), expiredKey.ID).Return(errors.NotSupportedError("error"))
+		purgedItemsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+
+		purgedItems, err := connector.PurgeDeleted(ctx, false)
+
+		require.NoError(t, err)
+		assert.Len(t, purgedItems, 1)
+	})
+
+	t.Run("should not destroy the keys in dry run mode", func(t *testing.T) {
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().GetAllDeleted(gomock.Any()).Return([]*storesentities.Key{expiredKey, recoverableKey}, nil)
+
+		purgedItems, err := connector.PurgeDeleted(ctx, true)
+
+		require.NoError(t, err)
+		require.Len(t, purgedItems, 1)
+		assert.Equal(t, expiredKey.ID, purgedItems[0].ID)
+		assert.True(t, purgedItems[0].PurgedAt.IsZero())
+	})
+
+	t.Run("should fail with same error if a key fails to be destroyed", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().GetAllDeleted(gomock.Any()).Return([]*storesentities.Key{expiredKey}, nil)
+		keysDB.EXPECT().GetDeleted(gomock.Any(), expiredKey.ID).Return(expiredKey, nil)
+		keysDB.EXPECT().Purge(gomock.Any(), expiredKey.ID).Return(nil)
+		keyStore.EXPECT().Destroy(gomock.Any(), expiredKey.ID).Return(expectedErr)
+
+		purgedItems, err := connector.PurgeDeleted(ctx, false)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, purgedItems)
+	})
+
+	t.Run("should fail with same error if deleted keys cannot be fetched", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().GetAllDeleted(gomock.Any()).Return(nil, expectedErr)
+
+		_, err := connector.PurgeDeleted(ctx, false)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestPurgeDeletedSecrets(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	secretsDB := mock2.NewMockSecrets(ctrl)
+	purgedItemsDB := mock2.NewMockPurgedItems(ctrl)
+	secretStore := mock5.NewMockSecretStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+
+	connector := NewConnector(mock3.NewMockRoles(ctrl), db, mock4.NewMockVaults(ctrl), logger)
+	connector.createStore("secret-store", storesentities.SecretStoreType, secretStore, nil, 0)
+
+	secretsDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Secrets) error) error {
+			return persist(secretsDB)
+		}).AnyTimes()
+
+	t.Run("should destroy every version of a deleted secret at once", func(t *testing.T) {
+		secretV1 := testutils2.FakeSecret()
+		secretV1.Metadata.DeletedAt = time.Now().Add(-2 * time.Hour)
+		secretV1.Metadata.RecoveryPeriod = time.Hour
+		secretV2 := testutils2.FakeSecret()
+		secretV2.ID = secretV1.ID
+		secretV2.Metadata.Version = "2"
+		secretV2.Metadata.DeletedAt = secretV1.Metadata.DeletedAt
+		secretV2.Metadata.RecoveryPeriod = time.Hour
+		// Neither the secret nor its store have a recovery period
+		keptSecret := testutils2.FakeSecret()
+		keptSecret.Metadata.DeletedAt = time.Now().Add(-24 * time.Hour)
+
+		db.EXPECT().Secrets("secret-store").Return(secretsDB)
+		secretsDB.EXPECT().GetAllDeleted(gomock.Any()).Return([]*storesentities.Secret{secretV1, secretV2, keptSecret}, nil)
+		secretsDB.EXPECT().GetDeleted(gomock.Any(), secretV1.ID).Return(secretV1, nil)
+		secretsDB.EXPECT().Purge(gomock.Any(), secretV1.ID).Return(nil)
+		secretStore.EXPECT().Destroy(gomock.Any(), secretV1.ID).Return(nil)
+		db.EXPECT().PurgedItems().Return(purgedItemsDB)
+		purgedItemsDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil)
+
+		purgedItems, err := connector.PurgeDeleted(ctx, false)
+
+		require.NoError(t, err)
+		require.Len(t, purgedItems, 1)
+		assert.Equal(t, secretV1.ID, purgedItems[0].ID)
+		assert.Equal(t, storesentities.SecretStoreType, purgedItems[0].StoreType)
+	})
+}
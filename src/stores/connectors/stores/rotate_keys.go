@@ -0,0 +1,73 @@
+package stores
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/service/authorizator"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/connectors/keys"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// keyRotationLock prevents several instances from rotating the same keys
+const keyRotationLock = "key-rotation"
+
+// RotateKeys rotates the keys of all the key stores whose rotation period has elapsed. Only one instance rotates keys
+// at a time, the run is skipped when another instance is rotating them
+func (c *Connector) RotateKeys(ctx context.Context) error {
+	return c.db.RunExclusively(ctx, keyRotationLock, c.rotateKeys)
+}
+
+func (c *Connector) rotateKeys(ctx context.Context) error {
+	c.mux.RLock()
+	keyStores := make(map[string]stores.KeyStore)
+	for name, store := range c.stores {
+		if store.StoreType == entities.KeyStoreType {
+			keyStores[name] = store.Store.(stores.KeyStore)
+		}
+	}
+	c.mux.RUnlock()
+
+	// Scheduled rotations are not performed on behalf of a user, they are allowed regardless of the store tenants
+	resolver := authorizator.New(ctx, authtypes.ListPermissions(), "", c.logger)
+
+	var lastErr error
+	for name, store := range keyStores {
+		logger := c.logger.With("store_name", name)
+		db := c.db.Keys(name)
+
+		dueKeys, err := db.SearchRotationDue(ctx, time.Now().UTC())
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		connector := keys.NewConnector(store, db, resolver, c.logger)
+		var nSuccesses, nFailures uint
+		for _, key := range dueKeys {
+			_, err = connector.Rotate(ctx, key.ID, key.Algo)
+			if err != nil && errors.IsNotSupportedError(err) {
+				// Postpones the rotation of keys whose store does not support it instead of retrying at every run
+				key.SetRotationPeriod(key.RotationPeriod)
+				_, _ = db.Update(ctx, key)
+			}
+			if err != nil {
+				logger.WithError(err).Error("failed to rotate key", "id", key.ID)
+				lastErr = err
+				nFailures++
+				continue
+			}
+
+			nSuccesses++
+		}
+
+		if len(dueKeys) > 0 {
+			logger.Info("scheduled key rotation completed", "n_successes", nSuccesses, "n_failures", nFailures)
+		}
+	}
+
+	return lastErr
+}
@@ -0,0 +1,101 @@
+package stores
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	storesentities "github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	mock5 "github.com/consensys/quorum-key-manager/src/stores/mock"
+	mock4 "github.com/consensys/quorum-key-manager/src/vaults/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateKeys(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := mock2.NewMockDatabase(ctrl)
+	keysDB := mock2.NewMockKeys(ctrl)
+	keyStore := mock5.NewMockKeyStore(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock3.NewMockRoles(ctrl)
+	vaults := mock4.NewMockVaults(ctrl)
+
+	connector := NewConnector(roles, db, vaults, logger)
+	// Scheduled rotations apply to the stores restricted to tenants as well
+	connector.createStore("key-store", storesentities.KeyStoreType, keyStore, []string{"tenant"}, 0)
+	connector.createStore("secret-store", storesentities.SecretStoreType, mock5.NewMockSecretStore(ctrl), nil, 0)
+
+	db.EXPECT().RunExclusively(gomock.Any(), "key-rotation", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ string, run func(context.Context) error) error {
+			return run(ctx)
+		}).Times(3)
+	keysDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
+			return persist(keysDB)
+		}).AnyTimes()
+
+	t.Run("should rotate the keys due for rotation successfully", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.SetRotationPeriod(time.Hour)
+		rotatedKey := testutils2.FakeKey()
+		rotatedKey.Metadata.Version = "2"
+
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().SearchRotationDue(gomock.Any(), gomock.Any()).Return([]*storesentities.Key{key}, nil)
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		keyStore.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(rotatedKey, nil)
+		keysDB.EXPECT().AddVersion(gomock.Any(), key).Return(key, nil)
+
+		err := connector.RotateKeys(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2", key.Metadata.Version)
+	})
+
+	t.Run("should postpone the rotation of keys whose store does not support it", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.RotationPeriod = time.Hour
+		key.NextRotationAt = time.Now().Add(-time.Minute)
+
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().SearchRotationDue(gomock.Any(), gomock.Any()).Return([]*storesentities.Key{key}, nil)
+		keysDB.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		keyStore.EXPECT().Rotate(gomock.Any(), key.ID, key.Algo).Return(nil, errors.NotSupportedError("error"))
+		keysDB.EXPECT().Update(gomock.Any(), key).Return(key, nil)
+
+		err := connector.RotateKeys(ctx)
+
+		assert.True(t, errors.IsNotSupportedError(err))
+		assert.True(t, key.NextRotationAt.After(time.Now()))
+	})
+
+	t.Run("should fail with same error if keys due for rotation cannot be searched", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		db.EXPECT().Keys("key-store").Return(keysDB)
+		keysDB.EXPECT().SearchRotationDue(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := connector.RotateKeys(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should not rotate keys while another instance is rotating them", func(t *testing.T) {
+		db.EXPECT().RunExclusively(gomock.Any(), "key-rotation", gomock.Any()).Return(nil)
+
+		err := connector.RotateKeys(ctx)
+
+		assert.NoError(t, err)
+	})
+}
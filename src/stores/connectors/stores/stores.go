@@ -3,6 +3,7 @@ package stores
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/vaults"
 
@@ -38,7 +39,7 @@ func NewConnector(roles auth.Roles, db database.Database, vaultsService vaults.V
 }
 
 // TODO: Move to data layer
-func (c *Connector) createStore(name, storeType string, store interface{}, allowedTenants []string) {
+func (c *Connector) createStore(name, storeType string, store interface{}, allowedTenants []string, recoveryPeriod time.Duration) {
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
@@ -47,6 +48,7 @@ func (c *Connector) createStore(name, storeType string, store interface{}, allow
 		AllowedTenants: allowedTenants,
 		Store:          store,
 		StoreType:      storeType,
+		RecoveryPeriod: recoveryPeriod,
 	}
 }
 
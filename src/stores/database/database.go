@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
@@ -13,6 +14,12 @@ type Database interface {
 	Ping(ctx context.Context) error
 	Keys(storeID string) Keys
 	Secrets(storeID string) Secrets
+	EncryptedSecrets(vault string) EncryptedSecrets
+	PurgedItems() PurgedItems
+	Backups() Backups
+	// RunExclusively runs the function while holding a lock on the name shared by all the instances, the function is
+	// not run when another instance holds the lock
+	RunExclusively(ctx context.Context, name string, run func(ctx context.Context) error) error
 }
 
 type ETHAccounts interface {
@@ -22,6 +29,8 @@ type ETHAccounts interface {
 	GetAll(ctx context.Context) ([]*entities.ETHAccount, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.ETHAccount, error)
 	SearchAddresses(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.ETHAccount, error)
+	Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error)
 	Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error)
 	Update(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error)
 	Delete(ctx context.Context, addr string) error
@@ -32,11 +41,17 @@ type ETHAccounts interface {
 type Keys interface {
 	RunInTransaction(ctx context.Context, persistFunc func(dbtx Keys) error) error
 	Get(ctx context.Context, id string) (*entities.Key, error)
+	GetVersion(ctx context.Context, id, version string) (*entities.Key, error)
 	GetDeleted(ctx context.Context, id string) (*entities.Key, error)
 	GetAll(ctx context.Context) ([]*entities.Key, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.Key, error)
+	ListVersions(ctx context.Context, id string) ([]string, error)
 	SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Key, error)
+	Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error)
+	SearchRotationDue(ctx context.Context, before time.Time) ([]*entities.Key, error)
 	Add(ctx context.Context, key *entities.Key) (*entities.Key, error)
+	AddVersion(ctx context.Context, key *entities.Key) (*entities.Key, error)
 	Update(ctx context.Context, key *entities.Key) (*entities.Key, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
@@ -48,7 +63,11 @@ type Secrets interface {
 	Get(ctx context.Context, id, version string) (*entities.Secret, error)
 	GetLatestVersion(ctx context.Context, id string, isDeleted bool) (string, error)
 	ListVersions(ctx context.Context, id string, isDeleted bool) ([]string, error)
+	GetAllVersions(ctx context.Context, id string) ([]*entities.Secret, error)
+	Lock(ctx context.Context, id string) error
 	SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Secret, error)
+	Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error)
 	GetDeleted(ctx context.Context, id string) (*entities.Secret, error)
 	GetAll(ctx context.Context) ([]*entities.Secret, error)
 	GetAllDeleted(ctx context.Context) ([]*entities.Secret, error)
@@ -56,5 +75,31 @@ type Secrets interface {
 	Update(ctx context.Context, secret *entities.Secret) (*entities.Secret, error)
 	Delete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
+	RestoreVersion(ctx context.Context, id, version string) error
 	Purge(ctx context.Context, id string) error
+	PurgeVersion(ctx context.Context, id, version string) error
+}
+
+type EncryptedSecrets interface {
+	RunInTransaction(ctx context.Context, persistFunc func(dbtx EncryptedSecrets) error) error
+	Get(ctx context.Context, id, version string) (*entities.EncryptedSecret, error)
+	GetDeleted(ctx context.Context, id string) (*entities.EncryptedSecret, error)
+	GetLatestVersion(ctx context.Context, id string) (int, error)
+	SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error)
+	Add(ctx context.Context, secret *entities.EncryptedSecret) (*entities.EncryptedSecret, error)
+	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	RestoreVersion(ctx context.Context, id, version string) error
+	Purge(ctx context.Context, id string) error
+	PurgeVersion(ctx context.Context, id, version string) error
+}
+
+// PurgedItems records the deleted items destroyed by purges
+type PurgedItems interface {
+	Add(ctx context.Context, item *entities.PurgedItem) error
+}
+
+// Backups records the backups of stores
+type Backups interface {
+	Add(ctx context.Context, record *entities.BackupRecord) error
 }
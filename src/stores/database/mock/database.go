@@ -10,6 +10,7 @@ import (
 	entities "github.com/consensys/quorum-key-manager/src/stores/entities"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockDatabase is a mock of Database interface
@@ -91,6 +92,62 @@ func (mr *MockDatabaseMockRecorder) Secrets(storeID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockDatabase)(nil).Secrets), storeID)
 }
 
+// EncryptedSecrets mocks base method
+func (m *MockDatabase) EncryptedSecrets(vault string) database.EncryptedSecrets {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptedSecrets", vault)
+	ret0, _ := ret[0].(database.EncryptedSecrets)
+	return ret0
+}
+
+// EncryptedSecrets indicates an expected call of EncryptedSecrets
+func (mr *MockDatabaseMockRecorder) EncryptedSecrets(vault interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptedSecrets", reflect.TypeOf((*MockDatabase)(nil).EncryptedSecrets), vault)
+}
+
+// PurgedItems mocks base method
+func (m *MockDatabase) PurgedItems() database.PurgedItems {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgedItems")
+	ret0, _ := ret[0].(database.PurgedItems)
+	return ret0
+}
+
+// PurgedItems indicates an expected call of PurgedItems
+func (mr *MockDatabaseMockRecorder) PurgedItems() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgedItems", reflect.TypeOf((*MockDatabase)(nil).PurgedItems))
+}
+
+// Backups mocks base method
+func (m *MockDatabase) Backups() database.Backups {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backups")
+	ret0, _ := ret[0].(database.Backups)
+	return ret0
+}
+
+// Backups indicates an expected call of Backups
+func (mr *MockDatabaseMockRecorder) Backups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backups", reflect.TypeOf((*MockDatabase)(nil).Backups))
+}
+
+// RunExclusively mocks base method
+func (m *MockDatabase) RunExclusively(ctx context.Context, name string, run func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunExclusively", ctx, name, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunExclusively indicates an expected call of RunExclusively
+func (mr *MockDatabaseMockRecorder) RunExclusively(ctx, name, run interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunExclusively", reflect.TypeOf((*MockDatabase)(nil).RunExclusively), ctx, name, run)
+}
+
 // MockETHAccounts is a mock of ETHAccounts interface
 type MockETHAccounts struct {
 	ctrl     *gomock.Controller
@@ -275,6 +332,36 @@ func (mr *MockETHAccountsMockRecorder) Purge(ctx, addr interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockETHAccounts)(nil).Purge), ctx, addr)
 }
 
+// Search mocks base method
+func (m *MockETHAccounts) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.ETHAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockETHAccountsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockETHAccounts)(nil).Search), ctx, filter)
+}
+
+// Count mocks base method
+func (m *MockETHAccounts) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockETHAccountsMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockETHAccounts)(nil).Count), ctx, filter)
+}
+
 // MockKeys is a mock of Keys interface
 type MockKeys struct {
 	ctrl     *gomock.Controller
@@ -327,6 +414,21 @@ func (mr *MockKeysMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeys)(nil).Get), ctx, id)
 }
 
+// GetVersion mocks base method
+func (m *MockKeys) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, id, version)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockKeysMockRecorder) GetVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockKeys)(nil).GetVersion), ctx, id, version)
+}
+
 // GetDeleted mocks base method
 func (m *MockKeys) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +474,21 @@ func (mr *MockKeysMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockKeys)(nil).GetAllDeleted), ctx)
 }
 
+// ListVersions mocks base method
+func (m *MockKeys) ListVersions(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockKeysMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockKeys)(nil).ListVersions), ctx, id)
+}
+
 // SearchIDs mocks base method
 func (m *MockKeys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -402,6 +519,21 @@ func (mr *MockKeysMockRecorder) Add(ctx, key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockKeys)(nil).Add), ctx, key)
 }
 
+// AddVersion mocks base method
+func (m *MockKeys) AddVersion(ctx context.Context, key *entities.Key) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVersion", ctx, key)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVersion indicates an expected call of AddVersion
+func (mr *MockKeysMockRecorder) AddVersion(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVersion", reflect.TypeOf((*MockKeys)(nil).AddVersion), ctx, key)
+}
+
 // Update mocks base method
 func (m *MockKeys) Update(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +591,51 @@ func (mr *MockKeysMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockKeys)(nil).Purge), ctx, id)
 }
 
+// Search mocks base method
+func (m *MockKeys) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockKeysMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockKeys)(nil).Search), ctx, filter)
+}
+
+// Count mocks base method
+func (m *MockKeys) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockKeysMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockKeys)(nil).Count), ctx, filter)
+}
+
+// SearchRotationDue mocks base method
+func (m *MockKeys) SearchRotationDue(ctx context.Context, before time.Time) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRotationDue", ctx, before)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchRotationDue indicates an expected call of SearchRotationDue
+func (mr *MockKeysMockRecorder) SearchRotationDue(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRotationDue", reflect.TypeOf((*MockKeys)(nil).SearchRotationDue), ctx, before)
+}
+
 // MockSecrets is a mock of Secrets interface
 type MockSecrets struct {
 	ctrl     *gomock.Controller
@@ -541,6 +718,35 @@ func (mr *MockSecretsMockRecorder) ListVersions(ctx, id, isDeleted interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockSecrets)(nil).ListVersions), ctx, id, isDeleted)
 }
 
+// GetAllVersions mocks base method
+func (m *MockSecrets) GetAllVersions(ctx context.Context, id string) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVersions", ctx, id)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllVersions indicates an expected call of GetAllVersions
+func (mr *MockSecretsMockRecorder) GetAllVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVersions", reflect.TypeOf((*MockSecrets)(nil).GetAllVersions), ctx, id)
+}
+
+// Lock mocks base method
+func (m *MockSecrets) Lock(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock
+func (mr *MockSecretsMockRecorder) Lock(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockSecrets)(nil).Lock), ctx, id)
+}
+
 // SearchIDs mocks base method
 func (m *MockSecrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -659,6 +865,20 @@ func (mr *MockSecretsMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockSecrets)(nil).Restore), ctx, id)
 }
 
+// RestoreVersion mocks base method
+func (m *MockSecrets) RestoreVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion
+func (mr *MockSecretsMockRecorder) RestoreVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockSecrets)(nil).RestoreVersion), ctx, id, version)
+}
+
 // Purge mocks base method
 func (m *MockSecrets) Purge(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -672,3 +892,303 @@ func (mr *MockSecretsMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockSecrets)(nil).Purge), ctx, id)
 }
+
+// Search mocks base method
+func (m *MockSecrets) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSecretsMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSecrets)(nil).Search), ctx, filter)
+}
+
+// Count mocks base method
+func (m *MockSecrets) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockSecretsMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockSecrets)(nil).Count), ctx, filter)
+}
+
+// PurgeVersion mocks base method
+func (m *MockSecrets) PurgeVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeVersion indicates an expected call of PurgeVersion
+func (mr *MockSecretsMockRecorder) PurgeVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeVersion", reflect.TypeOf((*MockSecrets)(nil).PurgeVersion), ctx, id, version)
+}
+
+// MockEncryptedSecrets is a mock of EncryptedSecrets interface
+type MockEncryptedSecrets struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncryptedSecretsMockRecorder
+}
+
+// MockEncryptedSecretsMockRecorder is the mock recorder for MockEncryptedSecrets
+type MockEncryptedSecretsMockRecorder struct {
+	mock *MockEncryptedSecrets
+}
+
+// NewMockEncryptedSecrets creates a new mock instance
+func NewMockEncryptedSecrets(ctrl *gomock.Controller) *MockEncryptedSecrets {
+	mock := &MockEncryptedSecrets{ctrl: ctrl}
+	mock.recorder = &MockEncryptedSecretsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEncryptedSecrets) EXPECT() *MockEncryptedSecretsMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method
+func (m *MockEncryptedSecrets) Add(ctx context.Context, secret *entities.EncryptedSecret) (*entities.EncryptedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, secret)
+	ret0, _ := ret[0].(*entities.EncryptedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add
+func (mr *MockEncryptedSecretsMockRecorder) Add(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockEncryptedSecrets)(nil).Add), ctx, secret)
+}
+
+// Delete mocks base method
+func (m *MockEncryptedSecrets) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockEncryptedSecretsMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEncryptedSecrets)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method
+func (m *MockEncryptedSecrets) Get(ctx context.Context, id, version string) (*entities.EncryptedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, version)
+	ret0, _ := ret[0].(*entities.EncryptedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockEncryptedSecretsMockRecorder) Get(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEncryptedSecrets)(nil).Get), ctx, id, version)
+}
+
+// GetDeleted mocks base method
+func (m *MockEncryptedSecrets) GetDeleted(ctx context.Context, id string) (*entities.EncryptedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
+	ret0, _ := ret[0].(*entities.EncryptedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted
+func (mr *MockEncryptedSecretsMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockEncryptedSecrets)(nil).GetDeleted), ctx, id)
+}
+
+// GetLatestVersion mocks base method
+func (m *MockEncryptedSecrets) GetLatestVersion(ctx context.Context, id string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestVersion", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestVersion indicates an expected call of GetLatestVersion
+func (mr *MockEncryptedSecretsMockRecorder) GetLatestVersion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestVersion", reflect.TypeOf((*MockEncryptedSecrets)(nil).GetLatestVersion), ctx, id)
+}
+
+// RestoreVersion mocks base method
+func (m *MockEncryptedSecrets) RestoreVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion
+func (mr *MockEncryptedSecretsMockRecorder) RestoreVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockEncryptedSecrets)(nil).RestoreVersion), ctx, id, version)
+}
+
+// Purge mocks base method
+func (m *MockEncryptedSecrets) Purge(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge
+func (mr *MockEncryptedSecretsMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockEncryptedSecrets)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method
+func (m *MockEncryptedSecrets) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockEncryptedSecretsMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockEncryptedSecrets)(nil).Restore), ctx, id)
+}
+
+// RunInTransaction mocks base method
+func (m *MockEncryptedSecrets) RunInTransaction(ctx context.Context, persistFunc func(database.EncryptedSecrets) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTransaction indicates an expected call of RunInTransaction
+func (mr *MockEncryptedSecretsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockEncryptedSecrets)(nil).RunInTransaction), ctx, persistFunc)
+}
+
+// SearchIDs mocks base method
+func (m *MockEncryptedSecrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIDs", ctx, isDeleted, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIDs indicates an expected call of SearchIDs
+func (mr *MockEncryptedSecretsMockRecorder) SearchIDs(ctx, isDeleted, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockEncryptedSecrets)(nil).SearchIDs), ctx, isDeleted, limit, offset)
+}
+
+// PurgeVersion mocks base method
+func (m *MockEncryptedSecrets) PurgeVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeVersion indicates an expected call of PurgeVersion
+func (mr *MockEncryptedSecretsMockRecorder) PurgeVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeVersion", reflect.TypeOf((*MockEncryptedSecrets)(nil).PurgeVersion), ctx, id, version)
+}
+
+// MockPurgedItems is a mock of PurgedItems interface
+type MockPurgedItems struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgedItemsMockRecorder
+}
+
+// MockPurgedItemsMockRecorder is the mock recorder for MockPurgedItems
+type MockPurgedItemsMockRecorder struct {
+	mock *MockPurgedItems
+}
+
+// NewMockPurgedItems creates a new mock instance
+func NewMockPurgedItems(ctrl *gomock.Controller) *MockPurgedItems {
+	mock := &MockPurgedItems{ctrl: ctrl}
+	mock.recorder = &MockPurgedItemsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPurgedItems) EXPECT() *MockPurgedItemsMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method
+func (m *MockPurgedItems) Add(ctx context.Context, item *entities.PurgedItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add
+func (mr *MockPurgedItemsMockRecorder) Add(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockPurgedItems)(nil).Add), ctx, item)
+}
+
+// MockBackups is a mock of Backups interface
+type MockBackups struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupsMockRecorder
+}
+
+// MockBackupsMockRecorder is the mock recorder for MockBackups
+type MockBackupsMockRecorder struct {
+	mock *MockBackups
+}
+
+// NewMockBackups creates a new mock instance
+func NewMockBackups(ctrl *gomock.Controller) *MockBackups {
+	mock := &MockBackups{ctrl: ctrl}
+	mock.recorder = &MockBackupsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackups) EXPECT() *MockBackupsMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method
+func (m *MockBackups) Add(ctx context.Context, record *entities.BackupRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add
+func (mr *MockBackupsMockRecorder) Add(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockBackups)(nil).Add), ctx, record)
+}
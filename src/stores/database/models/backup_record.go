@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type BackupRecord struct {
+	tableName struct{} `pg:"backups"` // nolint:unused,structcheck // reason
+
+	StoreName string
+	StoreType string
+	Tenant    string
+	Username  string
+	AuthMode  string
+	Recipient string
+	Items     int       `pg:",use_zero"`
+	CreatedAt time.Time `pg:"default:now()"`
+}
+
+func NewBackupRecord(record *entities.BackupRecord) *BackupRecord {
+	return &BackupRecord{
+		StoreName: record.StoreName,
+		StoreType: record.StoreType,
+		Tenant:    record.Tenant,
+		Username:  record.Username,
+		AuthMode:  record.AuthMode,
+		Recipient: record.Recipient,
+		Items:     record.Items,
+		CreatedAt: record.CreatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type EncryptedSecret struct {
+	tableName struct{} `pg:"encrypted_secrets"` // nolint:unused,structcheck // reason
+
+	ID         string `pg:",pk"`
+	Version    int    `pg:",pk"`
+	Vault      string `pg:",pk"`
+	Ciphertext []byte
+	Tags       map[string]string
+	CreatedAt  time.Time `pg:"default:now()"`
+	UpdatedAt  time.Time `pg:"default:now()"`
+	DeletedAt  time.Time `pg:",soft_delete"`
+}
+
+func NewEncryptedSecret(secret *entities.EncryptedSecret) (*EncryptedSecret, error) {
+	version, err := strconv.Atoi(secret.Metadata.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedSecret{
+		ID:         secret.ID,
+		Version:    version,
+		Ciphertext: secret.Ciphertext,
+		Tags:       secret.Tags,
+		CreatedAt:  secret.Metadata.CreatedAt,
+		UpdatedAt:  secret.Metadata.UpdatedAt,
+		DeletedAt:  secret.Metadata.DeletedAt,
+	}, nil
+}
+
+func (s *EncryptedSecret) ToEntity() *entities.EncryptedSecret {
+	return &entities.EncryptedSecret{
+		ID:         s.ID,
+		Ciphertext: s.Ciphertext,
+		Tags:       s.Tags,
+		Metadata: &entities.Metadata{
+			Version:   strconv.Itoa(s.Version),
+			CreatedAt: s.CreatedAt,
+			UpdatedAt: s.UpdatedAt,
+			DeletedAt: s.DeletedAt,
+		},
+	}
+}
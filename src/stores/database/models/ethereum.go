@@ -18,10 +18,12 @@ type ETHAccount struct {
 	PublicKey           []byte
 	CompressedPublicKey []byte
 	Tags                map[string]string
-	Disabled            bool
-	CreatedAt           time.Time `pg:"default:now()"`
-	UpdatedAt           time.Time `pg:"default:now()"`
-	DeletedAt           time.Time `pg:",soft_delete"`
+	Disabled            bool `pg:",use_zero"`
+	ExpireAt            time.Time
+	RecoveryPeriod      time.Duration `pg:",use_zero"`
+	CreatedAt           time.Time     `pg:"default:now()"`
+	UpdatedAt           time.Time     `pg:"default:now()"`
+	DeletedAt           time.Time     `pg:",soft_delete"`
 }
 
 func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
@@ -32,6 +34,8 @@ func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
 		CompressedPublicKey: account.CompressedPublicKey,
 		Tags:                account.Tags,
 		Disabled:            account.Metadata.Disabled,
+		ExpireAt:            account.Metadata.ExpireAt,
+		RecoveryPeriod:      account.Metadata.RecoveryPeriod,
 		CreatedAt:           account.Metadata.CreatedAt,
 		UpdatedAt:           account.Metadata.UpdatedAt,
 		DeletedAt:           account.Metadata.DeletedAt,
@@ -40,7 +44,7 @@ func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
 
 func NewETHAccountFromKey(key *entities.Key, attr *entities.Attributes) *entities.ETHAccount {
 	pubKey, _ := crypto.UnmarshalPubkey(key.PublicKey)
-	return &entities.ETHAccount{
+	acc := &entities.ETHAccount{
 		KeyID:               key.ID,
 		Address:             crypto.PubkeyToAddress(*pubKey),
 		Tags:                attr.Tags,
@@ -48,10 +52,17 @@ func NewETHAccountFromKey(key *entities.Key, attr *entities.Attributes) *entitie
 		CompressedPublicKey: crypto.CompressPubkey(pubKey),
 		Metadata: &entities.Metadata{
 			Disabled:  key.Metadata.Disabled,
+			ExpireAt:  key.Metadata.ExpireAt,
 			CreatedAt: key.Metadata.CreatedAt,
 			UpdatedAt: key.Metadata.UpdatedAt,
 		},
 	}
+
+	if attr.Recovery != nil {
+		acc.Metadata.RecoveryPeriod = attr.Recovery.Period
+	}
+
+	return acc
 }
 
 func (eth *ETHAccount) ToEntity() *entities.ETHAccount {
@@ -61,10 +72,12 @@ func (eth *ETHAccount) ToEntity() *entities.ETHAccount {
 		PublicKey:           eth.PublicKey,
 		CompressedPublicKey: eth.CompressedPublicKey,
 		Metadata: &entities.Metadata{
-			Disabled:  eth.Disabled,
-			CreatedAt: eth.CreatedAt,
-			UpdatedAt: eth.UpdatedAt,
-			DeletedAt: eth.DeletedAt,
+			Disabled:       eth.Disabled,
+			ExpireAt:       eth.ExpireAt,
+			RecoveryPeriod: eth.RecoveryPeriod,
+			CreatedAt:      eth.CreatedAt,
+			UpdatedAt:      eth.UpdatedAt,
+			DeletedAt:      eth.DeletedAt,
 		},
 		Tags: eth.Tags,
 	}
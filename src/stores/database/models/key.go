@@ -18,10 +18,16 @@ type Key struct {
 	EllipticCurve    string
 	Tags             map[string]string
 	Annotations      *entities.Annotation
-	Disabled         bool
-	CreatedAt        time.Time `pg:"default:now()"`
-	UpdatedAt        time.Time `pg:"default:now()"`
-	DeletedAt        time.Time `pg:",soft_delete"`
+	Operations       []entities.CryptoOperation
+	Version          string        `pg:",use_zero"`
+	RotationPeriod   time.Duration `pg:",use_zero"`
+	NextRotationAt   time.Time
+	Disabled         bool `pg:",use_zero"`
+	ExpireAt         time.Time
+	RecoveryPeriod   time.Duration `pg:",use_zero"`
+	CreatedAt        time.Time     `pg:"default:now()"`
+	UpdatedAt        time.Time     `pg:"default:now()"`
+	DeletedAt        time.Time     `pg:",soft_delete"`
 }
 
 func NewKey(key *entities.Key) *Key {
@@ -32,7 +38,13 @@ func NewKey(key *entities.Key) *Key {
 		EllipticCurve:    string(key.Algo.EllipticCurve),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
+		Operations:       key.Operations,
+		Version:          key.Metadata.Version,
+		RotationPeriod:   key.RotationPeriod,
+		NextRotationAt:   key.NextRotationAt,
 		Disabled:         key.Metadata.Disabled,
+		ExpireAt:         key.Metadata.ExpireAt,
+		RecoveryPeriod:   key.Metadata.RecoveryPeriod,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
 		DeletedAt:        key.Metadata.DeletedAt,
@@ -47,13 +59,38 @@ func (k *Key) ToEntity() *entities.Key {
 			Type:          entities2.KeyType(k.SigningAlgorithm),
 			EllipticCurve: entities2.Curve(k.EllipticCurve),
 		},
-		Tags:        k.Tags,
-		Annotations: k.Annotations,
+		Tags:           k.Tags,
+		Annotations:    k.Annotations,
+		Operations:     k.Operations,
+		RotationPeriod: k.RotationPeriod,
+		NextRotationAt: k.NextRotationAt,
 		Metadata: &entities.Metadata{
-			Disabled:  k.Disabled,
-			CreatedAt: k.CreatedAt,
-			UpdatedAt: k.UpdatedAt,
-			DeletedAt: k.DeletedAt,
+			Version:        k.Version,
+			Disabled:       k.Disabled,
+			ExpireAt:       k.ExpireAt,
+			RecoveryPeriod: k.RecoveryPeriod,
+			CreatedAt:      k.CreatedAt,
+			UpdatedAt:      k.UpdatedAt,
+			DeletedAt:      k.DeletedAt,
 		},
 	}
 }
+
+type KeyVersion struct {
+	tableName struct{} `pg:"key_versions"` // nolint:unused,structcheck // reason
+
+	ID        string `pg:",pk"`
+	Version   string `pg:",pk,use_zero"`
+	StoreID   string `pg:",pk"`
+	PublicKey []byte
+	CreatedAt time.Time `pg:"default:now()"`
+}
+
+func NewKeyVersion(key *entities.Key) *KeyVersion {
+	return &KeyVersion{
+		ID:        key.ID,
+		Version:   key.Metadata.Version,
+		PublicKey: key.PublicKey,
+		CreatedAt: key.Metadata.UpdatedAt,
+	}
+}
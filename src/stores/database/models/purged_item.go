@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type PurgedItem struct {
+	tableName struct{} `pg:"purged_items"` // nolint:unused,structcheck // reason
+
+	StoreName string
+	StoreType string
+	ID        string
+	DeletedAt time.Time
+	PurgedAt  time.Time `pg:"default:now()"`
+}
+
+func NewPurgedItem(item *entities.PurgedItem) *PurgedItem {
+	return &PurgedItem{
+		StoreName: item.StoreName,
+		StoreType: item.StoreType,
+		ID:        item.ID,
+		DeletedAt: item.DeletedAt,
+		PurgedAt:  item.PurgedAt,
+	}
+}
@@ -9,38 +9,44 @@ import (
 type Secret struct {
 	tableName struct{} `pg:"secrets"` // nolint:unused,structcheck // reason
 
-	ID        string `pg:",pk"`
-	Version   string `pg:",pk"`
-	StoreID   string `pg:",pk"`
-	Tags      map[string]string
-	Disabled  bool
-	CreatedAt time.Time `pg:"default:now()"`
-	UpdatedAt time.Time `pg:"default:now()"`
-	DeletedAt time.Time `pg:",soft_delete"`
+	ID             string `pg:",pk"`
+	Version        string `pg:",pk"`
+	StoreID        string `pg:",pk"`
+	Tags           map[string]string
+	ValueType      string `pg:"default:'string'"`
+	Disabled       bool
+	RecoveryPeriod time.Duration `pg:",use_zero"`
+	CreatedAt      time.Time     `pg:"default:now()"`
+	UpdatedAt      time.Time     `pg:"default:now()"`
+	DeletedAt      time.Time     `pg:",soft_delete"`
 }
 
 func NewSecret(secret *entities.Secret) *Secret {
 	return &Secret{
-		ID:        secret.ID,
-		Version:   secret.Metadata.Version,
-		Tags:      secret.Tags,
-		Disabled:  secret.Metadata.Disabled,
-		CreatedAt: secret.Metadata.CreatedAt,
-		UpdatedAt: secret.Metadata.UpdatedAt,
-		DeletedAt: secret.Metadata.DeletedAt,
+		ID:             secret.ID,
+		Version:        secret.Metadata.Version,
+		Tags:           secret.Tags,
+		ValueType:      string(secret.ValueType),
+		Disabled:       secret.Metadata.Disabled,
+		RecoveryPeriod: secret.Metadata.RecoveryPeriod,
+		CreatedAt:      secret.Metadata.CreatedAt,
+		UpdatedAt:      secret.Metadata.UpdatedAt,
+		DeletedAt:      secret.Metadata.DeletedAt,
 	}
 }
 
 func (s *Secret) ToEntity() *entities.Secret {
 	return &entities.Secret{
-		ID:   s.ID,
-		Tags: s.Tags,
+		ID:        s.ID,
+		Tags:      s.Tags,
+		ValueType: entities.SecretValueType(s.ValueType),
 		Metadata: &entities.Metadata{
-			Version:   s.Version,
-			Disabled:  s.Disabled,
-			CreatedAt: s.CreatedAt,
-			UpdatedAt: s.UpdatedAt,
-			DeletedAt: s.DeletedAt,
+			Version:        s.Version,
+			Disabled:       s.Disabled,
+			RecoveryPeriod: s.RecoveryPeriod,
+			CreatedAt:      s.CreatedAt,
+			UpdatedAt:      s.UpdatedAt,
+			DeletedAt:      s.DeletedAt,
 		},
 	}
 }
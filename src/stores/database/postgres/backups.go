@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type Backups struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.Backups = &Backups{}
+
+func NewBackups(db postgres.Client, logger log.Logger) *Backups {
+	return &Backups{
+		logger: logger,
+		client: db,
+	}
+}
+
+func (b *Backups) Add(ctx context.Context, record *entities.BackupRecord) error {
+	err := b.client.Insert(ctx, models.NewBackupRecord(record))
+	if err != nil {
+		errMessage := "failed to record backup"
+		b.logger.With("store_name", record.StoreName).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
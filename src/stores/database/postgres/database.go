@@ -39,6 +39,34 @@ func (db *Database) Ping(ctx context.Context) error {
 	return nil
 }
 
+// RunExclusively holds a transaction level advisory lock while the function runs, the transaction is only used for the
+// lock and the function performs its own queries
+func (db *Database) RunExclusively(ctx context.Context, name string, run func(ctx context.Context) error) error {
+	var runErr error
+	err := db.client.RunInTransaction(ctx, func(dbtx postgres.Client) error {
+		var locked []bool
+		err := dbtx.Query(ctx, &locked, "SELECT pg_try_advisory_xact_lock(hashtext(?))", name)
+		if err != nil {
+			return err
+		}
+
+		if len(locked) == 0 || !locked[0] {
+			db.logger.Debug("lock held by another instance, skipping run", "lock", name)
+			return nil
+		}
+
+		runErr = run(ctx)
+		return nil
+	})
+	if err != nil {
+		errMessage := "failed to acquire lock"
+		db.logger.With("lock", name).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return runErr
+}
+
 func (db *Database) Keys(storeID string) database.Keys {
 	return NewKeys(storeID, db.client, db.logger.With("store_id", storeID))
 }
@@ -46,3 +74,15 @@ func (db *Database) Keys(storeID string) database.Keys {
 func (db *Database) Secrets(storeID string) database.Secrets {
 	return NewSecrets(storeID, db.client, db.logger.With("store_id", storeID))
 }
+
+func (db *Database) EncryptedSecrets(vault string) database.EncryptedSecrets {
+	return NewEncryptedSecrets(vault, db.client, db.logger.With("vault", vault))
+}
+
+func (db *Database) PurgedItems() database.PurgedItems {
+	return NewPurgedItems(db.client, db.logger)
+}
+
+func (db *Database) Backups() database.Backups {
+	return NewBackups(db.client, db.logger)
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type EncryptedSecrets struct {
+	vault  string
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.EncryptedSecrets = &EncryptedSecrets{}
+
+func NewEncryptedSecrets(vault string, db postgres.Client, logger log.Logger) *EncryptedSecrets {
+	return &EncryptedSecrets{
+		vault:  vault,
+		logger: logger,
+		client: db,
+	}
+}
+
+func (s EncryptedSecrets) RunInTransaction(ctx context.Context, persist func(dbtx database.EncryptedSecrets) error) error {
+	return s.client.RunInTransaction(ctx, func(dbTx postgres.Client) error {
+		s.client = dbTx
+		return persist(&s)
+	})
+}
+
+func (s *EncryptedSecrets) Get(ctx context.Context, id, version string) (*entities.EncryptedSecret, error) {
+	logger := s.logger.With("id", id, "version", version)
+
+	item := &models.EncryptedSecret{ID: id, Vault: s.vault}
+	var err error
+	if version == "" {
+		err = s.client.QueryOne(ctx, &item.Version,
+			"SELECT version FROM encrypted_secrets WHERE id = ? AND vault = ? AND deleted_at IS NULL ORDER BY version DESC LIMIT 1", id, s.vault)
+		if err != nil {
+			errMessage := "failed to get latest encrypted secret version"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.FromError(err).SetMessage(errMessage)
+		}
+	} else {
+		item.Version, err = strconv.Atoi(version)
+		if err != nil {
+			errMessage := "version must be a number"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+	}
+
+	err = s.client.SelectPK(ctx, item)
+	if err != nil {
+		errMessage := "failed to get encrypted secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return item.ToEntity(), nil
+}
+
+func (s *EncryptedSecrets) GetDeleted(ctx context.Context, id string) (*entities.EncryptedSecret, error) {
+	var items []*models.EncryptedSecret
+	err := s.client.SelectDeletedWhere(ctx, &items, "id = ? AND vault = ?", id, s.vault)
+	if err != nil {
+		errMessage := "failed to get deleted encrypted secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(items) == 0 {
+		errMessage := "deleted encrypted secret not found"
+		s.logger.With("id", id).Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	latest := items[0]
+	for _, item := range items {
+		if item.Version > latest.Version {
+			latest = item
+		}
+	}
+
+	return latest.ToEntity(), nil
+}
+
+func (s *EncryptedSecrets) GetLatestVersion(ctx context.Context, id string) (int, error) {
+	var version int
+	// Deleted versions are also considered so versions are never reused
+	err := s.client.QueryOne(ctx, &version,
+		"SELECT COALESCE(MAX(version), 0) FROM encrypted_secrets WHERE id = ? AND vault = ?", id, s.vault)
+	if err != nil {
+		errMessage := "failed to get latest encrypted secret version"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return version, nil
+}
+
+func (s *EncryptedSecrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
+	deletedCond := "deleted_at IS NULL"
+	if isDeleted {
+		deletedCond = "deleted_at IS NOT NULL"
+	}
+
+	query := "SELECT id FROM encrypted_secrets WHERE vault = ? AND " + deletedCond + " GROUP BY id ORDER BY MIN(created_at) ASC"
+	if limit != 0 || offset != 0 {
+		query += " LIMIT " + strconv.FormatUint(limit, 10) + " OFFSET " + strconv.FormatUint(offset, 10)
+	}
+
+	var ids []string
+	err := s.client.Query(ctx, &ids, query, s.vault)
+	if err != nil {
+		errMessage := "failed to list encrypted secret ids"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+func (s *EncryptedSecrets) Add(ctx context.Context, secret *entities.EncryptedSecret) (*entities.EncryptedSecret, error) {
+	itemModel, err := models.NewEncryptedSecret(secret)
+	if err != nil {
+		errMessage := "invalid encrypted secret version"
+		s.logger.With("id", secret.ID).WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+	itemModel.Vault = s.vault
+	itemModel.CreatedAt = time.Now()
+	itemModel.UpdatedAt = time.Now()
+
+	err = s.client.Insert(ctx, itemModel)
+	if err != nil {
+		errMessage := "failed to add encrypted secret"
+		s.logger.With("id", itemModel.ID, "version", itemModel.Version).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return itemModel.ToEntity(), nil
+}
+
+func (s *EncryptedSecrets) Delete(ctx context.Context, id string) error {
+	err := s.client.DeleteWhere(ctx, &models.EncryptedSecret{}, "id = ? AND vault = ?", id, s.vault)
+	if err != nil {
+		errMessage := "failed to delete encrypted secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *EncryptedSecrets) Restore(ctx context.Context, id string) error {
+	err := s.client.UndeleteWhere(ctx, &models.EncryptedSecret{}, "id = ? AND vault = ?", id, s.vault)
+	if err != nil {
+		errMessage := "failed to restore encrypted secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *EncryptedSecrets) RestoreVersion(ctx context.Context, id, version string) error {
+	err := s.client.UndeleteWhere(ctx, &models.EncryptedSecret{}, "id = ? AND version = ? AND vault = ?", id, version, s.vault)
+	if err != nil {
+		errMessage := "failed to restore encrypted secret version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *EncryptedSecrets) Purge(ctx context.Context, id string) error {
+	err := s.client.ForceDeleteWhere(ctx, &models.EncryptedSecret{}, "id = ? AND vault = ?", id, s.vault)
+	if err != nil {
+		errMessage := "failed to permanently delete encrypted secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *EncryptedSecrets) PurgeVersion(ctx context.Context, id, version string) error {
+	err := s.client.ForceDeleteWhere(ctx, &models.EncryptedSecret{}, "id = ? AND version = ? AND vault = ?", id, version, s.vault)
+	if err != nil {
+		errMessage := "failed to permanently delete encrypted secret version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
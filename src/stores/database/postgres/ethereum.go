@@ -23,6 +23,8 @@ type ETHAccounts struct {
 
 var _ database.ETHAccounts = &ETHAccounts{}
 
+const ethAccountColumns = "address, store_id, key_id, public_key, compressed_public_key, tags, disabled, expire_at, recovery_period, created_at, updated_at, deleted_at"
+
 func NewETHAccounts(storeID string, db postgres.Client, logger log.Logger) *ETHAccounts {
 	return &ETHAccounts{
 		storeID: storeID,
@@ -111,6 +113,43 @@ func (ea *ETHAccounts) SearchAddresses(ctx context.Context, isDeleted bool, limi
 	return ids, nil
 }
 
+func (ea *ETHAccounts) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.ETHAccount, error) {
+	var accModels []*models.ETHAccount
+	query, args := searchQuery(ethAccountColumns, "eth_accounts", "address", []string{"store_id = ?"}, []interface{}{ea.storeID}, filter)
+	err := ea.client.Query(ctx, &accModels, query, args...)
+	if err != nil {
+		errMessage := "failed to search ethereum accounts"
+		ea.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	accounts := []*entities.ETHAccount{}
+	for _, acc := range accModels {
+		accounts = append(accounts, acc.ToEntity())
+	}
+
+	if filter.Cursor != nil && filter.Cursor.Backward {
+		for i, j := 0, len(accounts)-1; i < j; i, j = i+1, j-1 {
+			accounts[i], accounts[j] = accounts[j], accounts[i]
+		}
+	}
+
+	return accounts, nil
+}
+
+func (ea *ETHAccounts) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	var count uint64
+	query, args := countQuery("eth_accounts", []string{"store_id = ?"}, []interface{}{ea.storeID}, filter)
+	err := ea.client.Query(ctx, &count, query, args...)
+	if err != nil {
+		errMessage := "failed to count ethereum accounts"
+		ea.logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return count, nil
+}
+
 func (ea *ETHAccounts) Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
 	accModel := models.NewETHAccount(account)
 	accModel.StoreID = ea.storeID
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	"github.com/consensys/quorum-key-manager/src/stores/database/models"
@@ -23,6 +24,8 @@ type Keys struct {
 
 var _ database.Keys = &Keys{}
 
+const keyColumns = "id, store_id, public_key, signing_algorithm, elliptic_curve, tags, annotations, version, rotation_period, next_rotation_at, operations, disabled, expire_at, recovery_period, created_at, updated_at, deleted_at"
+
 func NewKeys(storeID string, db postgres.Client, logger log.Logger) *Keys {
 	return &Keys{
 		storeID: storeID,
@@ -51,6 +54,31 @@ func (k *Keys) Get(ctx context.Context, id string) (*entities.Key, error) {
 	return key.ToEntity(), nil
 }
 
+// GetVersion returns the key with the public key of one of its versions, the empty version designates the latest one.
+// Keys indexed before versioning have an empty version until they are rotated, see migration 000008
+func (k *Keys) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	key, err := k.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == "" || version == key.Metadata.Version {
+		return key, nil
+	}
+
+	keyVersion := &models.KeyVersion{ID: id, Version: version, StoreID: k.storeID}
+	err = k.client.SelectPK(ctx, keyVersion)
+	if err != nil {
+		errMessage := "failed to get key version"
+		k.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	key.PublicKey = keyVersion.PublicKey
+	key.Metadata.Version = keyVersion.Version
+	return key, nil
+}
+
 func (k *Keys) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
 	key := &models.Key{ID: id, StoreID: k.storeID}
 
@@ -100,6 +128,19 @@ func (k *Keys) GetAllDeleted(ctx context.Context) ([]*entities.Key, error) {
 	return keys, nil
 }
 
+func (k *Keys) ListVersions(ctx context.Context, id string) ([]string, error) {
+	var versions []string
+	err := k.client.Query(ctx, &versions,
+		"SELECT array_agg(version ORDER BY created_at ASC) FROM key_versions WHERE id = ? AND store_id = ?", id, k.storeID)
+	if err != nil {
+		errMessage := "failed to list key versions"
+		k.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return versions, nil
+}
+
 func (k *Keys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
 	ids, err := client.QuerySearchIDs(ctx, k.client, "keys", "id", "store_id = ?", []interface{}{k.storeID}, isDeleted, limit, offset)
 	if err != nil {
@@ -111,11 +152,91 @@ func (k *Keys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint
 	return ids, nil
 }
 
+func (k *Keys) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Key, error) {
+	conds, args := k.searchConditions(filter)
+
+	var keyModels []*models.Key
+	query, args := searchQuery(keyColumns, "keys", "id", conds, args, filter)
+	err := k.client.Query(ctx, &keyModels, query, args...)
+	if err != nil {
+		errMessage := "failed to search keys"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	keys := []*entities.Key{}
+	for _, key := range keyModels {
+		keys = append(keys, key.ToEntity())
+	}
+
+	if filter.Cursor != nil && filter.Cursor.Backward {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+
+	return keys, nil
+}
+
+func (k *Keys) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	conds, args := k.searchConditions(filter)
+
+	var count uint64
+	query, args := countQuery("keys", conds, args, filter)
+	err := k.client.Query(ctx, &count, query, args...)
+	if err != nil {
+		errMessage := "failed to count keys"
+		k.logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return count, nil
+}
+
+func (k *Keys) SearchRotationDue(ctx context.Context, before time.Time) ([]*entities.Key, error) {
+	var keyModels []*models.Key
+	err := k.client.Query(ctx, &keyModels,
+		"SELECT "+keyColumns+" FROM keys WHERE store_id = ? AND next_rotation_at <= ? AND deleted_at IS NULL ORDER BY next_rotation_at ASC", k.storeID, before)
+	if err != nil {
+		errMessage := "failed to search keys due for rotation"
+		k.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	keys := []*entities.Key{}
+	for _, key := range keyModels {
+		keys = append(keys, key.ToEntity())
+	}
+
+	return keys, nil
+}
+
+func (k *Keys) searchConditions(filter *entities.SearchFilter) ([]string, []interface{}) {
+	conds, args := []string{"store_id = ?"}, []interface{}{k.storeID}
+	if filter.SigningAlgorithm != "" {
+		conds, args = append(conds, "signing_algorithm = ?"), append(args, filter.SigningAlgorithm)
+	}
+	if filter.Curve != "" {
+		conds, args = append(conds, "elliptic_curve = ?"), append(args, filter.Curve)
+	}
+
+	return conds, args
+}
+
 func (k *Keys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	keyModel := models.NewKey(key)
 	keyModel.StoreID = k.storeID
+	keyVersion := models.NewKeyVersion(key)
+	keyVersion.StoreID = k.storeID
+
+	err := k.client.RunInTransaction(ctx, func(dbtx postgres.Client) error {
+		derr := dbtx.Insert(ctx, keyModel)
+		if derr != nil {
+			return derr
+		}
 
-	err := k.client.Insert(ctx, keyModel)
+		return dbtx.Insert(ctx, keyVersion)
+	})
 	if err != nil {
 		errMessage := "failed to add key"
 		k.logger.With("id", key.ID).WithError(err).Error(errMessage)
@@ -125,6 +246,30 @@ func (k *Keys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error
 	return keyModel.ToEntity(), nil
 }
 
+// AddVersion adds the new version of a rotated key and makes it the current version of the key
+func (k *Keys) AddVersion(ctx context.Context, key *entities.Key) (*entities.Key, error) {
+	keyModel := models.NewKey(key)
+	keyModel.StoreID = k.storeID
+	keyVersion := models.NewKeyVersion(key)
+	keyVersion.StoreID = k.storeID
+
+	err := k.client.RunInTransaction(ctx, func(dbtx postgres.Client) error {
+		derr := dbtx.Insert(ctx, keyVersion)
+		if derr != nil {
+			return derr
+		}
+
+		return dbtx.UpdatePK(ctx, keyModel)
+	})
+	if err != nil {
+		errMessage := "failed to add key version"
+		k.logger.With("id", key.ID, "version", key.Metadata.Version).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return keyModel.ToEntity(), nil
+}
+
 func (k *Keys) Update(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	keyModel := models.NewKey(key)
 	keyModel.StoreID = k.storeID
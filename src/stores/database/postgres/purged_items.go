@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/database/models"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+type PurgedItems struct {
+	logger log.Logger
+	client postgres.Client
+}
+
+var _ database.PurgedItems = &PurgedItems{}
+
+func NewPurgedItems(db postgres.Client, logger log.Logger) *PurgedItems {
+	return &PurgedItems{
+		logger: logger,
+		client: db,
+	}
+}
+
+func (p *PurgedItems) Add(ctx context.Context, item *entities.PurgedItem) error {
+	err := p.client.Insert(ctx, models.NewPurgedItem(item))
+	if err != nil {
+		errMessage := "failed to record purged item"
+		p.logger.With("store_name", item.StoreName, "id", item.ID).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// searchQuery builds the query selecting columns from the relation matching the conditions and the search filter
+func searchQuery(columns, relation, idCol string, conds []string, args []interface{}, filter *entities.SearchFilter) (string, []interface{}) {
+	conds, args = searchConditions(conds, args, filter)
+
+	sortCol := entities.SortByCreatedAt
+	switch filter.SortBy {
+	case entities.SortByUpdatedAt:
+		sortCol = entities.SortByUpdatedAt
+	case entities.SortByID:
+		sortCol = idCol
+	}
+
+	// Listing backward reverses the sort order, the results are reversed back by the caller
+	desc := filter.SortDesc
+	if filter.Cursor != nil {
+		desc = desc != filter.Cursor.Backward
+
+		operator := ">"
+		if desc {
+			operator = "<"
+		}
+		if sortCol == idCol {
+			conds, args = append(conds, fmt.Sprintf("%s %s ?", idCol, operator)), append(args, filter.Cursor.ID)
+		} else {
+			conds = append(conds, fmt.Sprintf("(%s, %s) %s (?::timestamptz, ?)", sortCol, idCol, operator))
+			args = append(args, filter.Cursor.Value, filter.Cursor.ID)
+		}
+	}
+
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s ORDER BY %s %s, %s %s", columns, relation, strings.Join(conds, " AND "), sortCol, direction, idCol, direction)
+	if filter.Limit != 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, filter.Limit)
+	}
+	if filter.Offset != 0 && filter.Cursor == nil {
+		query = fmt.Sprintf("%s OFFSET %d", query, filter.Offset)
+	}
+
+	return query, args
+}
+
+// countQuery builds the query counting the items of the relation matching the conditions and the search filter,
+// regardless of the pagination
+func countQuery(relation string, conds []string, args []interface{}, filter *entities.SearchFilter) (string, []interface{}) {
+	conds, args = searchConditions(conds, args, filter)
+
+	return fmt.Sprintf("SELECT count(*) FROM %s WHERE %s", relation, strings.Join(conds, " AND ")), args
+}
+
+func searchConditions(conds []string, args []interface{}, filter *entities.SearchFilter) ([]string, []interface{}) {
+	if filter.Deleted {
+		conds = append(conds, "deleted_at IS NOT NULL")
+	} else {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+
+	tagKeys := make([]string, 0, len(filter.Tags))
+	for k := range filter.Tags {
+		tagKeys = append(tagKeys, k)
+	}
+	sort.Strings(tagKeys)
+	for _, k := range tagKeys {
+		// Containment is supported by the GIN index on tags
+		bTag, _ := json.Marshal(map[string]string{k: filter.Tags[k]})
+		conds, args = append(conds, "tags @> ?::jsonb"), append(args, string(bTag))
+	}
+
+	for _, k := range filter.TagKeys {
+		conds, args = append(conds, `tags \? ?`), append(args, k)
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		conds, args = append(conds, "created_at >= ?"), append(args, filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		conds, args = append(conds, "created_at < ?"), append(args, filter.CreatedBefore)
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		conds, args = append(conds, "updated_at >= ?"), append(args, filter.UpdatedAfter)
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		conds, args = append(conds, "updated_at < ?"), append(args, filter.UpdatedBefore)
+	}
+
+	return conds, args
+}
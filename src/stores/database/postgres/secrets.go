@@ -24,6 +24,13 @@ type Secrets struct {
 
 var _ database.Secrets = &Secrets{}
 
+const (
+	secretColumns = "id, version, store_id, tags, value_type, disabled, recovery_period, created_at, updated_at, deleted_at"
+	// isLatestSecretVersion filters the latest version of every secret directly on the secrets table, so that the
+	// conditions on tags can use their GIN index
+	isLatestSecretVersion = "NOT EXISTS (SELECT 1 FROM secrets AS newer WHERE newer.id = secrets.id AND newer.store_id = secrets.store_id AND newer.created_at > secrets.created_at)"
+)
+
 func NewSecrets(storeID string, db postgres.Client, logger log.Logger) *Secrets {
 	return &Secrets{
 		storeID: storeID,
@@ -107,6 +114,44 @@ func (s *Secrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset u
 	return ids, nil
 }
 
+// Search filters secrets on their latest version
+func (s *Secrets) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Secret, error) {
+	var secretModels []*models.Secret
+	query, args := searchQuery(secretColumns, "secrets", "id", []string{"store_id = ?", isLatestSecretVersion}, []interface{}{s.storeID}, filter)
+	err := s.client.Query(ctx, &secretModels, query, args...)
+	if err != nil {
+		errMessage := "failed to search secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	secrets := []*entities.Secret{}
+	for _, secret := range secretModels {
+		secrets = append(secrets, secret.ToEntity())
+	}
+
+	if filter.Cursor != nil && filter.Cursor.Backward {
+		for i, j := 0, len(secrets)-1; i < j; i, j = i+1, j-1 {
+			secrets[i], secrets[j] = secrets[j], secrets[i]
+		}
+	}
+
+	return secrets, nil
+}
+
+func (s *Secrets) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	var count uint64
+	query, args := countQuery("secrets", []string{"store_id = ?", isLatestSecretVersion}, []interface{}{s.storeID}, filter)
+	err := s.client.Query(ctx, &count, query, args...)
+	if err != nil {
+		errMessage := "failed to count secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return count, nil
+}
+
 func (s *Secrets) ListVersions(ctx context.Context, id string, isDeleted bool) ([]string, error) {
 	var versions []string
 	var err error
@@ -127,6 +172,39 @@ func (s *Secrets) ListVersions(ctx context.Context, id string, isDeleted bool) (
 	return versions, nil
 }
 
+func (s *Secrets) GetAllVersions(ctx context.Context, id string) ([]*entities.Secret, error) {
+	var itemModels []*models.Secret
+
+	err := s.client.Query(ctx, &itemModels,
+		"SELECT "+secretColumns+" FROM secrets WHERE id = ? AND store_id = ? ORDER BY created_at ASC", id, s.storeID)
+	if err != nil {
+		errMessage := "failed to get all secret versions"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	var items []*entities.Secret
+	for _, item := range itemModels {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
+// Lock locks the ID of a secret until the end of the transaction so concurrent writes are serialized. An advisory lock
+// is taken rather than row locks, which do not serialize the creation of a secret that has no version yet
+func (s *Secrets) Lock(ctx context.Context, id string) error {
+	var locked []int
+	err := s.client.Query(ctx, &locked, "SELECT 1 FROM pg_advisory_xact_lock(hashtext(? || '/' || ?))", s.storeID, id)
+	if err != nil {
+		errMessage := "failed to lock secret"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
 func (s *Secrets) GetAll(ctx context.Context) ([]*entities.Secret, error) {
 	var itemModels []*models.Secret
 
@@ -216,6 +294,17 @@ func (s *Secrets) Restore(ctx context.Context, id string) error {
 	return nil
 }
 
+func (s *Secrets) RestoreVersion(ctx context.Context, id, version string) error {
+	err := s.client.UndeleteWhere(ctx, &models.Secret{}, "id = ? AND version = ? AND store_id = ?", id, version, s.storeID)
+	if err != nil {
+		errMessage := "failed to restore secret version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
 func (s *Secrets) Purge(ctx context.Context, id string) error {
 	err := s.client.ForceDeleteWhere(ctx, &models.Secret{ID: id, StoreID: s.storeID}, "id = ?", id)
 	if err != nil {
@@ -226,3 +315,14 @@ func (s *Secrets) Purge(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *Secrets) PurgeVersion(ctx context.Context, id, version string) error {
+	err := s.client.ForceDeleteWhere(ctx, &models.Secret{}, "id = ? AND version = ? AND store_id = ?", id, version, s.storeID)
+	if err != nil {
+		errMessage := "failed to permanently delete secret version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
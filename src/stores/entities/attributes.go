@@ -18,23 +18,32 @@ type Attributes struct {
 	// Operations supported by a stored item (e.g. sign, encrypt...)
 	Operations []CryptoOperation
 
-	// Disabled whether item is disabled
-	Disabled bool
+	// Disabled whether item is disabled, nil to keep the current state
+	Disabled *bool
 
 	// TTL
 	TTL time.Duration
 
+	// ExpireAt date after which the item can no longer be used, nil to keep the current one
+	ExpireAt *time.Time
+
 	// Recovery policy about a key after being deleted before being destroyed
 	Recovery *Recovery
 
 	// Tags attached to a stored item
 	Tags map[string]string
+
+	// RotationPeriod of a key, nil to keep the current one
+	RotationPeriod *time.Duration
+
+	// ValueType encoding of the value of a secret, a string if empty
+	ValueType SecretValueType
 }
 
 type Recovery struct {
 	// Policy for recovery
 	Policy RecoveryPolicy
 
-	// Period for recovery
-	Period time.Time
+	// Period during which a deleted item can be recovered before being destroyed
+	Period time.Duration
 }
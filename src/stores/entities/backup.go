@@ -0,0 +1,48 @@
+package entities
+
+import "time"
+
+// Backup is the content of the archive of a store, its items are stored along with their index
+type Backup struct {
+	StoreType   string
+	Secrets     []*Secret
+	Keys        []*BackedUpKey
+	ETHAccounts []*BackedUpETHAccount
+}
+
+// BackedUpKey is a key of a backup along with its private key
+type BackedUpKey struct {
+	*Key
+	PrivateKey []byte
+}
+
+// BackedUpETHAccount is an Ethereum account of a backup along with its private key
+type BackedUpETHAccount struct {
+	*ETHAccount
+	PrivateKey []byte
+}
+
+// BackupEncryption encrypts an archive either with a passphrase or for the secp256k1 public key of a recipient
+type BackupEncryption struct {
+	Passphrase string
+	PublicKey  []byte
+}
+
+// BackupDecryption decrypts an archive either with its passphrase or with the secp256k1 private key of its recipient
+type BackupDecryption struct {
+	Passphrase string
+	PrivateKey []byte
+}
+
+// BackupRecord is the audit record of a backup of a store
+type BackupRecord struct {
+	StoreName string
+	StoreType string
+	Tenant    string
+	Username  string
+	AuthMode  string
+	// Recipient is the hex encoded public key the backup is encrypted for, empty for backups encrypted with a passphrase
+	Recipient string
+	Items     int
+	CreatedAt time.Time
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "github.com/consensys/quorum-key-manager/src/entities"
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
 
 // Key public part of a key
 type Key struct {
@@ -10,8 +14,37 @@ type Key struct {
 	Metadata    *Metadata
 	Tags        map[string]string
 	Annotations *Annotation
+	// Operations allowed on the key, all operations are allowed when empty
+	Operations []CryptoOperation
+	// RotationPeriod after which a new version of the key is automatically created, no rotation when zero
+	RotationPeriod time.Duration
+	NextRotationAt time.Time
 }
 
 func (k *Key) IsETHAccount() bool {
 	return k.Algo.EllipticCurve == entities.Secp256k1 && k.Algo.Type == entities.Ecdsa
 }
+
+// AllowsOperation indicates whether the key can be used for the given crypto operation
+func (k *Key) AllowsOperation(op CryptoOperation) bool {
+	if len(k.Operations) == 0 {
+		return true
+	}
+
+	for _, allowed := range k.Operations {
+		if allowed == op {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetRotationPeriod sets the rotation period of the key and schedules its next rotation from now
+func (k *Key) SetRotationPeriod(period time.Duration) {
+	k.RotationPeriod = period
+	k.NextRotationAt = time.Time{}
+	if period > 0 {
+		k.NextRotationAt = time.Now().UTC().Add(period)
+	}
+}
@@ -9,4 +9,11 @@ type Metadata struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt time.Time
+	// RecoveryPeriod after which a deleted item is destroyed, the store recovery period applies when zero
+	RecoveryPeriod time.Duration
+}
+
+// IsExpired indicates whether the item has an expiration date in the past
+func (m *Metadata) IsExpired() bool {
+	return !m.ExpireAt.IsZero() && time.Now().After(m.ExpireAt)
 }
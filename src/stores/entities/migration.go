@@ -0,0 +1,22 @@
+package entities
+
+// MigrationStatus is the outcome of the migration of an item
+type MigrationStatus string
+
+const (
+	MigrationStatusMigrated MigrationStatus = "migrated"
+	// MigrationStatusPending is the status of the items that would be migrated in dry run mode
+	MigrationStatusPending MigrationStatus = "pending"
+	// MigrationStatusSkipped is the status of the items already existing in the destination store
+	MigrationStatusSkipped MigrationStatus = "skipped"
+	MigrationStatusFailed  MigrationStatus = "failed"
+	// MigrationStatusRestored is the status of the items restored from a backup
+	MigrationStatusRestored MigrationStatus = "restored"
+)
+
+// MigratedItem is the result of the migration of an item from a store to another, or of its restoration from a backup
+type MigratedItem struct {
+	ID     string
+	Status MigrationStatus
+	Error  string
+}
@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// PurgedItem is a deleted item destroyed once its recovery period has elapsed
+type PurgedItem struct {
+	StoreName string
+	StoreType string
+	ID        string
+	DeletedAt time.Time
+	// PurgedAt is empty for items that would be purged in dry run mode
+	PurgedAt time.Time
+}
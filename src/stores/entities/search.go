@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByID        = "id"
+)
+
+// SearchFilter restricts and orders the items listed from the index of a store, zero values do not filter
+type SearchFilter struct {
+	Deleted bool
+
+	// Tags are matched by equality, TagKeys by existence
+	Tags    map[string]string
+	TagKeys []string
+
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+
+	// SigningAlgorithm and Curve only apply to keys
+	SigningAlgorithm string
+	Curve            string
+
+	// SortBy defaults to the creation date
+	SortBy   string
+	SortDesc bool
+
+	// Cursor lists the items after, or before, the given position of the sort order instead of using an offset
+	Cursor *entities.Cursor
+
+	Limit  uint64
+	Offset uint64
+}
@@ -1,8 +1,64 @@
 package entities
 
+// SecretValueType encoding of the value of a secret
+type SecretValueType string
+
+const (
+	StringSecretValue SecretValueType = "string"
+	Base64SecretValue SecretValueType = "base64"
+	JSONSecretValue   SecretValueType = "json"
+)
+
+// ParseSecretValueType returns the value type stored along a secret in a vault, empty if it is not a known type
+func ParseSecretValueType(valueType string) SecretValueType {
+	switch t := SecretValueType(valueType); t {
+	case StringSecretValue, Base64SecretValue, JSONSecretValue:
+		return t
+	default:
+		return ""
+	}
+}
+
+// SecretGenerator kind of value generated for a secret
+type SecretGenerator string
+
+const (
+	StringGenerator SecretGenerator = "string"
+	BytesGenerator  SecretGenerator = "bytes"
+	UUIDGenerator   SecretGenerator = "uuid"
+	RSAGenerator    SecretGenerator = "rsa"
+	ECDSAGenerator  SecretGenerator = "ecdsa"
+)
+
 type Secret struct {
-	ID       string
-	Value    string
-	Metadata *Metadata
-	Tags     map[string]string
+	ID        string
+	Value     string
+	ValueType SecretValueType
+	Metadata  *Metadata
+	Tags      map[string]string
+}
+
+// EncryptedSecret is a secret whose value is encrypted, as persisted by vaults storing data in the database
+type EncryptedSecret struct {
+	ID         string
+	Ciphertext []byte
+	Metadata   *Metadata
+	Tags       map[string]string
+}
+
+// SecretPolicy describes how the value of a generated secret is produced
+type SecretPolicy struct {
+	Generator SecretGenerator
+
+	// Length of string values in characters, or of bytes values
+	Length int
+
+	// Charset the characters of string values are drawn from
+	Charset string
+
+	// KeySize of RSA key pairs in bits
+	KeySize int
+
+	// Curve of ECDSA key pairs
+	Curve string
 }
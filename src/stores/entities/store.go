@@ -1,5 +1,7 @@
 package entities
 
+import "time"
+
 const (
 	EthereumStoreType = "ethereum"
 	KeyStoreType      = "key"
@@ -11,4 +13,6 @@ type Store struct {
 	AllowedTenants []string
 	Store          interface{}
 	StoreType      string
+	// RecoveryPeriod after which deleted items are destroyed, deleted items are kept forever when zero
+	RecoveryPeriod time.Duration
 }
@@ -59,7 +59,6 @@ func FakeAttributes() *entities.Attributes {
 		Operations: []entities.CryptoOperation{
 			entities.Signing, entities.Encryption,
 		},
-		Disabled: false,
 		TTL:      24 * time.Hour,
 		Recovery: nil,
 		Tags:     FakeTags(),
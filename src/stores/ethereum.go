@@ -41,6 +41,12 @@ type EthStore interface {
 	// ListDeleted lists all deleted Ethereum accounts
 	ListDeleted(ctx context.Context, limit, offset uint64) ([]common.Address, error)
 
+	// Search lists the Ethereum accounts of the index matching the filter
+	Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.ETHAccount, error)
+
+	// Count counts the Ethereum accounts of the index matching the filter, regardless of the pagination
+	Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error)
+
 	// Restore restores a previously deleted Ethereum account
 	Restore(ctx context.Context, addr common.Address) error
 
@@ -17,15 +17,27 @@ type KeyStore interface {
 	// Import imports an externally created key and stores it
 	Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error)
 
+	// Export exports the private key of the latest version of a key to migrate it to another store (not exposed in the API)
+	Export(ctx context.Context, id string) ([]byte, error)
+
 	// Get gets the public part of a stored key.
 	Get(ctx context.Context, id string) (*entities.Key, error)
 
+	// GetVersion gets the public part of a version of a stored key, the latest one when the version is empty
+	GetVersion(ctx context.Context, id, version string) (*entities.Key, error)
+
 	// List lists keys
 	List(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// ListVersions lists the versions of a key, from the oldest to the latest
+	ListVersions(ctx context.Context, id string) ([]string, error)
+
 	// Update updates key tags
 	Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error)
 
+	// Rotate creates a new version of a key, used to sign by default from then on
+	Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error)
+
 	// Delete soft-deletes a key
 	Delete(ctx context.Context, id string) error
 
@@ -44,9 +56,26 @@ type KeyStore interface {
 	// Sign from any arbitrary data using the specified key
 	Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error)
 
+	// SignWithVersion signs any arbitrary data using the specified version of a key
+	SignWithVersion(ctx context.Context, id, version string, data []byte, algo *entities2.Algorithm) ([]byte, error)
+
+	// Verify verifies that a signature of data belongs to a version of a key, the latest one when the version is empty
+	Verify(ctx context.Context, id, version string, data, sig []byte) error
+
 	// Encrypt encrypts any arbitrary data using a specified key
 	Encrypt(ctx context.Context, id string, data []byte) ([]byte, error)
 
 	// Decrypt decrypts a single block of encrypted data.
 	Decrypt(ctx context.Context, id string, data []byte) ([]byte, error)
 }
+
+// IndexedKeyStore is a KeyStore whose index in database can be searched
+type IndexedKeyStore interface {
+	KeyStore
+
+	// Search lists the keys of the index matching the filter
+	Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Key, error)
+
+	// Count counts the keys of the index matching the filter, regardless of the pagination
+	Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error)
+}
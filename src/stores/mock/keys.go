@@ -65,6 +65,21 @@ func (mr *MockKeyStoreMockRecorder) Import(ctx, id, privKey, alg, attr interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockKeyStore)(nil).Import), ctx, id, privKey, alg, attr)
 }
 
+// Export mocks base method
+func (m *MockKeyStore) Export(ctx context.Context, id string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockKeyStoreMockRecorder) Export(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockKeyStore)(nil).Export), ctx, id)
+}
+
 // Get mocks base method
 func (m *MockKeyStore) Get(ctx context.Context, id string) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +95,21 @@ func (mr *MockKeyStoreMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeyStore)(nil).Get), ctx, id)
 }
 
+// GetVersion mocks base method
+func (m *MockKeyStore) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, id, version)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockKeyStoreMockRecorder) GetVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockKeyStore)(nil).GetVersion), ctx, id, version)
+}
+
 // List mocks base method
 func (m *MockKeyStore) List(ctx context.Context, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +125,21 @@ func (mr *MockKeyStoreMockRecorder) List(ctx, limit, offset interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeyStore)(nil).List), ctx, limit, offset)
 }
 
+// ListVersions mocks base method
+func (m *MockKeyStore) ListVersions(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockKeyStoreMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockKeyStore)(nil).ListVersions), ctx, id)
+}
+
 // Update mocks base method
 func (m *MockKeyStore) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +155,21 @@ func (mr *MockKeyStoreMockRecorder) Update(ctx, id, attr interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeyStore)(nil).Update), ctx, id, attr)
 }
 
+// Rotate mocks base method
+func (m *MockKeyStore) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, alg)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate
+func (mr *MockKeyStoreMockRecorder) Rotate(ctx, id, alg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockKeyStore)(nil).Rotate), ctx, id, alg)
+}
+
 // Delete mocks base method
 func (m *MockKeyStore) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -197,6 +257,35 @@ func (mr *MockKeyStoreMockRecorder) Sign(ctx, id, data, algo interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockKeyStore)(nil).Sign), ctx, id, data, algo)
 }
 
+// SignWithVersion mocks base method
+func (m *MockKeyStore) SignWithVersion(ctx context.Context, id, version string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignWithVersion", ctx, id, version, data, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignWithVersion indicates an expected call of SignWithVersion
+func (mr *MockKeyStoreMockRecorder) SignWithVersion(ctx, id, version, data, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignWithVersion", reflect.TypeOf((*MockKeyStore)(nil).SignWithVersion), ctx, id, version, data, algo)
+}
+
+// Verify mocks base method
+func (m *MockKeyStore) Verify(ctx context.Context, id, version string, data, sig []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, id, version, data, sig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockKeyStoreMockRecorder) Verify(ctx, id, version, data, sig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockKeyStore)(nil).Verify), ctx, id, version, data, sig)
+}
+
 // Encrypt mocks base method
 func (m *MockKeyStore) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -226,3 +315,337 @@ func (mr *MockKeyStoreMockRecorder) Decrypt(ctx, id, data interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyStore)(nil).Decrypt), ctx, id, data)
 }
+
+// MockIndexedKeyStore is a mock of IndexedKeyStore interface
+type MockIndexedKeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexedKeyStoreMockRecorder
+}
+
+// MockIndexedKeyStoreMockRecorder is the mock recorder for MockIndexedKeyStore
+type MockIndexedKeyStoreMockRecorder struct {
+	mock *MockIndexedKeyStore
+}
+
+// NewMockIndexedKeyStore creates a new mock instance
+func NewMockIndexedKeyStore(ctrl *gomock.Controller) *MockIndexedKeyStore {
+	mock := &MockIndexedKeyStore{ctrl: ctrl}
+	mock.recorder = &MockIndexedKeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIndexedKeyStore) EXPECT() *MockIndexedKeyStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockIndexedKeyStore) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, id, alg, attr)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockIndexedKeyStoreMockRecorder) Create(ctx, id, alg, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIndexedKeyStore)(nil).Create), ctx, id, alg, attr)
+}
+
+// Import mocks base method
+func (m *MockIndexedKeyStore) Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, id, privKey, alg, attr)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import
+func (mr *MockIndexedKeyStoreMockRecorder) Import(ctx, id, privKey, alg, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockIndexedKeyStore)(nil).Import), ctx, id, privKey, alg, attr)
+}
+
+// Export mocks base method
+func (m *MockIndexedKeyStore) Export(ctx context.Context, id string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockIndexedKeyStoreMockRecorder) Export(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockIndexedKeyStore)(nil).Export), ctx, id)
+}
+
+// Get mocks base method
+func (m *MockIndexedKeyStore) Get(ctx context.Context, id string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockIndexedKeyStoreMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIndexedKeyStore)(nil).Get), ctx, id)
+}
+
+// GetVersion mocks base method
+func (m *MockIndexedKeyStore) GetVersion(ctx context.Context, id, version string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, id, version)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockIndexedKeyStoreMockRecorder) GetVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockIndexedKeyStore)(nil).GetVersion), ctx, id, version)
+}
+
+// List mocks base method
+func (m *MockIndexedKeyStore) List(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockIndexedKeyStoreMockRecorder) List(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIndexedKeyStore)(nil).List), ctx, limit, offset)
+}
+
+// ListVersions mocks base method
+func (m *MockIndexedKeyStore) ListVersions(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockIndexedKeyStoreMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockIndexedKeyStore)(nil).ListVersions), ctx, id)
+}
+
+// Update mocks base method
+func (m *MockIndexedKeyStore) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, attr)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockIndexedKeyStoreMockRecorder) Update(ctx, id, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIndexedKeyStore)(nil).Update), ctx, id, attr)
+}
+
+// Rotate mocks base method
+func (m *MockIndexedKeyStore) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, id, alg)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate
+func (mr *MockIndexedKeyStoreMockRecorder) Rotate(ctx, id, alg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockIndexedKeyStore)(nil).Rotate), ctx, id, alg)
+}
+
+// Delete mocks base method
+func (m *MockIndexedKeyStore) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockIndexedKeyStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIndexedKeyStore)(nil).Delete), ctx, id)
+}
+
+// GetDeleted mocks base method
+func (m *MockIndexedKeyStore) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted
+func (mr *MockIndexedKeyStoreMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockIndexedKeyStore)(nil).GetDeleted), ctx, id)
+}
+
+// ListDeleted mocks base method
+func (m *MockIndexedKeyStore) ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted
+func (mr *MockIndexedKeyStoreMockRecorder) ListDeleted(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockIndexedKeyStore)(nil).ListDeleted), ctx, limit, offset)
+}
+
+// Restore mocks base method
+func (m *MockIndexedKeyStore) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockIndexedKeyStoreMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockIndexedKeyStore)(nil).Restore), ctx, id)
+}
+
+// Destroy mocks base method
+func (m *MockIndexedKeyStore) Destroy(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy
+func (mr *MockIndexedKeyStoreMockRecorder) Destroy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockIndexedKeyStore)(nil).Destroy), ctx, id)
+}
+
+// Sign mocks base method
+func (m *MockIndexedKeyStore) Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", ctx, id, data, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign
+func (mr *MockIndexedKeyStoreMockRecorder) Sign(ctx, id, data, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockIndexedKeyStore)(nil).Sign), ctx, id, data, algo)
+}
+
+// SignWithVersion mocks base method
+func (m *MockIndexedKeyStore) SignWithVersion(ctx context.Context, id, version string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignWithVersion", ctx, id, version, data, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignWithVersion indicates an expected call of SignWithVersion
+func (mr *MockIndexedKeyStoreMockRecorder) SignWithVersion(ctx, id, version, data, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignWithVersion", reflect.TypeOf((*MockIndexedKeyStore)(nil).SignWithVersion), ctx, id, version, data, algo)
+}
+
+// Verify mocks base method
+func (m *MockIndexedKeyStore) Verify(ctx context.Context, id, version string, data, sig []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, id, version, data, sig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockIndexedKeyStoreMockRecorder) Verify(ctx, id, version, data, sig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockIndexedKeyStore)(nil).Verify), ctx, id, version, data, sig)
+}
+
+// Encrypt mocks base method
+func (m *MockIndexedKeyStore) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, id, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockIndexedKeyStoreMockRecorder) Encrypt(ctx, id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockIndexedKeyStore)(nil).Encrypt), ctx, id, data)
+}
+
+// Decrypt mocks base method
+func (m *MockIndexedKeyStore) Decrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, id, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockIndexedKeyStoreMockRecorder) Decrypt(ctx, id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockIndexedKeyStore)(nil).Decrypt), ctx, id, data)
+}
+
+// Search mocks base method
+func (m *MockIndexedKeyStore) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockIndexedKeyStoreMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIndexedKeyStore)(nil).Search), ctx, filter)
+}
+
+// Count mocks base method
+func (m *MockIndexedKeyStore) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockIndexedKeyStoreMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIndexedKeyStore)(nil).Count), ctx, filter)
+}
@@ -49,6 +49,36 @@ func (mr *MockSecretStoreMockRecorder) Set(ctx, id, value, attr interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockSecretStore)(nil).Set), ctx, id, value, attr)
 }
 
+// CompareAndSet mocks base method
+func (m *MockSecretStore) CompareAndSet(ctx context.Context, id, value, expectedVersion string, attr *entities.Attributes) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndSet", ctx, id, value, expectedVersion, attr)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareAndSet indicates an expected call of CompareAndSet
+func (mr *MockSecretStoreMockRecorder) CompareAndSet(ctx, id, value, expectedVersion, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSet", reflect.TypeOf((*MockSecretStore)(nil).CompareAndSet), ctx, id, value, expectedVersion, attr)
+}
+
+// Generate mocks base method
+func (m *MockSecretStore) Generate(ctx context.Context, id string, policy *entities.SecretPolicy, attr *entities.Attributes) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", ctx, id, policy, attr)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate
+func (mr *MockSecretStoreMockRecorder) Generate(ctx, id, policy, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockSecretStore)(nil).Generate), ctx, id, policy, attr)
+}
+
 // Get mocks base method
 func (m *MockSecretStore) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +109,21 @@ func (mr *MockSecretStoreMockRecorder) List(ctx, limit, offset interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSecretStore)(nil).List), ctx, limit, offset)
 }
 
+// ListVersions mocks base method
+func (m *MockSecretStore) ListVersions(ctx context.Context, id string) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockSecretStoreMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockSecretStore)(nil).ListVersions), ctx, id)
+}
+
 // Delete mocks base method
 func (m *MockSecretStore) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -150,3 +195,274 @@ func (mr *MockSecretStoreMockRecorder) Destroy(ctx, id interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockSecretStore)(nil).Destroy), ctx, id)
 }
+
+// RestoreVersion mocks base method
+func (m *MockSecretStore) RestoreVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion
+func (mr *MockSecretStoreMockRecorder) RestoreVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockSecretStore)(nil).RestoreVersion), ctx, id, version)
+}
+
+// DestroyVersion mocks base method
+func (m *MockSecretStore) DestroyVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyVersion indicates an expected call of DestroyVersion
+func (mr *MockSecretStoreMockRecorder) DestroyVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVersion", reflect.TypeOf((*MockSecretStore)(nil).DestroyVersion), ctx, id, version)
+}
+
+// MockIndexedSecretStore is a mock of IndexedSecretStore interface
+type MockIndexedSecretStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexedSecretStoreMockRecorder
+}
+
+// MockIndexedSecretStoreMockRecorder is the mock recorder for MockIndexedSecretStore
+type MockIndexedSecretStoreMockRecorder struct {
+	mock *MockIndexedSecretStore
+}
+
+// NewMockIndexedSecretStore creates a new mock instance
+func NewMockIndexedSecretStore(ctrl *gomock.Controller) *MockIndexedSecretStore {
+	mock := &MockIndexedSecretStore{ctrl: ctrl}
+	mock.recorder = &MockIndexedSecretStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIndexedSecretStore) EXPECT() *MockIndexedSecretStoreMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method
+func (m *MockIndexedSecretStore) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, id, value, attr)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Set indicates an expected call of Set
+func (mr *MockIndexedSecretStoreMockRecorder) Set(ctx, id, value, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockIndexedSecretStore)(nil).Set), ctx, id, value, attr)
+}
+
+// CompareAndSet mocks base method
+func (m *MockIndexedSecretStore) CompareAndSet(ctx context.Context, id, value, expectedVersion string, attr *entities.Attributes) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndSet", ctx, id, value, expectedVersion, attr)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareAndSet indicates an expected call of CompareAndSet
+func (mr *MockIndexedSecretStoreMockRecorder) CompareAndSet(ctx, id, value, expectedVersion, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSet", reflect.TypeOf((*MockIndexedSecretStore)(nil).CompareAndSet), ctx, id, value, expectedVersion, attr)
+}
+
+// Generate mocks base method
+func (m *MockIndexedSecretStore) Generate(ctx context.Context, id string, policy *entities.SecretPolicy, attr *entities.Attributes) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", ctx, id, policy, attr)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate
+func (mr *MockIndexedSecretStoreMockRecorder) Generate(ctx, id, policy, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockIndexedSecretStore)(nil).Generate), ctx, id, policy, attr)
+}
+
+// Get mocks base method
+func (m *MockIndexedSecretStore) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, version)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockIndexedSecretStoreMockRecorder) Get(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIndexedSecretStore)(nil).Get), ctx, id, version)
+}
+
+// List mocks base method
+func (m *MockIndexedSecretStore) List(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockIndexedSecretStoreMockRecorder) List(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIndexedSecretStore)(nil).List), ctx, limit, offset)
+}
+
+// ListVersions mocks base method
+func (m *MockIndexedSecretStore) ListVersions(ctx context.Context, id string) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockIndexedSecretStoreMockRecorder) ListVersions(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockIndexedSecretStore)(nil).ListVersions), ctx, id)
+}
+
+// Delete mocks base method
+func (m *MockIndexedSecretStore) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockIndexedSecretStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIndexedSecretStore)(nil).Delete), ctx, id)
+}
+
+// GetDeleted mocks base method
+func (m *MockIndexedSecretStore) GetDeleted(ctx context.Context, id string) (*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted
+func (mr *MockIndexedSecretStoreMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockIndexedSecretStore)(nil).GetDeleted), ctx, id)
+}
+
+// ListDeleted mocks base method
+func (m *MockIndexedSecretStore) ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted
+func (mr *MockIndexedSecretStoreMockRecorder) ListDeleted(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockIndexedSecretStore)(nil).ListDeleted), ctx, limit, offset)
+}
+
+// Restore mocks base method
+func (m *MockIndexedSecretStore) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockIndexedSecretStoreMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockIndexedSecretStore)(nil).Restore), ctx, id)
+}
+
+// Destroy mocks base method
+func (m *MockIndexedSecretStore) Destroy(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy
+func (mr *MockIndexedSecretStoreMockRecorder) Destroy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockIndexedSecretStore)(nil).Destroy), ctx, id)
+}
+
+// Search mocks base method
+func (m *MockIndexedSecretStore) Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].([]*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockIndexedSecretStoreMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockIndexedSecretStore)(nil).Search), ctx, filter)
+}
+
+// Count mocks base method
+func (m *MockIndexedSecretStore) Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockIndexedSecretStoreMockRecorder) Count(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIndexedSecretStore)(nil).Count), ctx, filter)
+}
+
+// RestoreVersion mocks base method
+func (m *MockIndexedSecretStore) RestoreVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVersion indicates an expected call of RestoreVersion
+func (mr *MockIndexedSecretStoreMockRecorder) RestoreVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVersion", reflect.TypeOf((*MockIndexedSecretStore)(nil).RestoreVersion), ctx, id, version)
+}
+
+// DestroyVersion mocks base method
+func (m *MockIndexedSecretStore) DestroyVersion(ctx context.Context, id, version string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVersion", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyVersion indicates an expected call of DestroyVersion
+func (mr *MockIndexedSecretStoreMockRecorder) DestroyVersion(ctx, id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVersion", reflect.TypeOf((*MockIndexedSecretStore)(nil).DestroyVersion), ctx, id, version)
+}
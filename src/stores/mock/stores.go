@@ -7,10 +7,13 @@ package mock
 import (
 	context "context"
 	entities "github.com/consensys/quorum-key-manager/src/auth/entities"
+	entities0 "github.com/consensys/quorum-key-manager/src/entities"
 	stores "github.com/consensys/quorum-key-manager/src/stores"
+	entities1 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockStores is a mock of Stores interface
@@ -37,45 +40,45 @@ func (m *MockStores) EXPECT() *MockStoresMockRecorder {
 }
 
 // CreateEthereum mocks base method
-func (m *MockStores) CreateEthereum(arg0 context.Context, name, keyStore string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateEthereum(arg0 context.Context, name, keyStore string, allowedTenants []string, recoveryPeriod time.Duration, userInfo *entities.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEthereum", arg0, name, keyStore, allowedTenants, userInfo)
+	ret := m.ctrl.Call(m, "CreateEthereum", arg0, name, keyStore, allowedTenants, recoveryPeriod, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateEthereum indicates an expected call of CreateEthereum
-func (mr *MockStoresMockRecorder) CreateEthereum(arg0, name, keyStore, allowedTenants, userInfo interface{}) *gomock.Call {
+func (mr *MockStoresMockRecorder) CreateEthereum(arg0, name, keyStore, allowedTenants, recoveryPeriod, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthereum", reflect.TypeOf((*MockStores)(nil).CreateEthereum), arg0, name, keyStore, allowedTenants, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthereum", reflect.TypeOf((*MockStores)(nil).CreateEthereum), arg0, name, keyStore, allowedTenants, recoveryPeriod, userInfo)
 }
 
 // CreateKey mocks base method
-func (m *MockStores) CreateKey(arg0 context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateKey(arg0 context.Context, name, vault, secretStore string, replicaRegions, allowedTenants []string, recoveryPeriod time.Duration, masterKey, previousMasterKey *entities0.MasterKeyConfig, userInfo *entities.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateKey", arg0, name, vault, secretStore, allowedTenants, userInfo)
+	ret := m.ctrl.Call(m, "CreateKey", arg0, name, vault, secretStore, replicaRegions, allowedTenants, recoveryPeriod, masterKey, previousMasterKey, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateKey indicates an expected call of CreateKey
-func (mr *MockStoresMockRecorder) CreateKey(arg0, name, vault, secretStore, allowedTenants, userInfo interface{}) *gomock.Call {
+func (mr *MockStoresMockRecorder) CreateKey(arg0, name, vault, secretStore, replicaRegions, allowedTenants, recoveryPeriod, masterKey, previousMasterKey, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockStores)(nil).CreateKey), arg0, name, vault, secretStore, allowedTenants, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockStores)(nil).CreateKey), arg0, name, vault, secretStore, replicaRegions, allowedTenants, recoveryPeriod, masterKey, previousMasterKey, userInfo)
 }
 
 // CreateSecret mocks base method
-func (m *MockStores) CreateSecret(arg0 context.Context, name, vault string, allowedTenants []string, userInfo *entities.UserInfo) error {
+func (m *MockStores) CreateSecret(arg0 context.Context, name, vault, pathPrefix string, allowedTenants []string, recoveryPeriod time.Duration, userInfo *entities.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", arg0, name, vault, allowedTenants, userInfo)
+	ret := m.ctrl.Call(m, "CreateSecret", arg0, name, vault, pathPrefix, allowedTenants, recoveryPeriod, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateSecret indicates an expected call of CreateSecret
-func (mr *MockStoresMockRecorder) CreateSecret(arg0, name, vault, allowedTenants, userInfo interface{}) *gomock.Call {
+func (mr *MockStoresMockRecorder) CreateSecret(arg0, name, vault, pathPrefix, allowedTenants, recoveryPeriod, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockStores)(nil).CreateSecret), arg0, name, vault, allowedTenants, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockStores)(nil).CreateSecret), arg0, name, vault, pathPrefix, allowedTenants, recoveryPeriod, userInfo)
 }
 
 // ImportEthereum mocks base method
@@ -121,10 +124,10 @@ func (mr *MockStoresMockRecorder) ImportSecrets(ctx, storeName, userInfo interfa
 }
 
 // Secret mocks base method
-func (m *MockStores) Secret(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.SecretStore, error) {
+func (m *MockStores) Secret(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.IndexedSecretStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Secret", ctx, storeName, userInfo)
-	ret0, _ := ret[0].(stores.SecretStore)
+	ret0, _ := ret[0].(stores.IndexedSecretStore)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -136,10 +139,10 @@ func (mr *MockStoresMockRecorder) Secret(ctx, storeName, userInfo interface{}) *
 }
 
 // Key mocks base method
-func (m *MockStores) Key(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.KeyStore, error) {
+func (m *MockStores) Key(ctx context.Context, storeName string, userInfo *entities.UserInfo) (stores.IndexedKeyStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Key", ctx, storeName, userInfo)
-	ret0, _ := ret[0].(stores.KeyStore)
+	ret0, _ := ret[0].(stores.IndexedKeyStore)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -209,3 +212,48 @@ func (mr *MockStoresMockRecorder) ListAllAccounts(ctx, userInfo interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllAccounts", reflect.TypeOf((*MockStores)(nil).ListAllAccounts), ctx, userInfo)
 }
+
+// Migrate mocks base method
+func (m *MockStores) Migrate(ctx context.Context, storeName, destStoreName string, dryRun, deleteSource bool, userInfo *entities.UserInfo) ([]*entities1.MigratedItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx, storeName, destStoreName, dryRun, deleteSource, userInfo)
+	ret0, _ := ret[0].([]*entities1.MigratedItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Migrate indicates an expected call of Migrate
+func (mr *MockStoresMockRecorder) Migrate(ctx, storeName, destStoreName, dryRun, deleteSource, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockStores)(nil).Migrate), ctx, storeName, destStoreName, dryRun, deleteSource, userInfo)
+}
+
+// Backup mocks base method
+func (m *MockStores) Backup(ctx context.Context, storeName string, encryption *entities1.BackupEncryption, userInfo *entities.UserInfo) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", ctx, storeName, encryption, userInfo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup
+func (mr *MockStoresMockRecorder) Backup(ctx, storeName, encryption, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockStores)(nil).Backup), ctx, storeName, encryption, userInfo)
+}
+
+// RestoreBackup mocks base method
+func (m *MockStores) RestoreBackup(ctx context.Context, storeName string, archive []byte, decryption *entities1.BackupDecryption, userInfo *entities.UserInfo) ([]*entities1.MigratedItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBackup", ctx, storeName, archive, decryption, userInfo)
+	ret0, _ := ret[0].([]*entities1.MigratedItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBackup indicates an expected call of RestoreBackup
+func (mr *MockStoresMockRecorder) RestoreBackup(ctx, storeName, archive, decryption, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackup", reflect.TypeOf((*MockStores)(nil).RestoreBackup), ctx, storeName, archive, decryption, userInfo)
+}
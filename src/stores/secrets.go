@@ -12,12 +12,21 @@ type SecretStore interface {
 	// Set secret
 	Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error)
 
+	// CompareAndSet sets a secret only if its latest version is the expected one
+	CompareAndSet(ctx context.Context, id, value, expectedVersion string, attr *entities.Attributes) (*entities.Secret, error)
+
+	// Generate sets a secret whose value is generated from the policy
+	Generate(ctx context.Context, id string, policy *entities.SecretPolicy, attr *entities.Attributes) (*entities.Secret, error)
+
 	// Get a secret
 	Get(ctx context.Context, id string, version string) (*entities.Secret, error)
 
 	// List secrets
 	List(ctx context.Context, limit, offset uint64) ([]string, error)
 
+	// ListVersions lists every version of a secret without their value, including the deleted ones
+	ListVersions(ctx context.Context, id string) ([]*entities.Secret, error)
+
 	// Delete secret not permanently, it can be restored
 	Delete(ctx context.Context, id string) error
 
@@ -32,4 +41,21 @@ type SecretStore interface {
 
 	// Destroy secret permanently
 	Destroy(ctx context.Context, id string) error
+
+	// RestoreVersion restores a previously deleted version of a secret
+	RestoreVersion(ctx context.Context, id, version string) error
+
+	// DestroyVersion permanently deletes a version of a secret
+	DestroyVersion(ctx context.Context, id, version string) error
+}
+
+// IndexedSecretStore is a SecretStore whose index in database can be searched
+type IndexedSecretStore interface {
+	SecretStore
+
+	// Search lists the latest version of the secrets of the index matching the filter, without their value
+	Search(ctx context.Context, filter *entities.SearchFilter) ([]*entities.Secret, error)
+
+	// Count counts the secrets of the index matching the filter, regardless of the pagination
+	Count(ctx context.Context, filter *entities.SearchFilter) (uint64, error)
 }
@@ -3,7 +3,6 @@ package akv
 import (
 	"context"
 	"encoding/base64"
-	"time"
 
 	entities2 "github.com/consensys/quorum-key-manager/src/entities"
 
@@ -43,7 +42,7 @@ func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm,
 	logger := s.logger.With("elliptic_curve", alg.EllipticCurve, "signing_algorithm", alg.Type)
 	switch {
 	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
-		kty = keyvault.EC
+		kty = s.ecKeyType()
 		crv = keyvault.P256K
 	default:
 		errMessage := "not supported elliptic curve and signing algorithm in AKV for creation"
@@ -61,6 +60,15 @@ func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm,
 	return parseKeyBundleRes(&res), nil
 }
 
+// ecKeyType returns the EC key type to create, Managed HSM pools only accept HSM-protected keys
+func (s *Store) ecKeyType() keyvault.JSONWebKeyType {
+	if s.client.IsManagedHSM() {
+		return keyvault.ECHSM
+	}
+
+	return keyvault.EC
+}
+
 func (s *Store) Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
 	var pKeyD, pKeyX, pKeyY string
 	var kty keyvault.JSONWebKeyType
@@ -103,6 +111,13 @@ func (s *Store) Import(ctx context.Context, id string, privKey []byte, alg *enti
 	return parseKeyBundleRes(&res), nil
 }
 
+// Export is not supported, private keys never leave the vault
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("exporting keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
 	res, err := s.client.GetKey(ctx, id, "")
 	if err != nil {
@@ -114,6 +129,13 @@ func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
 	return parseKeyBundleRes(&res), nil
 }
 
+// GetVersion is only supported on the index of the store
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	res, err := s.client.GetKeys(ctx, 0)
 	if err != nil {
@@ -131,11 +153,25 @@ func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	return kIDs, nil
 }
 
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]string, error) {
+	err := errors.NotSupportedError("list key versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
-	expireAt := date.NewUnixTimeFromNanoseconds(time.Now().Add(attr.TTL).UnixNano())
-	res, err := s.client.UpdateKey(ctx, id, "", &keyvault.KeyAttributes{
-		Expires: &expireAt,
-	}, convertToAKVOps(attr.Operations), attr.Tags)
+	kAttr := &keyvault.KeyAttributes{}
+	if attr.ExpireAt != nil {
+		expireAt := date.NewUnixTimeFromNanoseconds(attr.ExpireAt.UnixNano())
+		kAttr.Expires = &expireAt
+	}
+	if attr.Disabled != nil {
+		enabled := !*attr.Disabled
+		kAttr.Enabled = &enabled
+	}
+
+	res, err := s.client.UpdateKey(ctx, id, "", kAttr, convertToAKVOps(attr.Operations), attr.Tags)
 	if err != nil {
 		errMessage := "failed to update AKV key"
 		s.logger.With("id", id).WithError(err).Error(errMessage)
@@ -145,6 +181,18 @@ func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes
 	return parseKeyBundleRes(&res), nil
 }
 
+// Rotate creates a new version of the AKV key, keeping its tags
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	current, err := s.client.GetKey(ctx, id, "")
+	if err != nil {
+		errMessage := "failed to get AKV key"
+		s.logger.With("id", id).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.Create(ctx, id, alg, &entities.Attributes{Tags: parseKeyBundleRes(&current).Tags})
+}
+
 func (s *Store) Delete(ctx context.Context, id string) error {
 	_, err := s.client.DeleteKey(ctx, id)
 	if err != nil {
@@ -207,7 +255,11 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 }
 
 func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
-	logger := s.logger.With("id", id)
+	return s.SignWithVersion(ctx, id, "", data, algo)
+}
+
+func (s *Store) SignWithVersion(ctx context.Context, id, version string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id, "version", version)
 
 	var akvAlgo keyvault.JSONWebKeySignatureAlgorithm
 	switch {
@@ -219,7 +271,7 @@ func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities
 		return nil, errors.InvalidParameterError(errMessage)
 	}
 
-	b64Signature, err := s.client.Sign(ctx, id, "", akvAlgo, base64.StdEncoding.EncodeToString(data))
+	b64Signature, err := s.client.Sign(ctx, id, version, akvAlgo, base64.StdEncoding.EncodeToString(data))
 	if err != nil {
 		errMessage := "failed to sign using AKV key"
 		logger.WithError(err).Error(errMessage)
@@ -236,7 +288,8 @@ func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities
 	return signature, nil
 }
 
-func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entities2.Algorithm) error {
+// Verify is only supported on the index of the store
+func (s *Store) Verify(_ context.Context, _, _ string, _, _ []byte) error {
 	err := errors.NotSupportedError("verify signature is not supported")
 	s.logger.Warn(err.Error())
 	return err
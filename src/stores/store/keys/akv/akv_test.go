@@ -79,6 +79,7 @@ func (s *akvKeyStoreTestSuite) TestCreate() {
 	}
 
 	s.Run("should create a new key successfully", func() {
+		s.mockVault.EXPECT().IsManagedHSM().Return(false)
 		s.mockVault.EXPECT().CreateKey(gomock.Any(), id, akv.EC, akv.P256K, gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(akvKey, nil)
 
@@ -92,6 +93,28 @@ func (s *akvKeyStoreTestSuite) TestCreate() {
 		assert.False(s.T(), key.Metadata.Disabled)
 		assert.Equal(s.T(), version, key.Metadata.Version)
 	})
+
+	s.Run("should create a new HSM protected key successfully in a managed HSM", func() {
+		hsmKey := akvKey
+		hsmKey.Key = &akv.JSONWebKey{
+			Kid: &akvKeyID,
+			Crv: akv.P256K,
+			Kty: akv.ECHSM,
+			X:   &base64PubKeyX,
+			Y:   &base64PubKeyY,
+		}
+
+		s.mockVault.EXPECT().IsManagedHSM().Return(true)
+		s.mockVault.EXPECT().CreateKey(gomock.Any(), id, akv.ECHSM, akv.P256K, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(hsmKey, nil)
+
+		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), publicKey, hexutil.Encode(key.PublicKey))
+		assert.Equal(s.T(), entities.Ecdsa, key.Algo.Type)
+		assert.Equal(s.T(), entities.Secp256k1, key.Algo.EllipticCurve)
+	})
 }
 
 func (s *akvKeyStoreTestSuite) TestImport() {
@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -19,16 +20,20 @@ const (
 )
 
 type Store struct {
-	client aws.KmsClient
-	logger log.Logger
+	client         aws.KmsClient
+	replicaRegions []string
+	logger         log.Logger
 }
 
 var _ stores.KeyStore = &Store{}
 
-func New(client aws.KmsClient, logger log.Logger) *Store {
+// New creates an AWS KMS key store. If replica regions are given, keys are created as multi-region primary keys
+// and replicated in each of these regions
+func New(client aws.KmsClient, replicaRegions []string, logger log.Logger) *Store {
 	return &Store{
-		client: client,
-		logger: logger,
+		client:         client,
+		replicaRegions: replicaRegions,
+		logger:         logger,
 	}
 }
 
@@ -48,13 +53,19 @@ func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm,
 		return nil, errors.NotSupportedError(errMessage)
 	}
 
-	_, err := s.client.CreateKey(ctx, alias(id), keyType, toTags(attr.Tags))
+	out, err := s.client.CreateKey(ctx, alias(id), keyType, toTags(attr.Tags), len(s.replicaRegions) > 0)
 	if err != nil {
 		errMessage := "failed to create AWS key"
 		s.logger.With("id", id).WithError(err).Error(errMessage)
 		return nil, errors.FromError(err).SetMessage(errMessage)
 	}
 
+	err = s.replicate(ctx, id, *out.KeyMetadata.KeyId, attr, nil)
+	if err != nil {
+		s.deleteOrphanKey(ctx, id, *out.KeyMetadata.KeyId)
+		return nil, err
+	}
+
 	key, err := s.Get(ctx, id)
 	if err != nil {
 		return nil, err
@@ -63,11 +74,53 @@ func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm,
 	return key, nil
 }
 
-// Import an externally created key and stores it
-// this feature is not supported by AWS kms
-// always returns errors.ErrNotSupported
-func (s *Store) Import(_ context.Context, _ string, _ []byte, _ *entities2.Algorithm, _ *entities.Attributes) (*entities.Key, error) {
-	err := errors.NotSupportedError("import secret is not supported")
+func (s *Store) Import(ctx context.Context, id string, privKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	logger := s.logger.With("id", id)
+
+	var keyType string
+	var keyMaterial []byte
+	switch {
+	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
+		pKey, err := crypto.ToECDSA(privKey)
+		if err != nil {
+			errMessage := "invalid private key"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		keyMaterial, err = toPKCS8(pKey)
+		if err != nil {
+			errMessage := "failed to encode private key"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.CryptoOperationError(errMessage)
+		}
+		keyType = kms.CustomerMasterKeySpecEccSecgP256k1
+	default:
+		errMessage := "not supported signing algorithm and curve combination for import"
+		logger.With("signing_algorithm", alg.Type, "elliptic_curve", alg.EllipticCurve).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	}
+
+	out, err := s.client.ImportKey(ctx, alias(id), keyType, keyMaterial, toTags(attr.Tags), len(s.replicaRegions) > 0)
+	if err != nil {
+		errMessage := "failed to import AWS key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	// Replicas of imported keys do not share the key material of their primary key, it must be imported in every region
+	err = s.replicate(ctx, id, *out.KeyMetadata.KeyId, attr, keyMaterial)
+	if err != nil {
+		s.deleteOrphanKey(ctx, id, *out.KeyMetadata.KeyId)
+		return nil, err
+	}
+
+	return s.Get(ctx, id)
+}
+
+// Export is not supported, private keys never leave the vault
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("exporting keys is not supported")
 	s.logger.Warn(err.Error())
 	return nil, err
 }
@@ -108,6 +161,13 @@ func (s *Store) Get(ctx context.Context, id string) (*entities.Key, error) {
 	return key, nil
 }
 
+// GetVersion is only supported on the index of the store
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	var ids []string
 	nextMarker := ""
@@ -144,6 +204,13 @@ func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	return ids, nil
 }
 
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]string, error) {
+	err := errors.NotSupportedError("list key versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
 	logger := s.logger.With("id", id)
 	key, err := s.Get(ctx, id)
@@ -178,6 +245,12 @@ func (s *Store) Update(ctx context.Context, id string, attr *entities.Attributes
 	return key, nil
 }
 
+func (s *Store) Rotate(_ context.Context, _ string, _ *entities2.Algorithm) (*entities.Key, error) {
+	err := errors.NotSupportedError("key rotation is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Delete(ctx context.Context, id string) error {
 	logger := s.logger.With("id", id)
 	keyID, err := s.getAWSKeyID(ctx, id)
@@ -258,7 +331,14 @@ func (s *Store) Sign(ctx context.Context, id string, data []byte, _ *entities2.A
 	return signature, nil
 }
 
-func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entities2.Algorithm) error {
+func (s *Store) SignWithVersion(_ context.Context, _, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("signing with a key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Verify is only supported on the index of the store
+func (s *Store) Verify(_ context.Context, _, _ string, _, _ []byte) error {
 	err := errors.NotSupportedError("verify signature is not supported")
 	s.logger.Warn(err.Error())
 	return err
@@ -272,6 +352,51 @@ func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, erro
 	return nil, errors.ErrNotImplemented
 }
 
+func (s *Store) replicate(ctx context.Context, id, keyID string, attr *entities.Attributes, keyMaterial []byte) error {
+	for _, region := range s.replicaRegions {
+		logger := s.logger.With("id", id, "region", region)
+
+		_, err := s.client.ReplicateKey(ctx, alias(id), keyID, region, toTags(attr.Tags))
+		if err != nil {
+			errMessage := "failed to replicate AWS key"
+			logger.WithError(err).Error(errMessage)
+			return errors.FromError(err).SetMessage(errMessage)
+		}
+
+		if keyMaterial != nil {
+			err = s.client.ImportKeyMaterial(ctx, keyID, region, keyMaterial)
+			if err != nil {
+				errMessage := "failed to import key material in AWS replica key"
+				logger.WithError(err).Error(errMessage)
+				return errors.FromError(err).SetMessage(errMessage)
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteOrphanKey schedules the deletion of a multi-region key whose replication failed, so that it does not remain in
+// the vault without being indexed. Replicas are deleted before their primary key, which cannot be deleted before them
+func (s *Store) deleteOrphanKey(ctx context.Context, id, keyID string) {
+	logger := s.logger.With("id", id)
+
+	for _, region := range s.replicaRegions {
+		err := s.client.DeleteOrphanKey(ctx, alias(id), keyID, region)
+		if err != nil {
+			logger.WithError(err).Error("failed to delete orphan AWS replica key", "region", region)
+		}
+	}
+
+	err := s.client.DeleteOrphanKey(ctx, alias(id), keyID, "")
+	if err != nil {
+		logger.WithError(err).Error("failed to delete orphan AWS key")
+		return
+	}
+
+	logger.Warn("orphan AWS key scheduled for deletion")
+}
+
 func (s *Store) getAWSKeyID(ctx context.Context, id string) (string, error) {
 	outDescribe, err := s.client.DescribeKey(ctx, alias(id))
 	if err != nil {
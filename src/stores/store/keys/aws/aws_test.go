@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"encoding/asn1"
 	"encoding/base64"
 	"fmt"
 	"testing"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -47,7 +49,7 @@ func (s *awsKeyStoreTestSuite) SetupTest() {
 	defer ctrl.Finish()
 
 	s.mockKmsClient = mocks.NewMockKmsClient(ctrl)
-	s.keyStore = New(s.mockKmsClient, testutils.NewMockLogger(ctrl))
+	s.keyStore = New(s.mockKmsClient, nil, testutils.NewMockLogger(ctrl))
 }
 
 func (s *awsKeyStoreTestSuite) TestCreate() {
@@ -65,7 +67,7 @@ func (s *awsKeyStoreTestSuite) TestCreate() {
 	retDescribeKey := fakeDescribeKey(keyID)
 
 	s.Run("should create a new key successfully", func() {
-		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any()).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any(), false).Return(&retCreateKey, nil)
 		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeKey, nil)
 		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(retGetPubKey, nil)
 		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(retListTags, nil)
@@ -82,7 +84,7 @@ func (s *awsKeyStoreTestSuite) TestCreate() {
 	})
 
 	s.Run("should fail with same error if CreateKey fails", func() {
-		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
 		assert.Nil(s.T(), key)
@@ -90,8 +92,52 @@ func (s *awsKeyStoreTestSuite) TestCreate() {
 		assert.Equal(s.T(), expectedErr, err)
 	})
 
+	s.Run("should create a new multi-region key with replicas successfully", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		replicaRegions := []string{"eu-west-1", "us-east-1"}
+		keyStore := New(s.mockKmsClient, replicaRegions, testutils.NewMockLogger(ctrl))
+		replicaArn := "my-replica-arn"
+		retDescribeMultiRegion := fakeDescribeKey(keyID)
+		retDescribeMultiRegion.KeyMetadata.MultiRegionConfiguration = &kms.MultiRegionConfiguration{
+			ReplicaKeys: []*kms.MultiRegionKey{{Arn: &replicaArn, Region: aws.String("eu-west-1")}},
+		}
+
+		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any(), true).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().ReplicateKey(gomock.Any(), alias(id), keyID, "eu-west-1", gomock.Any()).Return(&kms.ReplicateKeyOutput{}, nil)
+		s.mockKmsClient.EXPECT().ReplicateKey(gomock.Any(), alias(id), keyID, "us-east-1", gomock.Any()).Return(&kms.ReplicateKeyOutput{}, nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(retDescribeMultiRegion, nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(retGetPubKey, nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(retListTags, nil)
+
+		key, err := keyStore.Create(ctx, id, algorithm, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{replicaArn}, key.Annotations.AWSReplicaArns)
+	})
+
+	s.Run("should schedule the deletion of the key and its replicas if the replication fails", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		keyStore := New(s.mockKmsClient, []string{"eu-west-1", "us-east-1"}, testutils.NewMockLogger(ctrl))
+
+		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any(), true).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().ReplicateKey(gomock.Any(), alias(id), keyID, "eu-west-1", gomock.Any()).Return(&kms.ReplicateKeyOutput{}, nil)
+		s.mockKmsClient.EXPECT().ReplicateKey(gomock.Any(), alias(id), keyID, "us-east-1", gomock.Any()).Return(nil, expectedErr)
+		gomock.InOrder(
+			s.mockKmsClient.EXPECT().DeleteOrphanKey(gomock.Any(), alias(id), keyID, "eu-west-1").Return(nil),
+			s.mockKmsClient.EXPECT().DeleteOrphanKey(gomock.Any(), alias(id), keyID, "us-east-1").Return(nil),
+			s.mockKmsClient.EXPECT().DeleteOrphanKey(gomock.Any(), alias(id), keyID, "").Return(nil),
+		)
+
+		key, err := keyStore.Create(ctx, id, algorithm, attributes)
+
+		assert.Nil(s.T(), key)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+
 	s.Run("should fail with same error if any function of Get fails", func() {
-		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().CreateKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&retCreateKey, nil)
 		s.mockKmsClient.EXPECT().DescribeKey(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
 
 		key, err := s.keyStore.Create(ctx, id, algorithm, attributes)
@@ -104,9 +150,87 @@ func (s *awsKeyStoreTestSuite) TestCreate() {
 func (s *awsKeyStoreTestSuite) TestImport() {
 	ctx := context.Background()
 
-	s.Run("should return NotSupportedError", func() {
-		_, err := s.keyStore.Import(ctx, "my-id", []byte(""), testutils2.FakeAlgorithm(), testutils2.FakeAttributes())
-		assert.True(s.T(), errors.IsNotSupportedError(err))
+	attributes := testutils2.FakeAttributes()
+	algorithm := testutils2.FakeAlgorithm()
+	privKey, _ := crypto.GenerateKey()
+	retCreateKey := kms.CreateKeyOutput{
+		KeyMetadata: &kms.KeyMetadata{
+			KeyId: aws.String(keyID),
+		},
+	}
+
+	s.Run("should import a key successfully", func() {
+		s.mockKmsClient.EXPECT().ImportKey(gomock.Any(), alias(id), kms.CustomerMasterKeySpecEccSecgP256k1, gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _, _ string, keyMaterial []byte, _ []*kms.Tag, _ bool) (*kms.CreateKeyOutput, error) {
+				decoded := &pkcs8{}
+				_, err := asn1.Unmarshal(keyMaterial, decoded)
+				assert.NoError(s.T(), err)
+				assert.True(s.T(), oidPublicKeyECDSA.Equal(decoded.Algo.Algorithm))
+
+				ecKey := &ecPrivateKey{}
+				_, err = asn1.Unmarshal(decoded.PrivateKey, ecKey)
+				assert.NoError(s.T(), err)
+				assert.Equal(s.T(), crypto.FromECDSA(privKey), ecKey.PrivateKey)
+
+				return &retCreateKey, nil
+			})
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakeDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(fakeGetPubKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		key, err := s.keyStore.Import(ctx, id, crypto.FromECDSA(privKey), algorithm, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), id, key.ID)
+	})
+
+	s.Run("should import key material in every replica region", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		keyStore := New(s.mockKmsClient, []string{"eu-west-1"}, testutils.NewMockLogger(ctrl))
+
+		s.mockKmsClient.EXPECT().ImportKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any(), gomock.Any(), true).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().ReplicateKey(gomock.Any(), alias(id), keyID, "eu-west-1", gomock.Any()).Return(&kms.ReplicateKeyOutput{}, nil)
+		s.mockKmsClient.EXPECT().ImportKeyMaterial(gomock.Any(), keyID, "eu-west-1", gomock.Any()).Return(nil)
+		s.mockKmsClient.EXPECT().DescribeKey(ctx, alias(id)).Return(fakeDescribeKey(keyID), nil)
+		s.mockKmsClient.EXPECT().GetPublicKey(ctx, keyID).Return(fakeGetPubKey(keyID), nil)
+		s.mockKmsClient.EXPECT().ListTags(ctx, keyID, "").Return(fakeListTags(), nil)
+
+		_, err := keyStore.Import(ctx, id, crypto.FromECDSA(privKey), algorithm, attributes)
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should schedule the deletion of the key if the key material cannot be imported in a replica", func() {
+		ctrl := gomock.NewController(s.T())
+		defer ctrl.Finish()
+		keyStore := New(s.mockKmsClient, []string{"eu-west-1"}, testutils.NewMockLogger(ctrl))
+
+		s.mockKmsClient.EXPECT().ImportKey(gomock.Any(), alias(id), gomock.Any(), gomock.Any(), gomock.Any(), true).Return(&retCreateKey, nil)
+		s.mockKmsClient.EXPECT().ReplicateKey(gomock.Any(), alias(id), keyID, "eu-west-1", gomock.Any()).Return(&kms.ReplicateKeyOutput{}, nil)
+		s.mockKmsClient.EXPECT().ImportKeyMaterial(gomock.Any(), keyID, "eu-west-1", gomock.Any()).Return(expectedErr)
+		s.mockKmsClient.EXPECT().DeleteOrphanKey(gomock.Any(), alias(id), keyID, "eu-west-1").Return(nil)
+		s.mockKmsClient.EXPECT().DeleteOrphanKey(gomock.Any(), alias(id), keyID, "").Return(nil)
+
+		key, err := keyStore.Import(ctx, id, crypto.FromECDSA(privKey), algorithm, attributes)
+
+		assert.Nil(s.T(), key)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+
+	s.Run("should fail with InvalidParameterError if private key is invalid", func() {
+		_, err := s.keyStore.Import(ctx, id, []byte("invalid"), algorithm, attributes)
+
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
+	s.Run("should fail with same error if ImportKey fails", func() {
+		s.mockKmsClient.EXPECT().ImportKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		key, err := s.keyStore.Import(ctx, id, crypto.FromECDSA(privKey), algorithm, attributes)
+
+		assert.Nil(s.T(), key)
+		assert.Equal(s.T(), expectedErr, err)
 	})
 }
 
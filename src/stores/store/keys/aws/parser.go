@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"crypto/ecdsa"
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type publicKeyInfo struct {
@@ -23,6 +25,25 @@ type signatureInfo struct {
 	R, S *big.Int
 }
 
+var (
+	oidPublicKeyECDSA      = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// ecPrivateKey is the ASN.1 structure of an EC private key defined in RFC 5915
+type ecPrivateKey struct {
+	Version    int
+	PrivateKey []byte
+	PublicKey  asn1.BitString `asn1:"optional,explicit,tag:1"`
+}
+
+// pkcs8 is the ASN.1 structure of a private key defined in RFC 5208
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
 func parseKey(id string, kmsPubKey *kms.GetPublicKeyOutput, kmsDescribe *kms.DescribeKeyOutput, tags map[string]string) (*entities.Key, error) {
 	var algo *entities2.Algorithm
 	var pubKey []byte
@@ -95,6 +116,11 @@ func parseAnnotations(keyID string, keyDesc *kms.DescribeKeyOutput) *entities.An
 	if keyDesc.KeyMetadata.Arn != nil {
 		annotations.AWSArn = *keyDesc.KeyMetadata.Arn
 	}
+	if keyDesc.KeyMetadata.MultiRegionConfiguration != nil {
+		for _, replica := range keyDesc.KeyMetadata.MultiRegionConfiguration.ReplicaKeys {
+			annotations.AWSReplicaArns = append(annotations.AWSReplicaArns, *replica.Arn)
+		}
+	}
 
 	return annotations
 }
@@ -125,3 +151,29 @@ func toTags(tags map[string]string) []*kms.Tag {
 
 	return keyTags
 }
+
+// toPKCS8 encodes a secp256k1 private key as the DER PKCS#8 key material expected by AWS KMS on import
+func toPKCS8(privKey *ecdsa.PrivateKey) ([]byte, error) {
+	pubKey := crypto.FromECDSAPub(&privKey.PublicKey)
+	ecKey, err := asn1.Marshal(ecPrivateKey{
+		Version:    1,
+		PrivateKey: crypto.FromECDSA(privKey),
+		PublicKey:  asn1.BitString{Bytes: pubKey, BitLength: 8 * len(pubKey)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	curveParams, err := asn1.Marshal(oidNamedCurveSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(pkcs8{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: curveParams},
+		},
+		PrivateKey: ecKey,
+	})
+}
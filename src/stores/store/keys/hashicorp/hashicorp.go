@@ -84,6 +84,13 @@ func (s *Store) Import(_ context.Context, id string, privKey []byte, alg *entiti
 	return parseAPISecretToKey(res)
 }
 
+// Export is not supported, private keys never leave the vault
+func (s *Store) Export(_ context.Context, _ string) ([]byte, error) {
+	err := errors.NotSupportedError("exporting keys is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Get(_ context.Context, id string) (*entities.Key, error) {
 	logger := s.logger.With("id", id)
 
@@ -103,6 +110,13 @@ func (s *Store) Get(_ context.Context, id string) (*entities.Key, error) {
 	return parseAPISecretToKey(res)
 }
 
+// GetVersion is only supported on the index of the store
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
 	res, err := s.client.ListKeys()
 	if err != nil {
@@ -128,6 +142,13 @@ func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
 	return ids, nil
 }
 
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]string, error) {
+	err := errors.NotSupportedError("list key versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Update(_ context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
 	res, err := s.client.UpdateKey(id, map[string]interface{}{
 		tagsLabel: attr.Tags,
@@ -141,6 +162,12 @@ func (s *Store) Update(_ context.Context, id string, attr *entities.Attributes)
 	return parseAPISecretToKey(res)
 }
 
+func (s *Store) Rotate(_ context.Context, _ string, _ *entities2.Algorithm) (*entities.Key, error) {
+	err := errors.NotSupportedError("key rotation is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Delete(_ context.Context, _ string) error {
 	err := errors.NotSupportedError("delete key is not supported")
 	s.logger.Warn(err.Error())
@@ -202,6 +229,19 @@ func (s *Store) Sign(_ context.Context, id string, data []byte, alg *entities2.A
 	return signature, nil
 }
 
+func (s *Store) SignWithVersion(_ context.Context, _, _ string, _ []byte, _ *entities2.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("signing with a key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Verify is only supported on the index of the store
+func (s *Store) Verify(_ context.Context, _, _ string, _, _ []byte) error {
+	err := errors.NotSupportedError("verify signature is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
 func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
 	return nil, errors.ErrNotImplemented
 }
@@ -2,8 +2,11 @@ package local
 
 import (
 	"context"
+	"crypto/cipher"
 	"encoding/base64"
+	"strings"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/ecdsa"
 	"github.com/consensys/quorum-key-manager/pkg/crypto/eddsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
@@ -14,18 +17,25 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
 
+// encryptedValuePrefix marks the private keys encrypted with the data encryption key of the store, as opposed to
+// the ones stored base64 encoded before a master key was configured
+const encryptedValuePrefix = "encrypted:"
+
 type Store struct {
 	secretStore stores.SecretStore
 	db          database.Secrets
+	aead        cipher.AEAD
 	logger      log.Logger
 }
 
 var _ stores.KeyStore = &Store{}
 
-func New(secretStore stores.SecretStore, db database.Secrets, logger log.Logger) *Store {
+// New creates a key store keeping its private keys in a secret store. If aead is not nil, the private keys are encrypted
+func New(secretStore stores.SecretStore, db database.Secrets, aead cipher.AEAD, logger log.Logger) *Store {
 	return &Store{
 		secretStore: secretStore,
 		logger:      logger,
+		aead:        aead,
 		db:          db,
 	}
 }
@@ -34,36 +44,30 @@ func (s *Store) Get(_ context.Context, _ string) (*entities.Key, error) {
 	return nil, errors.ErrNotSupported
 }
 
-func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
-	var ids []string
-	items, err := s.db.GetAll(ctx)
-	if err != nil {
-		return nil, err
-	}
+// GetVersion is only supported on the index of the store
+func (s *Store) GetVersion(_ context.Context, _, _ string) (*entities.Key, error) {
+	err := errors.NotSupportedError("get key version is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
 
-	for _, item := range items {
-		ids = append(ids, item.ID)
-	}
+func (s *Store) List(ctx context.Context, limit, offset uint64) ([]string, error) {
+	return s.db.SearchIDs(ctx, false, limit, offset)
+}
 
-	return ids, nil
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]string, error) {
+	err := errors.NotSupportedError("list key versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
 
 func (s *Store) GetDeleted(_ context.Context, _ string) (*entities.Key, error) {
 	return nil, errors.ErrNotSupported
 }
 
-func (s *Store) ListDeleted(ctx context.Context, _, _ uint64) ([]string, error) {
-	var ids []string
-	items, err := s.db.GetAllDeleted(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, item := range items {
-		ids = append(ids, item.ID)
-	}
-
-	return ids, nil
+func (s *Store) ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error) {
+	return s.db.SearchIDs(ctx, true, limit, offset)
 }
 
 func (s *Store) Create(ctx context.Context, id string, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
@@ -77,50 +81,76 @@ func (s *Store) Import(ctx context.Context, id string, importedPrivKey []byte, a
 func (s *Store) create(ctx context.Context, id string, importedPrivKey []byte, alg *entities2.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
 	logger := s.logger.With("id", id).With("signing_algorithm", alg.Type).With("curve", alg.EllipticCurve)
 
-	var privKey []byte
-	var pubKey []byte
-	var err error
+	privKey, pubKey, err := generateKeyPair(importedPrivKey, alg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.encodePrivateKey(id, privKey, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretStore.Set(ctx, id, value, attr)
+	if err != nil && errors.IsAlreadyExistsError(err) {
+		secret, err = s.secretStore.Get(ctx, id, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Add(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(id, pubKey, alg, secret), nil
+}
+
+func (s *Store) Export(ctx context.Context, id string) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	secret, err := s.secretStore.Get(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decodePrivateKey(id, secret.Value, logger)
+}
+
+func generateKeyPair(importedPrivKey []byte, alg *entities2.Algorithm, logger log.Logger) (privKey, pubKey []byte, err error) {
 	switch {
 	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Babyjubjub:
 		privKey, pubKey, err = eddsa.CreateBabyjubjub(importedPrivKey)
 		if err != nil {
 			errMessage := "failed to generate EDDSA/Babyjujub key pair"
 			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
 		}
 	case alg.Type == entities2.Ecdsa && alg.EllipticCurve == entities2.Secp256k1:
 		privKey, pubKey, err = ecdsa.CreateSecp256k1(importedPrivKey)
 		if err != nil {
 			errMessage := "failed to generate Secp256k1/ECDSA key pair"
 			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
 		}
 	case alg.Type == entities2.Eddsa && alg.EllipticCurve == entities2.Curve25519:
 		privKey, pubKey, err = eddsa.CreateED25519(importedPrivKey)
 		if err != nil {
 			errMessage := "failed to generate EDDSA/Curve25519 key pair"
 			logger.With("error", err).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+			return nil, nil, errors.InvalidParameterError(errMessage)
 		}
 	default:
 		errMessage := "invalid signing algorithm/elliptic curve combination"
 		logger.Error(errMessage)
-		return nil, errors.InvalidParameterError(errMessage)
-	}
-
-	secret, err := s.secretStore.Set(ctx, id, base64.StdEncoding.EncodeToString(privKey), attr)
-	if err != nil && errors.IsAlreadyExistsError(err) {
-		secret, err = s.secretStore.Get(ctx, id, "")
-	}
-	if err != nil {
-		return nil, err
+		return nil, nil, errors.InvalidParameterError(errMessage)
 	}
 
-	_, err = s.db.Add(ctx, secret)
-	if err != nil {
-		return nil, err
-	}
+	return privKey, pubKey, nil
+}
 
+func newKey(id string, pubKey []byte, alg *entities2.Algorithm, secret *entities.Secret) *entities.Key {
 	return &entities.Key{
 		ID:        id,
 		PublicKey: pubKey,
@@ -129,18 +159,56 @@ func (s *Store) create(ctx context.Context, id string, importedPrivKey []byte, a
 			EllipticCurve: alg.EllipticCurve,
 		},
 		Metadata: &entities.Metadata{
+			Version:   secret.Metadata.Version,
 			Disabled:  false,
 			CreatedAt: secret.Metadata.CreatedAt,
 			UpdatedAt: secret.Metadata.UpdatedAt,
 		},
 		Tags: secret.Tags,
-	}, nil
+	}
 }
 
 func (s *Store) Update(_ context.Context, _ string, _ *entities.Attributes) (*entities.Key, error) {
 	return nil, errors.ErrNotSupported
 }
 
+// Rotate sets a new private key as a new version of the secret of the key. Secret stores without versioning, such as
+// HashiCorp KV version 1 mounts, refuse to write an existing secret and cannot rotate keys
+func (s *Store) Rotate(ctx context.Context, id string, alg *entities2.Algorithm) (*entities.Key, error) {
+	logger := s.logger.With("id", id).With("signing_algorithm", alg.Type).With("curve", alg.EllipticCurve)
+
+	current, err := s.secretStore.Get(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, pubKey, err := generateKeyPair(nil, alg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.encodePrivateKey(id, privKey, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := s.secretStore.Set(ctx, id, value, &entities.Attributes{Tags: current.Tags})
+	if err != nil && errors.IsAlreadyExistsError(err) {
+		errMessage := "key rotation is not supported by secret stores without versioning"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	} else if err != nil {
+		return nil, err
+	}
+
+	_, err = s.db.Add(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(id, pubKey, alg, secret), nil
+}
+
 func (s *Store) Delete(ctx context.Context, id string) error {
 	return s.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
 		derr := dbtx.Delete(ctx, id)
@@ -200,18 +268,20 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 }
 
 func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
-	logger := s.logger.With("id", id).With("type", algo.Type).With("curve", algo.EllipticCurve)
+	return s.SignWithVersion(ctx, id, "", data, algo)
+}
 
-	secret, err := s.secretStore.Get(ctx, id, "")
+func (s *Store) SignWithVersion(ctx context.Context, id, version string, data []byte, algo *entities2.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id).With("version", version).With("type", algo.Type).With("curve", algo.EllipticCurve)
+
+	secret, err := s.secretStore.Get(ctx, id, version)
 	if err != nil {
 		return nil, err
 	}
 
-	privkey, err := base64.StdEncoding.DecodeString(secret.Value)
+	privkey, err := s.decodePrivateKey(id, secret.Value, logger)
 	if err != nil {
-		errMessage := "failed to decode private key secret"
-		logger.Error(errMessage)
-		return nil, errors.DependencyFailureError(errMessage)
+		return nil, err
 	}
 
 	var signature []byte
@@ -237,8 +307,11 @@ func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities
 	return signature, nil
 }
 
-func (s *Store) Verify(_ context.Context, pubKey, data, sig []byte, algo *entities2.Algorithm) error {
-	return errors.ErrNotSupported
+// Verify is only supported on the index of the store
+func (s *Store) Verify(_ context.Context, _, _ string, _, _ []byte) error {
+	err := errors.NotSupportedError("verify signature is not supported")
+	s.logger.Warn(err.Error())
+	return err
 }
 
 func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, error) {
@@ -248,3 +321,121 @@ func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, erro
 func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
 	return nil, errors.ErrNotImplemented
 }
+
+// Encrypted indicates whether the store encrypts its private keys with the data encryption key of a master key
+func (s *Store) Encrypted() bool {
+	return s.aead != nil
+}
+
+// EncryptPrivateKeys encrypts the private keys stored before a master key was configured by writing them as a new
+// version of their secret, it returns the number of private keys encrypted. Previous versions of the secrets keep the
+// unencrypted private keys in secret stores with versioning
+func (s *Store) EncryptPrivateKeys(ctx context.Context) (int, error) {
+	if s.aead == nil {
+		errMessage := "no master key is configured for the key store"
+		s.logger.Error(errMessage)
+		return 0, errors.InvalidParameterError(errMessage)
+	}
+
+	ids, err := s.db.SearchIDs(ctx, false, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	var nEncrypted int
+	var lastErr error
+	for _, id := range ids {
+		logger := s.logger.With("id", id)
+
+		secret, err := s.secretStore.Get(ctx, id, "")
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if strings.HasPrefix(secret.Value, encryptedValuePrefix) {
+			continue
+		}
+
+		privKey, err := s.decodePrivateKey(id, secret.Value, logger)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		value, err := s.encodePrivateKey(id, privKey, logger)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		encrypted, err := s.secretStore.Set(ctx, id, value, &entities.Attributes{Tags: secret.Tags})
+		if err != nil {
+			logger.WithError(err).Error("failed to encrypt private key")
+			lastErr = err
+			continue
+		}
+
+		_, err = s.db.Add(ctx, encrypted)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		nEncrypted++
+	}
+
+	return nEncrypted, lastErr
+}
+
+func (s *Store) encodePrivateKey(id string, privKey []byte, logger log.Logger) (string, error) {
+	if s.aead == nil {
+		return base64.StdEncoding.EncodeToString(privKey), nil
+	}
+
+	ciphertext, err := aes.Seal(s.aead, privKey, []byte(id))
+	if err != nil {
+		errMessage := "failed to encrypt private key"
+		logger.WithError(err).Error(errMessage)
+		return "", errors.CryptoOperationError(errMessage)
+	}
+
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// decodePrivateKey decrypts the private keys encrypted by the store, private keys stored before a master key was
+// configured are still read as is
+func (s *Store) decodePrivateKey(id, value string, logger log.Logger) ([]byte, error) {
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		privKey, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			errMessage := "failed to decode private key secret"
+			logger.Error(errMessage)
+			return nil, errors.DependencyFailureError(errMessage)
+		}
+
+		return privKey, nil
+	}
+
+	if s.aead == nil {
+		errMessage := "private key is encrypted but no master key is configured for the key store"
+		logger.Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, encryptedValuePrefix))
+	if err != nil {
+		errMessage := "failed to decode private key secret"
+		logger.Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	privKey, err := aes.Open(s.aead, ciphertext, []byte(id))
+	if err != nil {
+		errMessage := "failed to decrypt private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return privKey, nil
+}
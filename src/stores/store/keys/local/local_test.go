@@ -3,15 +3,19 @@ package local
 import (
 	"context"
 	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/stretchr/testify/require"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/pkg/crypto/eddsa"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 	dbmocks "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	entities2 "github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -59,7 +63,7 @@ func (s *localKeyStoreTestSuite) SetupTest() {
 			return persist(s.mockSecretDB)
 		}).AnyTimes()
 
-	s.keyStore = New(s.mockSecretStore, s.mockSecretDB, testutils2.NewMockLogger(ctrl))
+	s.keyStore = New(s.mockSecretStore, s.mockSecretDB, nil, testutils2.NewMockLogger(ctrl))
 }
 
 func (s *localKeyStoreTestSuite) TestCreate() {
@@ -356,6 +360,100 @@ func (s *localKeyStoreTestSuite) TestSign() {
 	})
 }
 
+func (s *localKeyStoreTestSuite) TestSignWithVersion() {
+	ctx := context.Background()
+
+	s.Run("should sign with a version of an ED25519 key successfully", func() {
+		payload := []byte("my data")
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyED25519))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "1").Return(secret, nil)
+
+		signature, err := s.keyStore.SignWithVersion(ctx, id, "1", payload, &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Curve25519,
+		})
+		require.NoError(s.T(), err)
+
+		pubKey := hexutil.MustDecode(publicKeyED25519)
+		verified, err := eddsa.VerifyED25519Signature(pubKey, payload, signature)
+		require.NoError(s.T(), err)
+		assert.True(s.T(), verified)
+	})
+}
+
+func (s *localKeyStoreTestSuite) TestRotate() {
+	ctx := context.Background()
+	algo := &entities.Algorithm{
+		Type:          entities.Eddsa,
+		EllipticCurve: entities.Curve25519,
+	}
+
+	s.Run("should set a new version of the secret of the key successfully", func() {
+		current := testutils.FakeSecret()
+		rotated := testutils.FakeSecret()
+		rotated.Metadata.Version = "2"
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(current, nil)
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), &entities2.Attributes{Tags: current.Tags}).Return(rotated, nil)
+		s.mockSecretDB.EXPECT().Add(gomock.Any(), rotated).Return(rotated, nil)
+
+		key, err := s.keyStore.Rotate(ctx, id, algo)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), id, key.ID)
+		assert.Equal(s.T(), "2", key.Metadata.Version)
+		assert.Len(s.T(), key.PublicKey, 32)
+		assert.Equal(s.T(), algo, key.Algo)
+	})
+
+	s.Run("should fail with NotSupportedError if the secret store has no versioning", func() {
+		current := testutils.FakeSecret()
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(current, nil)
+		s.mockSecretStore.EXPECT().Set(ctx, id, gomock.Any(), &entities2.Attributes{Tags: current.Tags}).Return(nil, errors.AlreadyExistsError("error"))
+
+		key, err := s.keyStore.Rotate(ctx, id, algo)
+
+		assert.Nil(s.T(), key)
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if the key does not exist", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		key, err := s.keyStore.Rotate(ctx, id, algo)
+
+		assert.Nil(s.T(), key)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
+func (s *localKeyStoreTestSuite) TestExport() {
+	ctx := context.Background()
+
+	s.Run("should export the private key of a key successfully", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyED25519))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		privKey, err := s.keyStore.Export(ctx, id)
+		require.NoError(s.T(), err)
+
+		assert.Equal(s.T(), hexutil.MustDecode(privKeyED25519), privKey)
+	})
+
+	s.Run("should fail with same error if Get fails", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		_, err := s.keyStore.Export(ctx, id)
+
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
 func (s *localKeyStoreTestSuite) TestUpdate() {
 	ctx := context.Background()
 
@@ -468,3 +566,120 @@ func (s *localKeyStoreTestSuite) TestDecrypt() {
 		assert.Equal(s.T(), errors.ErrNotImplemented, err)
 	})
 }
+
+func TestEncryptedLocalKeyStore(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secretStore := mocksecrets.NewMockSecretStore(ctrl)
+	secretDB := dbmocks.NewMockSecrets(ctrl)
+	logger := testutils2.NewMockLogger(ctrl)
+
+	dek, _ := aes.NewKey()
+	aead, err := aes.NewGCM(dek)
+	require.NoError(t, err)
+
+	keyStore := New(secretStore, secretDB, aead, logger)
+	algo := &entities.Algorithm{
+		Type:          entities.Eddsa,
+		EllipticCurve: entities.Curve25519,
+	}
+	payload := []byte("my data")
+
+	t.Run("should encrypt the private key and decrypt it to sign", func(t *testing.T) {
+		secret := testutils.FakeSecret()
+		secretStore.EXPECT().Set(ctx, id, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, value string, _ *entities2.Attributes) (*entities2.Secret, error) {
+				assert.True(t, strings.HasPrefix(value, encryptedValuePrefix))
+				assert.NotContains(t, value, base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyED25519)))
+				secret.Value = value
+				return secret, nil
+			})
+		secretDB.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		key, err := keyStore.Import(ctx, id, hexutil.MustDecode(privKeyED25519), algo, testutils.FakeAttributes())
+		require.NoError(t, err)
+
+		secretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		signature, err := keyStore.Sign(ctx, id, payload, algo)
+		require.NoError(t, err)
+
+		verified, err := eddsa.VerifyED25519Signature(key.PublicKey, payload, signature)
+		require.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("should sign with a private key stored before the master key was configured", func(t *testing.T) {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyED25519))
+		secretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		signature, err := keyStore.Sign(ctx, id, payload, algo)
+		require.NoError(t, err)
+
+		verified, err := eddsa.VerifyED25519Signature(hexutil.MustDecode(publicKeyED25519), payload, signature)
+		require.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("should fail with CryptoOperationError if the private key was encrypted for another key", func(t *testing.T) {
+		ciphertext, err := aes.Seal(aead, hexutil.MustDecode(privKeyED25519), []byte("another-key"))
+		require.NoError(t, err)
+		secret := testutils.FakeSecret()
+		secret.Value = encryptedValuePrefix + base64.StdEncoding.EncodeToString(ciphertext)
+		secretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		_, err = keyStore.Sign(ctx, id, payload, algo)
+
+		assert.True(t, errors.IsCryptoOperationError(err))
+	})
+
+	t.Run("should fail with DependencyFailureError if the private key is encrypted and no master key is configured", func(t *testing.T) {
+		ciphertext, err := aes.Seal(aead, hexutil.MustDecode(privKeyED25519), []byte(id))
+		require.NoError(t, err)
+		secret := testutils.FakeSecret()
+		secret.Value = encryptedValuePrefix + base64.StdEncoding.EncodeToString(ciphertext)
+		secretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		_, err = New(secretStore, secretDB, nil, logger).Sign(ctx, id, payload, algo)
+
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+
+	t.Run("should encrypt the private keys stored before the master key was configured", func(t *testing.T) {
+		legacy := testutils.FakeSecret()
+		legacy.ID = "legacy-key"
+		legacy.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyED25519))
+		ciphertext, err := aes.Seal(aead, hexutil.MustDecode(privKeyED25519), []byte(id))
+		require.NoError(t, err)
+		encrypted := testutils.FakeSecret()
+		encrypted.Value = encryptedValuePrefix + base64.StdEncoding.EncodeToString(ciphertext)
+		rewritten := testutils.FakeSecret()
+
+		secretDB.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return([]string{"legacy-key", id}, nil)
+		secretStore.EXPECT().Get(ctx, "legacy-key", "").Return(legacy, nil)
+		secretStore.EXPECT().Get(ctx, id, "").Return(encrypted, nil)
+		secretStore.EXPECT().Set(ctx, "legacy-key", gomock.Any(), &entities2.Attributes{Tags: legacy.Tags}).
+			DoAndReturn(func(_ context.Context, _, value string, _ *entities2.Attributes) (*entities2.Secret, error) {
+				assert.True(t, strings.HasPrefix(value, encryptedValuePrefix))
+				privKey, err := keyStore.decodePrivateKey("legacy-key", value, logger)
+				require.NoError(t, err)
+				assert.Equal(t, hexutil.MustDecode(privKeyED25519), privKey)
+				return rewritten, nil
+			})
+		secretDB.EXPECT().Add(gomock.Any(), rewritten).Return(rewritten, nil)
+
+		n, err := keyStore.EncryptPrivateKeys(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("should fail with InvalidParameterError if no master key is configured", func(t *testing.T) {
+		_, err := New(secretStore, secretDB, nil, logger).EncryptPrivateKeys(ctx)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
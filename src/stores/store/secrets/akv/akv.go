@@ -30,7 +30,8 @@ func (s *Store) Info(context.Context) (*entities.Store, error) {
 }
 
 func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
-	res, err := s.client.SetSecret(ctx, id, value, attr.Tags)
+	// The value type is stored as the content type of the secret
+	res, err := s.client.SetSecret(ctx, id, value, string(attr.ValueType), attr.Tags)
 	if err != nil {
 		errMessage := "failed to create AKV secret"
 		s.logger.With("id", id).WithError(err).Error(errMessage)
@@ -40,6 +41,20 @@ func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attrib
 	return parseSecretBundle(&res), nil
 }
 
+// CompareAndSet is only supported on the index of the store
+func (s *Store) CompareAndSet(_ context.Context, _, _, _ string, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("compare and set secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Generate is handled by the connector of the store
+func (s *Store) Generate(_ context.Context, _ string, _ *entities.SecretPolicy, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("generate secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
 	res, err := s.client.GetSecret(ctx, id, version)
 	if err != nil {
@@ -69,6 +84,13 @@ func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	return list, nil
 }
 
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("list secret versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Delete(ctx context.Context, id string) error {
 	_, err := s.client.DeleteSecret(ctx, id)
 	if err != nil {
@@ -130,3 +152,15 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (s *Store) RestoreVersion(_ context.Context, _, _ string) error {
+	err := errors.NotSupportedError("restore secret version is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) DestroyVersion(_ context.Context, _, _ string) error {
+	err := errors.NotSupportedError("destroy secret version is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/consensys/quorum-key-manager/pkg/common"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -50,13 +51,16 @@ func (s *akvSecretStoreTestSuite) TestSet() {
 	secretBundleID := id + "/" + version
 	value := "my-value1"
 	attributes := testutils.FakeAttributes()
+	attributes.ValueType = entities.JSONSecretValue
+	contentType := string(entities.JSONSecretValue)
 
 	expectedCreatedAt, _ := time.Parse(time.RFC3339, "2018-03-22T02:24:06.945319214Z")
 	expectedUpdatedAt, _ := time.Parse(time.RFC3339, "2018-03-22T02:24:06.945319214Z")
 
 	res := keyvault.SecretBundle{
-		Value: &value,
-		ID:    &secretBundleID,
+		Value:       &value,
+		ContentType: &contentType,
+		ID:          &secretBundleID,
 		Attributes: &keyvault.SecretAttributes{
 			Created: &(&struct{ x date.UnixTime }{date.NewUnixTimeFromNanoseconds(expectedCreatedAt.UnixNano())}).x,
 			Updated: &(&struct{ x date.UnixTime }{date.NewUnixTimeFromNanoseconds(expectedUpdatedAt.UnixNano())}).x,
@@ -66,12 +70,13 @@ func (s *akvSecretStoreTestSuite) TestSet() {
 	}
 
 	s.Run("should set a new secret successfully", func() {
-		s.mockVault.EXPECT().SetSecret(gomock.Any(), id, value, attributes.Tags).Return(res, nil)
+		s.mockVault.EXPECT().SetSecret(gomock.Any(), id, value, contentType, attributes.Tags).Return(res, nil)
 
 		secret, err := s.secretStore.Set(ctx, id, value, attributes)
 
 		assert.NoError(s.T(), err)
 		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), entities.JSONSecretValue, secret.ValueType)
 		assert.Equal(s.T(), expectedCreatedAt, secret.Metadata.CreatedAt)
 		assert.Equal(s.T(), attributes.Tags, secret.Tags)
 		assert.Equal(s.T(), version, secret.Metadata.Version)
@@ -81,7 +86,7 @@ func (s *akvSecretStoreTestSuite) TestSet() {
 	})
 
 	s.Run("should fail with same error if write fails", func() {
-		s.mockVault.EXPECT().SetSecret(gomock.Any(), id, value, attributes.Tags).Return(keyvault.SecretBundle{}, expectedErr)
+		s.mockVault.EXPECT().SetSecret(gomock.Any(), id, value, contentType, attributes.Tags).Return(keyvault.SecretBundle{}, expectedErr)
 
 		secret, err := s.secretStore.Set(ctx, id, value, attributes)
 
@@ -10,14 +10,14 @@ import (
 )
 
 func parseDeletedSecretBundle(secretBundle *keyvault.DeletedSecretBundle) *entities.Secret {
-	return buildNewSecret(secretBundle.ID, secretBundle.Value, secretBundle.Tags, secretBundle.Attributes)
+	return buildNewSecret(secretBundle.ID, secretBundle.Value, secretBundle.ContentType, secretBundle.Tags, secretBundle.Attributes)
 }
 
 func parseSecretBundle(secretBundle *keyvault.SecretBundle) *entities.Secret {
-	return buildNewSecret(secretBundle.ID, secretBundle.Value, secretBundle.Tags, secretBundle.Attributes)
+	return buildNewSecret(secretBundle.ID, secretBundle.Value, secretBundle.ContentType, secretBundle.Tags, secretBundle.Attributes)
 }
 
-func buildNewSecret(id, value *string, tags map[string]*string, attributes *keyvault.SecretAttributes) *entities.Secret {
+func buildNewSecret(id, value, contentType *string, tags map[string]*string, attributes *keyvault.SecretAttributes) *entities.Secret {
 	secret := &entities.Secret{
 		Tags:     common.Tomapstr(tags),
 		Metadata: &entities.Metadata{},
@@ -25,6 +25,9 @@ func buildNewSecret(id, value *string, tags map[string]*string, attributes *keyv
 	if value != nil {
 		secret.Value = *value
 	}
+	if contentType != nil {
+		secret.ValueType = entities.ParseSecretValueType(*contentType)
+	}
 
 	if id != nil {
 		// path.Base to only retrieve the secretVersion instead of https://<vaultName>.vault.azure.net/secrets/<secretName>/<secretVersion>
@@ -13,6 +13,9 @@ import (
 
 const (
 	maxTagsAllowed = 50
+	// valueTypeTag is the tag storing the value type of a secret, it is not returned with the tags of the secret.
+	// AWS tags apply to the whole secret rather than to each version, the tag holds the type of the current version only
+	valueTypeTag = "qkm-value-type"
 )
 
 type Store struct {
@@ -51,15 +54,23 @@ func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attrib
 	}
 
 	// Tag secret resource when tags found
-	if len(attr.Tags) > 0 {
+	secretTags := attr.Tags
+	if attr.ValueType != "" {
+		secretTags = make(map[string]string, len(attr.Tags)+1)
+		for k, v := range attr.Tags {
+			secretTags[k] = v
+		}
+		secretTags[valueTypeTag] = string(attr.ValueType)
+	}
+	if len(secretTags) > 0 {
 		// check overall len must be limited to max according to doc
-		if len(attr.Tags) > maxTagsAllowed {
+		if len(secretTags) > maxTagsAllowed {
 			errMessage := fmt.Sprintf("resource may not be tagged with more than %d items", maxTagsAllowed)
 			logger.WithError(err).Error(errMessage)
 			return nil, errors.InvalidParameterError(errMessage)
 		}
 
-		_, err = s.client.TagSecretResource(ctx, id, attr.Tags)
+		_, err = s.client.TagSecretResource(ctx, id, secretTags)
 		if err != nil {
 			errMessage := "failed to set AWS secret tags"
 			logger.WithError(err).Error(errMessage)
@@ -77,6 +88,20 @@ func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attrib
 	return formatAwsSecret(id, value, tags, metadata), nil
 }
 
+// CompareAndSet is only supported on the index of the store
+func (s *Store) CompareAndSet(_ context.Context, _, _, _ string, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("compare and set secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Generate is handled by the connector of the store
+func (s *Store) Generate(_ context.Context, _ string, _ *entities.SecretPolicy, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("generate secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
 	logger := s.logger.With("id", id)
 
@@ -94,7 +119,13 @@ func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret,
 		return nil, errors.FromError(err).SetMessage(errMessage)
 	}
 
-	return formatAwsSecret(id, *getSecretOutput.SecretString, tags, metadata), nil
+	secret := formatAwsSecret(id, *getSecretOutput.SecretString, tags, metadata)
+	// The value type tag only describes the current version, the type of older versions is left to the index
+	if getSecretOutput.VersionId != nil && *getSecretOutput.VersionId != metadata.Version {
+		secret.ValueType = ""
+	}
+
+	return secret, nil
 }
 
 func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
@@ -119,6 +150,13 @@ func (s *Store) List(ctx context.Context, _, _ uint64) ([]string, error) {
 	return result, nil
 }
 
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("list secret versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Delete(ctx context.Context, id string) error {
 	_, err := s.client.DeleteSecret(ctx, id)
 	if err != nil {
@@ -164,6 +202,18 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 	return nil
 }
 
+func (s *Store) RestoreVersion(_ context.Context, _, _ string) error {
+	err := errors.NotSupportedError("restore secret version is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Store) DestroyVersion(_ context.Context, _, _ string) error {
+	err := errors.NotSupportedError("destroy secret version is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
 func (s *Store) listPaginated(ctx context.Context, maxResults int64, nextToken string) (resList []string, resNextToken *string, err error) {
 	listOutput, err := s.client.ListSecrets(ctx, maxResults, nextToken)
 	if err != nil {
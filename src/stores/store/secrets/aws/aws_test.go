@@ -14,6 +14,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -165,6 +166,35 @@ func (s *awsSecretStoreTestSuite) TestGet() {
 		assert.Equal(s.T(), retValue.ID, expectedSecret.ID)
 	})
 
+	s.Run("should get the value type of a secret from its tags", func() {
+		tags := testutils.FakeTags()
+		tags[valueTypeTag] = "json"
+		metadata := testutils.FakeMetadata()
+		metadata.Version = version
+		s.mockVault.EXPECT().GetSecret(gomock.Any(), id, "").Return(getSecretOutput, nil)
+		s.mockVault.EXPECT().DescribeSecret(gomock.Any(), id).Return(tags, metadata, nil)
+
+		retValue, err := s.secretStore.Get(ctx, id, "")
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), entities.JSONSecretValue, retValue.ValueType)
+		assert.Equal(s.T(), testutils.FakeTags(), retValue.Tags)
+	})
+
+	s.Run("should not get the value type of a version other than the current one", func() {
+		tags := testutils.FakeTags()
+		tags[valueTypeTag] = "json"
+		metadata := testutils.FakeMetadata()
+		metadata.Version = "current-version"
+		s.mockVault.EXPECT().GetSecret(gomock.Any(), id, version).Return(getSecretOutput, nil)
+		s.mockVault.EXPECT().DescribeSecret(gomock.Any(), id).Return(tags, metadata, nil)
+
+		retValue, err := s.secretStore.Get(ctx, id, version)
+
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), retValue.ValueType)
+	})
+
 	s.Run("should fail with same error if Secret fails", func() {
 		s.mockVault.EXPECT().GetSecret(gomock.Any(), id, version).Return(getSecretOutput, expectedErr)
 
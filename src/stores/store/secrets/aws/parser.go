@@ -5,10 +5,22 @@ import (
 )
 
 func formatAwsSecret(id, value string, tags map[string]string, metadata *entities.Metadata) *entities.Secret {
-	return &entities.Secret{
+	secret := &entities.Secret{
 		ID:       id,
 		Value:    value,
 		Tags:     tags,
 		Metadata: metadata,
 	}
+
+	if valueType, ok := tags[valueTypeTag]; ok {
+		secret.ValueType = entities.ParseSecretValueType(valueType)
+		secret.Tags = make(map[string]string, len(tags)-1)
+		for k, v := range tags {
+			if k != valueTypeTag {
+				secret.Tags[k] = v
+			}
+		}
+	}
+
+	return secret
 }
@@ -3,7 +3,9 @@ package hashicorp
 import (
 	"context"
 	"encoding/json"
+	"path"
 	"strconv"
+	"strings"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/infra/hashicorp"
@@ -11,37 +13,38 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/hashicorp/vault/api"
 )
 
 const (
-	valueLabel   = "value"
-	tagsLabel    = "tags"
-	versionLabel = "version"
+	valueLabel     = "value"
+	valueTypeLabel = "valueType"
+	tagsLabel      = "tags"
+	versionLabel   = "version"
 )
 
 type Store struct {
-	client hashicorp.Kvv2Client
-	db     database.Secrets
-	logger log.Logger
+	client     hashicorp.Kvv2Client
+	db         database.Secrets
+	pathPrefix string
+	logger     log.Logger
 }
 
 var _ stores.SecretStore = &Store{}
 
-func New(client hashicorp.Kvv2Client, db database.Secrets, logger log.Logger) *Store {
+func New(client hashicorp.Kvv2Client, db database.Secrets, pathPrefix string, logger log.Logger) *Store {
 	return &Store{
-		client: client,
-		logger: logger,
-		db:     db,
+		client:     client,
+		logger:     logger,
+		db:         db,
+		pathPrefix: pathPrefix,
 	}
 }
 
 func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
 	logger := s.logger.With("id", id)
 
-	secretItem, err := s.client.SetSecret(id, map[string]interface{}{
-		valueLabel: value,
-		tagsLabel:  attr.Tags,
-	})
+	secretItem, err := s.client.SetSecret(s.path(id), secretData(value, attr))
 	if err != nil {
 		errMessage := "failed to create Hashicorp secret"
 		logger.WithError(err).Error(errMessage)
@@ -51,6 +54,20 @@ func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attrib
 	return s.Get(ctx, id, string(secretItem.Data[versionLabel].(json.Number)))
 }
 
+// CompareAndSet is only supported on the index of the store
+func (s *Store) CompareAndSet(_ context.Context, _, _, _ string, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("compare and set secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Generate is handled by the connector of the store
+func (s *Store) Generate(_ context.Context, _ string, _ *entities.SecretPolicy, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("generate secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, error) {
 	logger := s.logger.With("id", id, "version", version)
 
@@ -68,7 +85,7 @@ func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, er
 		}
 	}
 
-	hashicorpSecretData, err := s.client.ReadData(id, callData)
+	hashicorpSecretData, err := s.client.ReadData(s.path(id), callData)
 	if err != nil {
 		errMessage := "failed to get Hashicorp secret data"
 		logger.WithError(err).Error(errMessage)
@@ -83,7 +100,7 @@ func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, er
 	value := data[valueLabel].(string)
 
 	// We need to do a second call to get the metadata
-	hashicorpSecretMetadata, err := s.client.ReadMetadata(id)
+	hashicorpSecretMetadata, err := s.client.ReadMetadata(s.path(id))
 	if err != nil {
 		errMessage := "failed to get Hashicorp secret metadata"
 		logger.WithError(err).Error(errMessage)
@@ -102,28 +119,27 @@ func (s *Store) Get(_ context.Context, id, version string) (*entities.Secret, er
 		tags = formatTags(data[tagsLabel].(map[string]interface{}))
 	}
 
-	return formatHashicorpSecret(id, value, tags, metadata), nil
+	secret := formatHashicorpSecret(id, value, tags, metadata)
+	secret.ValueType = parseValueType(data)
+	return secret, nil
 }
 
 func (s *Store) List(_ context.Context, _, _ uint64) ([]string, error) {
-	res, err := s.client.ListSecrets()
+	ids, err := listRecursive(s.client.ListSecrets, s.pathPrefix, "")
 	if err != nil {
 		errMessage := "failed to list Hashicorp secrets"
 		s.logger.WithError(err).Error(errMessage)
 		return nil, errors.FromError(err).SetMessage(errMessage)
 	}
 
-	if res == nil {
-		return []string{}, nil
-	}
-
-	keysInterface := res.Data["keys"].([]interface{})
-	keysStr := make([]string, len(keysInterface))
-	for i, key := range keysInterface {
-		keysStr[i] = key.(string)
-	}
+	return ids, nil
+}
 
-	return keysStr, nil
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("list secret versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
 }
 
 func (s *Store) Delete(ctx context.Context, id string) error {
@@ -133,7 +149,7 @@ func (s *Store) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
-	hashicorpSecretData, err := s.client.ReadData(id, map[string][]string{
+	hashicorpSecretData, err := s.client.ReadData(s.path(id), map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -147,7 +163,7 @@ func (s *Store) Delete(ctx context.Context, id string) error {
 		return errors.NotFoundError(errMessage)
 	}
 
-	err = s.client.DeleteSecret(id, map[string][]string{
+	err = s.client.DeleteSecret(s.path(id), map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -179,7 +195,7 @@ func (s *Store) Restore(ctx context.Context, id string) error {
 		return err
 	}
 
-	err = s.client.RestoreSecret(id, map[string][]string{
+	err = s.client.RestoreSecret(s.path(id), map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -199,7 +215,7 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 		return err
 	}
 
-	err = s.client.DestroySecret(id, map[string][]string{
+	err = s.client.DestroySecret(s.path(id), map[string][]string{
 		"versions": versions,
 	})
 	if err != nil {
@@ -211,6 +227,32 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 	return nil
 }
 
+func (s *Store) RestoreVersion(_ context.Context, id, version string) error {
+	err := s.client.RestoreSecret(s.path(id), map[string][]string{
+		"versions": {version},
+	})
+	if err != nil {
+		errMessage := "failed to restore Hashicorp secret version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (s *Store) DestroyVersion(_ context.Context, id, version string) error {
+	err := s.client.DestroySecret(s.path(id), map[string][]string{
+		"versions": {version},
+	})
+	if err != nil {
+		errMessage := "failed to destroy Hashicorp secret version"
+		s.logger.With("id", id, "version", version).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
 func (s *Store) listVersions(ctx context.Context, id string, isDeleted bool) ([]string, error) {
 	versionList, err := s.db.ListVersions(ctx, id, isDeleted)
 	if err != nil {
@@ -228,3 +270,38 @@ func (s *Store) listVersions(ctx context.Context, id string, isDeleted bool) ([]
 
 	return versionList, nil
 }
+
+func (s *Store) path(id string) string {
+	return path.Join(s.pathPrefix, id)
+}
+
+// listRecursive walks the folders below prefix and returns the ids of all the secrets found, relative to prefix
+func listRecursive(list func(dir string) (*api.Secret, error), prefix, dir string) ([]string, error) {
+	res, err := list(path.Join(prefix, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	if res == nil {
+		return ids, nil
+	}
+
+	keys, _ := res.Data["keys"].([]interface{})
+	for _, key := range keys {
+		name := key.(string)
+		if !strings.HasSuffix(name, "/") {
+			ids = append(ids, dir+name)
+			continue
+		}
+
+		// Keys ending with a slash are folders containing nested secrets
+		nestedIDs, err := listRecursive(list, prefix, dir+name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, nestedIDs...)
+	}
+
+	return ids, nil
+}
@@ -12,6 +12,7 @@ import (
 	dbmocks "github.com/consensys/quorum-key-manager/src/stores/database/mock"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/golang/mock/gomock"
 	hashicorp "github.com/hashicorp/vault/api"
@@ -40,7 +41,7 @@ func (s *hashicorpSecretStoreTestSuite) SetupTest() {
 	s.mockVault = mocks.NewMockKvv2Client(ctrl)
 	s.mockDB = dbmocks.NewMockSecrets(ctrl)
 
-	s.secretStore = New(s.mockVault, s.mockDB, testutils2.NewMockLogger(ctrl))
+	s.secretStore = New(s.mockVault, s.mockDB, "", testutils2.NewMockLogger(ctrl))
 }
 
 func (s *hashicorpSecretStoreTestSuite) TestSet() {
@@ -49,13 +50,16 @@ func (s *hashicorpSecretStoreTestSuite) TestSet() {
 	value := "my-value2"
 	version := "3"
 	attributes := testutils.FakeAttributes()
+	attributes.ValueType = entities.Base64SecretValue
 	expectedWriteData := map[string]interface{}{
-		valueLabel: value,
-		tagsLabel:  attributes.Tags,
+		valueLabel:     value,
+		valueTypeLabel: "base64",
+		tagsLabel:      attributes.Tags,
 	}
 
 	expectedData := map[string]interface{}{
-		valueLabel: value,
+		valueLabel:     value,
+		valueTypeLabel: "base64",
 		tagsLabel: map[string]interface{}{
 			"tag1": attributes.Tags["tag1"],
 			"tag2": attributes.Tags["tag2"],
@@ -114,6 +118,7 @@ func (s *hashicorpSecretStoreTestSuite) TestSet() {
 
 		assert.NoError(s.T(), err)
 		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), entities.Base64SecretValue, secret.ValueType)
 		assert.Equal(s.T(), expectedCreatedAt, secret.Metadata.CreatedAt)
 		assert.Equal(s.T(), attributes.Tags, secret.Tags)
 		assert.Equal(s.T(), version, secret.Metadata.Version)
@@ -257,7 +262,7 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 			},
 		}
 
-		s.mockVault.EXPECT().ListSecrets().Return(hashicorpSecret, nil)
+		s.mockVault.EXPECT().ListSecrets("").Return(hashicorpSecret, nil)
 
 		ids, err := s.secretStore.List(ctx, 0, 0)
 
@@ -265,8 +270,31 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 		assert.Equal(s.T(), keysStr, ids)
 	})
 
+	s.Run("should list secret ids in nested folders recursively", func() {
+		s.mockVault.EXPECT().ListSecrets("").Return(&hashicorp.Secret{
+			Data: map[string]interface{}{
+				"keys": []interface{}{"my-secret1", "folder/"},
+			},
+		}, nil)
+		s.mockVault.EXPECT().ListSecrets("folder").Return(&hashicorp.Secret{
+			Data: map[string]interface{}{
+				"keys": []interface{}{"my-secret2", "nested/"},
+			},
+		}, nil)
+		s.mockVault.EXPECT().ListSecrets("folder/nested").Return(&hashicorp.Secret{
+			Data: map[string]interface{}{
+				"keys": []interface{}{"my-secret3"},
+			},
+		}, nil)
+
+		ids, err := s.secretStore.List(ctx, 0, 0)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"my-secret1", "folder/my-secret2", "folder/nested/my-secret3"}, ids)
+	})
+
 	s.Run("should return empty list if result is nil", func() {
-		s.mockVault.EXPECT().ListSecrets().Return(nil, nil)
+		s.mockVault.EXPECT().ListSecrets("").Return(nil, nil)
 
 		ids, err := s.secretStore.List(ctx, 0, 0)
 
@@ -275,7 +303,7 @@ func (s *hashicorpSecretStoreTestSuite) TestList() {
 	})
 
 	s.Run("should fail with same error if ListSecrets fails", func() {
-		s.mockVault.EXPECT().ListSecrets().Return(nil, expectedErr)
+		s.mockVault.EXPECT().ListSecrets("").Return(nil, expectedErr)
 
 		ids, err := s.secretStore.List(ctx, 0, 0)
 
@@ -359,3 +387,37 @@ func (s *hashicorpSecretStoreTestSuite) TestDestroy() {
 		assert.True(s.T(), errors.IsHashicorpVaultError(err))
 	})
 }
+
+func (s *hashicorpSecretStoreTestSuite) TestRestoreVersion() {
+	ctx := context.Background()
+	id := "my-restored-secret"
+
+	s.Run("should restore secret version successfully", func() {
+		s.mockVault.EXPECT().RestoreSecret(id, map[string][]string{"versions": {"2"}}).Return(nil)
+		err := s.secretStore.RestoreVersion(ctx, id, "2")
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if restore secret version fails", func() {
+		s.mockVault.EXPECT().RestoreSecret(id, gomock.Any()).Return(expectedErr)
+		err := s.secretStore.RestoreVersion(ctx, id, "2")
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *hashicorpSecretStoreTestSuite) TestDestroyVersion() {
+	ctx := context.Background()
+	id := "my-destroyed-secret"
+
+	s.Run("should destroy secret version successfully", func() {
+		s.mockVault.EXPECT().DestroySecret(id, map[string][]string{"versions": {"2"}}).Return(nil)
+		err := s.secretStore.DestroyVersion(ctx, id, "2")
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with same error if destroy secret version fails", func() {
+		s.mockVault.EXPECT().DestroySecret(id, gomock.Any()).Return(expectedErr)
+		err := s.secretStore.DestroyVersion(ctx, id, "2")
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
@@ -0,0 +1,188 @@
+package hashicorp
+
+import (
+	"context"
+	"path"
+	"sync"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// KV version 1 engines are not versioned, every secret is then exposed with this single version
+const kvv1Version = "1"
+
+// Kvv1Store is a secret store backed by a Hashicorp KV version 1 engine.
+// Deleting a secret permanently removes it from Vault, so deleted secrets cannot be restored.
+type Kvv1Store struct {
+	client     hashicorp.Kvv1Client
+	pathPrefix string
+	logger     log.Logger
+
+	// setMux serializes the existence check and the write of Set within this instance
+	setMux sync.Mutex
+}
+
+var _ stores.SecretStore = &Kvv1Store{}
+
+func NewKvv1(client hashicorp.Kvv1Client, pathPrefix string, logger log.Logger) *Kvv1Store {
+	return &Kvv1Store{
+		client:     client,
+		pathPrefix: pathPrefix,
+		logger:     logger,
+	}
+}
+
+// Set creates a secret, failing if it already exists as KV version 1 has no versioning and writing an existing secret
+// would silently override its value. Vault offers no atomic create on KV version 1: the check is serialized within this
+// instance only, it is best-effort against other instances or clients writing the same path concurrently
+func (s *Kvv1Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	logger := s.logger.With("id", id)
+
+	s.setMux.Lock()
+	defer s.setMux.Unlock()
+
+	_, err := s.Get(ctx, id, "")
+	if err == nil {
+		errMessage := "Hashicorp secret already exists"
+		logger.Error(errMessage)
+		return nil, errors.AlreadyExistsError(errMessage)
+	} else if !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	err = s.client.WriteKvv1(s.path(id), secretData(value, attr))
+	if err != nil {
+		errMessage := "failed to create Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return s.Get(ctx, id, "")
+}
+
+// CompareAndSet is only supported on the index of the store
+func (s *Kvv1Store) CompareAndSet(_ context.Context, _, _, _ string, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("compare and set secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Generate is handled by the connector of the store
+func (s *Kvv1Store) Generate(_ context.Context, _ string, _ *entities.SecretPolicy, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("generate secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Kvv1Store) Get(_ context.Context, id, version string) (*entities.Secret, error) {
+	logger := s.logger.With("id", id, "version", version)
+
+	if version != "" && version != kvv1Version {
+		errMessage := "Hashicorp KV version 1 secrets only have a single version"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	hashicorpSecret, err := s.client.ReadKvv1(s.path(id))
+	if err != nil {
+		errMessage := "failed to get Hashicorp secret data"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	} else if hashicorpSecret == nil {
+		errMessage := "Hashicorp secret not found"
+		logger.Error(errMessage)
+		return nil, errors.NotFoundError(errMessage)
+	}
+
+	value, ok := hashicorpSecret.Data[valueLabel].(string)
+	if !ok {
+		errMessage := "failed to parse Hashicorp secret"
+		logger.Error(errMessage)
+		return nil, errors.HashicorpVaultError(errMessage)
+	}
+
+	var tags map[string]string
+	if tagsI, ok := hashicorpSecret.Data[tagsLabel].(map[string]interface{}); ok {
+		tags = formatTags(tagsI)
+	}
+
+	secret := formatHashicorpSecret(id, value, tags, &entities.Metadata{Version: kvv1Version})
+	secret.ValueType = parseValueType(hashicorpSecret.Data)
+	return secret, nil
+}
+
+func (s *Kvv1Store) List(_ context.Context, _, _ uint64) ([]string, error) {
+	ids, err := listRecursive(s.client.ListKvv1, s.pathPrefix, "")
+	if err != nil {
+		errMessage := "failed to list Hashicorp secrets"
+		s.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return ids, nil
+}
+
+// ListVersions is only supported on the index of the store
+func (s *Kvv1Store) ListVersions(_ context.Context, _ string) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("list secret versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Kvv1Store) Delete(_ context.Context, id string) error {
+	logger := s.logger.With("id", id)
+
+	err := s.client.DeleteKvv1(s.path(id))
+	if err != nil {
+		errMessage := "failed to delete Hashicorp secret"
+		logger.WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Warn("Hashicorp KV version 1 secrets are permanently deleted and cannot be restored")
+	return nil
+}
+
+func (s *Kvv1Store) GetDeleted(_ context.Context, _ string) (*entities.Secret, error) {
+	err := errors.NotSupportedError("get deleted secret is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Kvv1Store) ListDeleted(_ context.Context, _, _ uint64) ([]string, error) {
+	err := errors.NotSupportedError("list deleted secret is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Kvv1Store) Restore(_ context.Context, _ string) error {
+	// Secrets are permanently removed from Vault on deletion
+	err := errors.NotSupportedError("Hashicorp KV version 1 secrets cannot be restored once deleted")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Kvv1Store) Destroy(_ context.Context, _ string) error {
+	// Secrets are already permanently removed on deletion
+	return nil
+}
+
+func (s *Kvv1Store) RestoreVersion(_ context.Context, _, _ string) error {
+	err := errors.NotSupportedError("restore secret version is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Kvv1Store) DestroyVersion(_ context.Context, _, _ string) error {
+	err := errors.NotSupportedError("destroy secret version is not supported")
+	s.logger.Warn(err.Error())
+	return err
+}
+
+func (s *Kvv1Store) path(id string) string {
+	return path.Join(s.pathPrefix, id)
+}
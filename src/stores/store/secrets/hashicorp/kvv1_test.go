@@ -0,0 +1,160 @@
+package hashicorp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/hashicorp/mocks"
+	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	hashicorp "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type hashicorpKvv1SecretStoreTestSuite struct {
+	suite.Suite
+	mockVault   *mocks.MockKvv1Client
+	secretStore stores.SecretStore
+}
+
+func TestHashicorpKvv1SecretStore(t *testing.T) {
+	s := new(hashicorpKvv1SecretStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *hashicorpKvv1SecretStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.mockVault = mocks.NewMockKvv1Client(ctrl)
+
+	s.secretStore = NewKvv1(s.mockVault, "team-a", testutils2.NewMockLogger(ctrl))
+}
+
+func (s *hashicorpKvv1SecretStoreTestSuite) TestSet() {
+	ctx := context.Background()
+	id := "my-secret"
+	value := "my-value"
+	attributes := testutils.FakeAttributes()
+	hashicorpSecret := &hashicorp.Secret{
+		Data: map[string]interface{}{
+			valueLabel: value,
+			tagsLabel: map[string]interface{}{
+				"tag1": attributes.Tags["tag1"],
+				"tag2": attributes.Tags["tag2"],
+			},
+		},
+	}
+
+	s.Run("should set a new secret under the path prefix successfully", func() {
+		gomock.InOrder(
+			s.mockVault.EXPECT().ReadKvv1("team-a/my-secret").Return(nil, nil),
+			s.mockVault.EXPECT().WriteKvv1("team-a/my-secret", map[string]interface{}{
+				valueLabel: value,
+				tagsLabel:  attributes.Tags,
+			}).Return(nil),
+			s.mockVault.EXPECT().ReadKvv1("team-a/my-secret").Return(hashicorpSecret, nil),
+		)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), id, secret.ID)
+		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), attributes.Tags, secret.Tags)
+		assert.Equal(s.T(), "1", secret.Metadata.Version)
+	})
+
+	s.Run("should fail with AlreadyExistsError if secret already exists", func() {
+		s.mockVault.EXPECT().ReadKvv1("team-a/my-secret").Return(hashicorpSecret, nil)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsAlreadyExistsError(err))
+	})
+
+	s.Run("should fail with same error if WriteKvv1 fails", func() {
+		s.mockVault.EXPECT().ReadKvv1("team-a/my-secret").Return(nil, nil)
+		s.mockVault.EXPECT().WriteKvv1("team-a/my-secret", gomock.Any()).Return(expectedErr)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *hashicorpKvv1SecretStoreTestSuite) TestGet() {
+	ctx := context.Background()
+	id := "my-secret"
+
+	s.Run("should fail with InvalidParameterError if version is not 1", func() {
+		secret, err := s.secretStore.Get(ctx, id, "2")
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsInvalidParameterError(err))
+	})
+
+	s.Run("should fail with NotFoundError if secret does not exist", func() {
+		s.mockVault.EXPECT().ReadKvv1("team-a/my-secret").Return(nil, nil)
+
+		secret, err := s.secretStore.Get(ctx, id, "1")
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsNotFoundError(err))
+	})
+}
+
+func (s *hashicorpKvv1SecretStoreTestSuite) TestList() {
+	ctx := context.Background()
+
+	s.Run("should list secret ids in nested folders recursively", func() {
+		s.mockVault.EXPECT().ListKvv1("team-a").Return(&hashicorp.Secret{
+			Data: map[string]interface{}{
+				"keys": []interface{}{"my-secret1", "folder/"},
+			},
+		}, nil)
+		s.mockVault.EXPECT().ListKvv1("team-a/folder").Return(&hashicorp.Secret{
+			Data: map[string]interface{}{
+				"keys": []interface{}{"my-secret2"},
+			},
+		}, nil)
+
+		ids, err := s.secretStore.List(ctx, 0, 0)
+
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), []string{"my-secret1", "folder/my-secret2"}, ids)
+	})
+
+	s.Run("should fail with same error if ListKvv1 fails", func() {
+		s.mockVault.EXPECT().ListKvv1("team-a").Return(nil, expectedErr)
+
+		ids, err := s.secretStore.List(ctx, 0, 0)
+
+		assert.Empty(s.T(), ids)
+		assert.True(s.T(), errors.IsHashicorpVaultError(err))
+	})
+}
+
+func (s *hashicorpKvv1SecretStoreTestSuite) TestDelete() {
+	ctx := context.Background()
+
+	s.Run("should permanently delete secret successfully", func() {
+		s.mockVault.EXPECT().DeleteKvv1("team-a/my-secret").Return(nil)
+
+		err := s.secretStore.Delete(ctx, "my-secret")
+
+		assert.NoError(s.T(), err)
+	})
+
+	s.Run("should fail with NotSupportedError on restore", func() {
+		err := s.secretStore.Restore(ctx, "my-secret")
+
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+}
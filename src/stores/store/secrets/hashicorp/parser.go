@@ -20,6 +20,24 @@ func formatHashicorpSecret(id, value string, tags map[string]string, metadata *e
 	}
 }
 
+// secretData returns the data written to Vault for a secret, its value type is only written when set
+func secretData(value string, attr *entities.Attributes) map[string]interface{} {
+	data := map[string]interface{}{
+		valueLabel: value,
+		tagsLabel:  attr.Tags,
+	}
+	if attr.ValueType != "" {
+		data[valueTypeLabel] = string(attr.ValueType)
+	}
+
+	return data
+}
+
+func parseValueType(data map[string]interface{}) entities.SecretValueType {
+	valueType, _ := data[valueTypeLabel].(string)
+	return entities.ParseSecretValueType(valueType)
+}
+
 func formatHashicorpSecretMetadata(secret *api.Secret, version string) (*entities.Metadata, error) {
 	jsonMetadata := secret.Data
 
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"crypto/cipher"
+	"fmt"
+	"strconv"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+// Store is a secret store persisting secret values in Postgres, encrypted with AES-256-GCM under the data encryption key of the vault
+type Store struct {
+	aead   cipher.AEAD
+	db     database.EncryptedSecrets
+	logger log.Logger
+}
+
+var _ stores.SecretStore = &Store{}
+
+func New(aead cipher.AEAD, db database.EncryptedSecrets, logger log.Logger) *Store {
+	return &Store{
+		aead:   aead,
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (s *Store) Set(ctx context.Context, id, value string, attr *entities.Attributes) (*entities.Secret, error) {
+	logger := s.logger.With("id", id)
+
+	var secret *entities.EncryptedSecret
+	err := s.db.RunInTransaction(ctx, func(dbtx database.EncryptedSecrets) error {
+		latestVersion, err := dbtx.GetLatestVersion(ctx, id)
+		if err != nil {
+			return err
+		}
+		version := strconv.Itoa(latestVersion + 1)
+
+		ciphertext, err := aes.Seal(s.aead, []byte(value), additionalData(id, version))
+		if err != nil {
+			errMessage := "failed to encrypt secret"
+			logger.WithError(err).Error(errMessage)
+			return errors.CryptoOperationError(errMessage)
+		}
+
+		secret, err = dbtx.Add(ctx, &entities.EncryptedSecret{
+			ID:         id,
+			Ciphertext: ciphertext,
+			Tags:       attr.Tags,
+			Metadata:   &entities.Metadata{Version: version},
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(secret)
+}
+
+// CompareAndSet is only supported on the index of the store
+func (s *Store) CompareAndSet(_ context.Context, _, _, _ string, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("compare and set secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+// Generate is handled by the connector of the store
+func (s *Store) Generate(_ context.Context, _ string, _ *entities.SecretPolicy, _ *entities.Attributes) (*entities.Secret, error) {
+	err := errors.NotSupportedError("generate secrets is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
+	secret, err := s.db.Get(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(secret)
+}
+
+func (s *Store) List(ctx context.Context, limit, offset uint64) ([]string, error) {
+	return s.db.SearchIDs(ctx, false, limit, offset)
+}
+
+// ListVersions is only supported on the index of the store
+func (s *Store) ListVersions(_ context.Context, _ string) ([]*entities.Secret, error) {
+	err := errors.NotSupportedError("list secret versions is not supported")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
+func (s *Store) Delete(ctx context.Context, id string) error {
+	return s.db.Delete(ctx, id)
+}
+
+func (s *Store) GetDeleted(ctx context.Context, id string) (*entities.Secret, error) {
+	secret, err := s.db.GetDeleted(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Values of deleted secrets are not disclosed until restored
+	return &entities.Secret{
+		ID:       secret.ID,
+		Tags:     secret.Tags,
+		Metadata: secret.Metadata,
+	}, nil
+}
+
+func (s *Store) ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error) {
+	return s.db.SearchIDs(ctx, true, limit, offset)
+}
+
+func (s *Store) Restore(ctx context.Context, id string) error {
+	return s.db.Restore(ctx, id)
+}
+
+func (s *Store) Destroy(ctx context.Context, id string) error {
+	return s.db.Purge(ctx, id)
+}
+
+func (s *Store) RestoreVersion(ctx context.Context, id, version string) error {
+	return s.db.RestoreVersion(ctx, id, version)
+}
+
+func (s *Store) DestroyVersion(ctx context.Context, id, version string) error {
+	return s.db.PurgeVersion(ctx, id, version)
+}
+
+func (s *Store) decrypt(secret *entities.EncryptedSecret) (*entities.Secret, error) {
+	value, err := aes.Open(s.aead, secret.Ciphertext, additionalData(secret.ID, secret.Metadata.Version))
+	if err != nil {
+		errMessage := "failed to decrypt secret"
+		s.logger.With("id", secret.ID, "version", secret.Metadata.Version).WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return &entities.Secret{
+		ID:       secret.ID,
+		Value:    string(value),
+		Tags:     secret.Tags,
+		Metadata: secret.Metadata,
+	}, nil
+}
+
+// additionalData binds a ciphertext to its secret ID and version so encrypted values cannot be swapped between rows
+func additionalData(id, version string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", id, version))
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	testutils2 "github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type postgresSecretStoreTestSuite struct {
+	suite.Suite
+	mockDB      *mock.MockEncryptedSecrets
+	secretStore stores.SecretStore
+}
+
+func TestPostgresSecretStore(t *testing.T) {
+	s := new(postgresSecretStoreTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *postgresSecretStoreTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	key, err := aes.NewKey()
+	require.NoError(s.T(), err)
+	aead, err := aes.NewGCM(key)
+	require.NoError(s.T(), err)
+
+	s.mockDB = mock.NewMockEncryptedSecrets(ctrl)
+	s.mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, persist func(dbtx database.EncryptedSecrets) error) error {
+			return persist(s.mockDB)
+		}).AnyTimes()
+
+	s.secretStore = New(aead, s.mockDB, testutils2.NewMockLogger(ctrl))
+}
+
+func (s *postgresSecretStoreTestSuite) TestSet() {
+	ctx := context.Background()
+	id := "my-secret"
+	value := "my-value"
+	attributes := testutils.FakeAttributes()
+
+	s.Run("should set a new encrypted secret version successfully", func() {
+		s.mockDB.EXPECT().GetLatestVersion(gomock.Any(), id).Return(1, nil)
+		s.mockDB.EXPECT().Add(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, secret *entities.EncryptedSecret) (*entities.EncryptedSecret, error) {
+				assert.Equal(s.T(), "2", secret.Metadata.Version)
+				assert.NotContains(s.T(), string(secret.Ciphertext), value)
+				return secret, nil
+			})
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), "2", secret.Metadata.Version)
+		assert.Equal(s.T(), attributes.Tags, secret.Tags)
+	})
+
+	s.Run("should fail with same error if Add fails", func() {
+		expectedErr := errors.PostgresError("error")
+		s.mockDB.EXPECT().GetLatestVersion(gomock.Any(), id).Return(0, nil)
+		s.mockDB.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		secret, err := s.secretStore.Set(ctx, id, value, attributes)
+
+		assert.Nil(s.T(), secret)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
+func (s *postgresSecretStoreTestSuite) TestGet() {
+	ctx := context.Background()
+	id := "my-secret"
+	value := "my-value"
+	attributes := testutils.FakeAttributes()
+
+	var stored *entities.EncryptedSecret
+	s.mockDB.EXPECT().GetLatestVersion(gomock.Any(), id).Return(0, nil)
+	s.mockDB.EXPECT().Add(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, secret *entities.EncryptedSecret) (*entities.EncryptedSecret, error) {
+			stored = secret
+			return secret, nil
+		})
+	_, err := s.secretStore.Set(ctx, id, value, attributes)
+	require.NoError(s.T(), err)
+
+	s.Run("should get and decrypt a secret successfully", func() {
+		s.mockDB.EXPECT().Get(gomock.Any(), id, "1").Return(stored, nil)
+
+		secret, err := s.secretStore.Get(ctx, id, "1")
+
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), value, secret.Value)
+		assert.Equal(s.T(), "1", secret.Metadata.Version)
+	})
+
+	s.Run("should fail to decrypt a ciphertext bound to another secret", func() {
+		swapped := *stored
+		swapped.ID = "other-secret"
+		s.mockDB.EXPECT().Get(gomock.Any(), "other-secret", "").Return(&swapped, nil)
+
+		secret, err := s.secretStore.Get(ctx, "other-secret", "")
+
+		assert.Nil(s.T(), secret)
+		assert.True(s.T(), errors.IsCryptoOperationError(err))
+	})
+
+	s.Run("should fail with same error if Get fails", func() {
+		expectedErr := errors.NotFoundError("error")
+		s.mockDB.EXPECT().Get(gomock.Any(), id, "").Return(nil, expectedErr)
+
+		secret, err := s.secretStore.Get(ctx, id, "")
+
+		assert.Nil(s.T(), secret)
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
+
+func (s *postgresSecretStoreTestSuite) TestGetDeleted() {
+	ctx := context.Background()
+	id := "my-secret"
+
+	s.Run("should get a deleted secret without its value", func() {
+		s.mockDB.EXPECT().GetDeleted(gomock.Any(), id).Return(&entities.EncryptedSecret{
+			ID:         id,
+			Ciphertext: []byte("ciphertext"),
+			Metadata:   &entities.Metadata{Version: "3"},
+		}, nil)
+
+		secret, err := s.secretStore.GetDeleted(ctx, id)
+
+		require.NoError(s.T(), err)
+		assert.Empty(s.T(), secret.Value)
+		assert.Equal(s.T(), "3", secret.Metadata.Version)
+	})
+}
@@ -2,8 +2,11 @@ package stores
 
 import (
 	"context"
+	"time"
 
 	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	entities2 "github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -11,13 +14,13 @@ import (
 
 type Stores interface {
 	// CreateEthereum creates an ethereum store
-	CreateEthereum(_ context.Context, name, keyStore string, allowedTenants []string, userInfo *auth.UserInfo) error
+	CreateEthereum(_ context.Context, name, keyStore string, allowedTenants []string, recoveryPeriod time.Duration, userInfo *auth.UserInfo) error
 
-	// CreateKey creates a key store
-	CreateKey(_ context.Context, name, vault, secretStore string, allowedTenants []string, userInfo *auth.UserInfo) error
+	// CreateKey creates a key store, the private keys of a local key store are encrypted when a master key is given
+	CreateKey(_ context.Context, name, vault, secretStore string, replicaRegions, allowedTenants []string, recoveryPeriod time.Duration, masterKey, previousMasterKey *entities2.MasterKeyConfig, userInfo *auth.UserInfo) error
 
 	// CreateSecret creates a secret store
-	CreateSecret(_ context.Context, name, vault string, allowedTenants []string, userInfo *auth.UserInfo) error
+	CreateSecret(_ context.Context, name, vault, pathPrefix string, allowedTenants []string, recoveryPeriod time.Duration, userInfo *auth.UserInfo) error
 
 	// ImportEthereum import ethereum accounts from the vault into an ethereum store
 	ImportEthereum(ctx context.Context, name string, userInfo *auth.UserInfo) error
@@ -29,10 +32,10 @@ type Stores interface {
 	ImportSecrets(ctx context.Context, storeName string, userInfo *auth.UserInfo) error
 
 	// Secret get secret store by name
-	Secret(ctx context.Context, storeName string, userInfo *auth.UserInfo) (SecretStore, error)
+	Secret(ctx context.Context, storeName string, userInfo *auth.UserInfo) (IndexedSecretStore, error)
 
 	// Key get key store by name
-	Key(ctx context.Context, storeName string, userInfo *auth.UserInfo) (KeyStore, error)
+	Key(ctx context.Context, storeName string, userInfo *auth.UserInfo) (IndexedKeyStore, error)
 
 	// Ethereum get ethereum store by name
 	Ethereum(ctx context.Context, storeName string, userInfo *auth.UserInfo) (EthStore, error)
@@ -45,4 +48,13 @@ type Stores interface {
 
 	// ListAllAccounts list all accounts from all stores
 	ListAllAccounts(ctx context.Context, userInfo *auth.UserInfo) ([]common.Address, error)
+
+	// Migrate copies the items of a store into another store of the same type, preserving their IDs, tags and addresses
+	Migrate(ctx context.Context, storeName, destStoreName string, dryRun, deleteSource bool, userInfo *auth.UserInfo) ([]*entities.MigratedItem, error)
+
+	// Backup creates an encrypted archive of the items of a store along with their index
+	Backup(ctx context.Context, storeName string, encryption *entities.BackupEncryption, userInfo *auth.UserInfo) ([]byte, error)
+
+	// RestoreBackup restores an encrypted archive into an empty store
+	RestoreBackup(ctx context.Context, storeName string, archive []byte, decryption *entities.BackupDecryption, userInfo *auth.UserInfo) ([]*entities.MigratedItem, error)
 }
@@ -32,6 +32,8 @@ func (h *VaultsHandler) Register(ctx context.Context, mnfs []entities.Manifest)
 			err = h.CreateAzure(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
 		case entities.AWSVaultType:
 			err = h.CreateAWS(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
+		case entities.PostgresVaultType:
+			err = h.CreatePostgres(ctx, mnf.Name, mnf.AllowedTenants, mnf.Specs)
 		default:
 			return errors.InvalidFormatError("invalid vault type")
 		}
@@ -88,3 +90,18 @@ func (h *VaultsHandler) CreateAWS(ctx context.Context, name string, allowedTenan
 
 	return nil
 }
+
+func (h *VaultsHandler) CreatePostgres(ctx context.Context, name string, allowedTenants []string, specs interface{}) error {
+	config := &entities.PostgresConfig{}
+	err := json.UnmarshalYAML(specs, config)
+	if err != nil {
+		return errors.InvalidFormatError(err.Error())
+	}
+
+	err = h.vaults.CreatePostgres(ctx, name, config, allowedTenants, h.userInfo)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
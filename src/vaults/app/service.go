@@ -3,10 +3,15 @@ package app
 import (
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	db "github.com/consensys/quorum-key-manager/src/vaults/database/postgres"
 	"github.com/consensys/quorum-key-manager/src/vaults/service/vaults"
 )
 
-func RegisterService(logger log.Logger, roles auth.Roles) *vaults.Vaults {
+func RegisterService(logger log.Logger, postgresClient postgres.Client, roles auth.Roles) *vaults.Vaults {
+	// Data layer
+	dekRepository := db.NewDataEncryptionKeys(postgresClient)
+
 	// Business layer
-	return vaults.New(roles, logger)
+	return vaults.New(dekRepository, roles, logger)
 }
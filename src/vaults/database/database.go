@@ -0,0 +1,18 @@
+package database
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+//go:generate mockgen -source=database.go -destination=mock/database.go -package=mock
+
+type DataEncryptionKeys interface {
+	// Get gets the wrapped data encryption key of a vault
+	Get(ctx context.Context, vault string) (*entities.DataEncryptionKey, error)
+	// Insert inserts the wrapped data encryption key of a vault
+	Insert(ctx context.Context, dek *entities.DataEncryptionKey) (*entities.DataEncryptionKey, error)
+	// Update updates the wrapped data encryption key of a vault
+	Update(ctx context.Context, dek *entities.DataEncryptionKey) (*entities.DataEncryptionKey, error)
+}
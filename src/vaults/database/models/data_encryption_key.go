@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+type DataEncryptionKey struct {
+	tableName struct{} `pg:"data_encryption_keys"` // nolint:unused,structcheck // reason
+
+	Vault      string `pg:",pk"`
+	WrappedKey []byte
+	CreatedAt  time.Time `pg:"default:now()"`
+	UpdatedAt  time.Time `pg:"default:now()"`
+}
+
+func NewDataEncryptionKey(dek *entities.DataEncryptionKey) *DataEncryptionKey {
+	return &DataEncryptionKey{
+		Vault:      dek.Vault,
+		WrappedKey: dek.WrappedKey,
+		CreatedAt:  dek.CreatedAt,
+		UpdatedAt:  dek.UpdatedAt,
+	}
+}
+
+func (k *DataEncryptionKey) ToEntity() *entities.DataEncryptionKey {
+	return &entities.DataEncryptionKey{
+		Vault:      k.Vault,
+		WrappedKey: k.WrappedKey,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/consensys/quorum-key-manager/src/vaults/database"
+	"github.com/consensys/quorum-key-manager/src/vaults/database/models"
+)
+
+type DataEncryptionKeys struct {
+	pgClient postgres.Client
+}
+
+var _ database.DataEncryptionKeys = &DataEncryptionKeys{}
+
+func NewDataEncryptionKeys(pgClient postgres.Client) *DataEncryptionKeys {
+	return &DataEncryptionKeys{pgClient: pgClient}
+}
+
+func (r *DataEncryptionKeys) Get(ctx context.Context, vault string) (*entities.DataEncryptionKey, error) {
+	dekModel := &models.DataEncryptionKey{Vault: vault}
+
+	err := r.pgClient.SelectWhere(ctx, dekModel, "vault = ?", []string{}, vault)
+	if err != nil {
+		return nil, err
+	}
+
+	return dekModel.ToEntity(), nil
+}
+
+func (r *DataEncryptionKeys) Insert(ctx context.Context, dek *entities.DataEncryptionKey) (*entities.DataEncryptionKey, error) {
+	dekModel := models.NewDataEncryptionKey(dek)
+	dekModel.CreatedAt = time.Now()
+	dekModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.Insert(ctx, dekModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return dekModel.ToEntity(), nil
+}
+
+func (r *DataEncryptionKeys) Update(ctx context.Context, dek *entities.DataEncryptionKey) (*entities.DataEncryptionKey, error) {
+	dekModel := models.NewDataEncryptionKey(dek)
+	dekModel.UpdatedAt = time.Now()
+
+	err := r.pgClient.UpdateWhere(ctx, dekModel, "vault = ?", dek.Vault)
+	if err != nil {
+		return nil, err
+	}
+
+	return dekModel.ToEntity(), nil
+}
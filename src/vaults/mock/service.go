@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	cipher "crypto/cipher"
 	entities "github.com/consensys/quorum-key-manager/src/auth/entities"
 	entities0 "github.com/consensys/quorum-key-manager/src/entities"
 	gomock "github.com/golang/mock/gomock"
@@ -91,3 +92,32 @@ func (mr *MockVaultsMockRecorder) Get(ctx, name, userInfo interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVaults)(nil).Get), ctx, name, userInfo)
 }
+
+// CreatePostgres mocks base method
+func (m *MockVaults) CreatePostgres(ctx context.Context, name string, config *entities0.PostgresConfig, allowedTenants []string, userInfo *entities.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePostgres", ctx, name, config, allowedTenants, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePostgres indicates an expected call of CreatePostgres
+func (mr *MockVaultsMockRecorder) CreatePostgres(ctx, name, config, allowedTenants, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostgres", reflect.TypeOf((*MockVaults)(nil).CreatePostgres), ctx, name, config, allowedTenants, userInfo)
+}
+
+// DataEncryptionKey mocks base method
+func (m *MockVaults) DataEncryptionKey(ctx context.Context, name string, masterKey, previousMasterKey *entities0.MasterKeyConfig, userInfo *entities.UserInfo) (cipher.AEAD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataEncryptionKey", ctx, name, masterKey, previousMasterKey, userInfo)
+	ret0, _ := ret[0].(cipher.AEAD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DataEncryptionKey indicates an expected call of DataEncryptionKey
+func (mr *MockVaultsMockRecorder) DataEncryptionKey(ctx, name, masterKey, previousMasterKey, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataEncryptionKey", reflect.TypeOf((*MockVaults)(nil).DataEncryptionKey), ctx, name, masterKey, previousMasterKey, userInfo)
+}
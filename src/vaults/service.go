@@ -2,6 +2,7 @@ package vaults
 
 import (
 	"context"
+	"crypto/cipher"
 
 	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
 	"github.com/consensys/quorum-key-manager/src/entities"
@@ -19,6 +20,13 @@ type Vaults interface {
 	// CreateAWS creates an AWS KMS client
 	CreateAWS(ctx context.Context, name string, config *entities.AWSConfig, allowedTenants []string, userInfo *auth.UserInfo) error
 
+	// CreatePostgres creates a vault storing encrypted data in Postgres
+	CreatePostgres(ctx context.Context, name string, config *entities.PostgresConfig, allowedTenants []string, userInfo *auth.UserInfo) error
+
+	// DataEncryptionKey loads the data encryption key identified by name, wrapped by the given master key, and generates it on first use.
+	// If set, the previous master key is used to re-wrap a data encryption key still wrapped with it
+	DataEncryptionKey(ctx context.Context, name string, masterKey, previousMasterKey *entities.MasterKeyConfig, userInfo *auth.UserInfo) (cipher.AEAD, error)
+
 	// Get gets a valut by name
 	Get(ctx context.Context, name string, userInfo *auth.UserInfo) (*entities.Vault, error)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/auth/mock"
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/vaults/database/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,7 +19,7 @@ func TestCreateAWS(t *testing.T) {
 
 	logger := testutils.NewMockLogger(ctrl)
 	roles := mock.NewMockRoles(ctrl)
-	vault := New(roles, logger)
+	vault := New(mock2.NewMockDataEncryptionKeys(ctrl), roles, logger)
 
 	ctx := context.Background()
 	vaultName := "aws-vault"
@@ -0,0 +1,23 @@
+package vaults
+
+import (
+	"context"
+
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+)
+
+func (c *Vaults) CreatePostgres(ctx context.Context, name string, config *entities.PostgresConfig, allowedTenants []string, userInfo *auth.UserInfo) error {
+	logger := c.logger.With("name", name)
+	logger.Debug("creating postgres vault")
+
+	aead, err := c.DataEncryptionKey(ctx, name, config.MasterKey, config.PreviousMasterKey, userInfo)
+	if err != nil {
+		return err
+	}
+
+	c.createVault(name, entities.PostgresVaultType, allowedTenants, aead)
+
+	logger.Info("postgres vault created successfully")
+	return nil
+}
@@ -0,0 +1,122 @@
+package vaults
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	entities2 "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	localmasterkey "github.com/consensys/quorum-key-manager/src/infra/masterkey/local"
+	mock2 "github.com/consensys/quorum-key-manager/src/vaults/database/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePostgres(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	roles := mock.NewMockRoles(ctrl)
+	deks := mock2.NewMockDataEncryptionKeys(ctrl)
+	vault := New(deks, roles, logger)
+
+	ctx := context.Background()
+	vaultName := "postgres-vault"
+	userInfo := &entities2.UserInfo{Tenant: "tenant_id_1"}
+
+	masterKey, _ := aes.NewKey()
+	previousMasterKey, _ := aes.NewKey()
+	_ = os.Setenv("TEST_QKM_MASTER_KEY", base64.StdEncoding.EncodeToString(masterKey))
+	_ = os.Setenv("TEST_QKM_PREVIOUS_MASTER_KEY", base64.StdEncoding.EncodeToString(previousMasterKey))
+	defer os.Unsetenv("TEST_QKM_MASTER_KEY")
+	defer os.Unsetenv("TEST_QKM_PREVIOUS_MASTER_KEY")
+
+	cfg := &entities.PostgresConfig{
+		MasterKey: &entities.MasterKeyConfig{Env: "TEST_QKM_MASTER_KEY"},
+	}
+
+	t.Run("should generate a new data encryption key on first use", func(t *testing.T) {
+		deks.EXPECT().Get(gomock.Any(), vaultName).Return(nil, errors.NotFoundError("error"))
+		deks.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, dek *entities.DataEncryptionKey) (*entities.DataEncryptionKey, error) {
+				assert.Equal(t, vaultName, dek.Vault)
+				assert.NotEmpty(t, dek.WrappedKey)
+				return dek, nil
+			})
+
+		err := vault.CreatePostgres(ctx, vaultName, cfg, nil, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should use the data encryption key generated concurrently by another instance", func(t *testing.T) {
+		gomock.InOrder(
+			deks.EXPECT().Get(gomock.Any(), vaultName).Return(nil, errors.NotFoundError("error")),
+			deks.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil, errors.StatusConflictError("error")),
+			deks.EXPECT().Get(gomock.Any(), vaultName).Return(wrapDEK(t, masterKey, vaultName), nil),
+		)
+
+		err := vault.CreatePostgres(ctx, vaultName, cfg, nil, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should unwrap the existing data encryption key", func(t *testing.T) {
+		deks.EXPECT().Get(gomock.Any(), vaultName).Return(wrapDEK(t, masterKey, vaultName), nil)
+
+		err := vault.CreatePostgres(ctx, vaultName, cfg, nil, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail if the data encryption key cannot be unwrapped", func(t *testing.T) {
+		deks.EXPECT().Get(gomock.Any(), vaultName).Return(wrapDEK(t, previousMasterKey, vaultName), nil)
+
+		err := vault.CreatePostgres(ctx, vaultName, cfg, nil, userInfo)
+		assert.True(t, errors.IsCryptoOperationError(err))
+	})
+
+	t.Run("should re-wrap the data encryption key when rotating the master key", func(t *testing.T) {
+		rotationCfg := &entities.PostgresConfig{
+			MasterKey:         &entities.MasterKeyConfig{Env: "TEST_QKM_MASTER_KEY"},
+			PreviousMasterKey: &entities.MasterKeyConfig{Env: "TEST_QKM_PREVIOUS_MASTER_KEY"},
+		}
+
+		deks.EXPECT().Get(gomock.Any(), vaultName).Return(wrapDEK(t, previousMasterKey, vaultName), nil)
+		deks.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, dek *entities.DataEncryptionKey) (*entities.DataEncryptionKey, error) {
+				currentMasterKey, _ := localmasterkey.NewFromKey(masterKey)
+				_, err := currentMasterKey.Unwrap(ctx, dek.WrappedKey)
+				assert.NoError(t, err)
+				return dek, nil
+			})
+
+		err := vault.CreatePostgres(ctx, vaultName, rotationCfg, nil, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail if the master key cannot be loaded", func(t *testing.T) {
+		invalidCfg := &entities.PostgresConfig{
+			MasterKey: &entities.MasterKeyConfig{Env: "TEST_QKM_UNDEFINED_MASTER_KEY"},
+		}
+
+		err := vault.CreatePostgres(ctx, vaultName, invalidCfg, nil, userInfo)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func wrapDEK(t *testing.T, masterKey []byte, vaultName string) *entities.DataEncryptionKey {
+	key, err := localmasterkey.NewFromKey(masterKey)
+	require.NoError(t, err)
+
+	dek, _ := aes.NewKey()
+	wrappedKey, err := key.Wrap(context.Background(), dek)
+	require.NoError(t, err)
+
+	return &entities.DataEncryptionKey{Vault: vaultName, WrappedKey: wrappedKey}
+}
@@ -0,0 +1,170 @@
+package vaults
+
+import (
+	"context"
+	"crypto/cipher"
+
+	"github.com/consensys/quorum-key-manager/pkg/crypto/aes"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	auth "github.com/consensys/quorum-key-manager/src/auth/entities"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	akvinfra "github.com/consensys/quorum-key-manager/src/infra/akv"
+	awsinfra "github.com/consensys/quorum-key-manager/src/infra/aws"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/masterkey"
+	akvmasterkey "github.com/consensys/quorum-key-manager/src/infra/masterkey/akv"
+	awsmasterkey "github.com/consensys/quorum-key-manager/src/infra/masterkey/aws"
+	localmasterkey "github.com/consensys/quorum-key-manager/src/infra/masterkey/local"
+)
+
+// DataEncryptionKey loads the data encryption key identified by name, generating it on first use, and returns its cipher
+func (c *Vaults) DataEncryptionKey(ctx context.Context, name string, masterKeyCfg, previousMasterKeyCfg *entities.MasterKeyConfig, userInfo *auth.UserInfo) (cipher.AEAD, error) {
+	logger := c.logger.With("data_encryption_key", name)
+
+	masterKey, err := c.getMasterKey(ctx, masterKeyCfg, userInfo)
+	if err != nil {
+		errMessage := "failed to load master key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	dek, err := c.getDataEncryptionKey(ctx, name, masterKey, previousMasterKeyCfg, userInfo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := aes.NewGCM(dek)
+	if err != nil {
+		errMessage := "failed to instantiate data encryption cipher"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return aead, nil
+}
+
+func (c *Vaults) getMasterKey(ctx context.Context, cfg *entities.MasterKeyConfig, userInfo *auth.UserInfo) (masterkey.MasterKey, error) {
+	if cfg.Vault == "" {
+		masterKey, err := localmasterkey.New(localmasterkey.NewConfig(cfg.Env, cfg.Path))
+		if err != nil {
+			return nil, errors.InvalidParameterError(err.Error())
+		}
+
+		return masterKey, nil
+	}
+
+	vault, err := c.Get(ctx, cfg.Vault, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	switch vault.VaultType {
+	case entities.AzureVaultType:
+		return akvmasterkey.New(vault.Client.(akvinfra.KeysClient), cfg.KeyID), nil
+	case entities.AWSVaultType:
+		return awsmasterkey.New(vault.Client.(awsinfra.KmsClient), cfg.KeyID), nil
+	default:
+		return nil, errors.InvalidParameterError("master key vault must be an Azure or AWS vault")
+	}
+}
+
+// getDataEncryptionKey unwraps the data encryption key identified by name, generating it on first use unless another
+// instance generates it concurrently.
+// If the current master key cannot unwrap it, the previous master key is used and the key is re-wrapped (master key rotation)
+func (c *Vaults) getDataEncryptionKey(
+	ctx context.Context,
+	name string,
+	masterKey masterkey.MasterKey,
+	previousMasterKeyCfg *entities.MasterKeyConfig,
+	userInfo *auth.UserInfo,
+	logger log.Logger,
+) ([]byte, error) {
+	wrappedDEK, err := c.deks.Get(ctx, name)
+	if err != nil && errors.IsNotFoundError(err) {
+		dek, cerr := c.createDataEncryptionKey(ctx, name, masterKey, logger)
+		if cerr == nil || !errors.IsStatusConflictError(cerr) {
+			return dek, cerr
+		}
+
+		// Another instance created the data encryption key in the meantime, it is used instead
+		logger.Debug("data encryption key created concurrently, loading it")
+		wrappedDEK, err = c.deks.Get(ctx, name)
+	}
+	if err != nil {
+		errMessage := "failed to get data encryption key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	dek, err := masterKey.Unwrap(ctx, wrappedDEK.WrappedKey)
+	if err == nil {
+		return dek, nil
+	}
+
+	if previousMasterKeyCfg == nil {
+		errMessage := "failed to unwrap data encryption key with master key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	logger.Info("rotating master key of data encryption key")
+
+	previousMasterKey, err := c.getMasterKey(ctx, previousMasterKeyCfg, userInfo)
+	if err != nil {
+		errMessage := "failed to load previous master key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	dek, err = previousMasterKey.Unwrap(ctx, wrappedDEK.WrappedKey)
+	if err != nil {
+		errMessage := "failed to unwrap data encryption key with current and previous master keys"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	wrappedDEK.WrappedKey, err = masterKey.Wrap(ctx, dek)
+	if err != nil {
+		errMessage := "failed to wrap data encryption key with new master key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	_, err = c.deks.Update(ctx, wrappedDEK)
+	if err != nil {
+		errMessage := "failed to update data encryption key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("master key of data encryption key rotated successfully")
+	return dek, nil
+}
+
+func (c *Vaults) createDataEncryptionKey(ctx context.Context, name string, masterKey masterkey.MasterKey, logger log.Logger) ([]byte, error) {
+	dek, err := aes.NewKey()
+	if err != nil {
+		errMessage := "failed to generate data encryption key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	wrappedKey, err := masterKey.Wrap(ctx, dek)
+	if err != nil {
+		errMessage := "failed to wrap data encryption key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	_, err = c.deks.Insert(ctx, &entities.DataEncryptionKey{Vault: name, WrappedKey: wrappedKey})
+	if err != nil && errors.IsStatusConflictError(err) {
+		return nil, err
+	} else if err != nil {
+		errMessage := "failed to store data encryption key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	logger.Info("data encryption key generated")
+	return dek, nil
+}
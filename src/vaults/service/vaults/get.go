@@ -12,7 +12,7 @@ func (c *Vaults) Get(ctx context.Context, name string, userInfo *auth.UserInfo)
 	logger := c.logger.With("name", name)
 
 	permissions := c.roles.UserPermissions(ctx, userInfo)
-	resolver := authorizator.New(permissions, userInfo.Tenant, c.logger)
+	resolver := authorizator.New(ctx, permissions, userInfo.Tenant, c.logger)
 
 	vault, err := c.getVault(ctx, name, resolver)
 	if err != nil {
@@ -0,0 +1,27 @@
+package vaults
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/http/resilience"
+)
+
+type circuitBreakerClient interface {
+	CircuitBreaker() *resilience.CircuitBreaker
+}
+
+// CircuitBreakers reports the circuit breaker state of every vault whose client is protected by one
+func (c *Vaults) CircuitBreakers(_ context.Context) map[string]string {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	states := make(map[string]string)
+	for name, vault := range c.vaults {
+		if cli, ok := vault.Client.(circuitBreakerClient); ok {
+			states[name] = fmt.Sprintf("circuit breaker %s", cli.CircuitBreaker().State())
+		}
+	}
+
+	return states
+}
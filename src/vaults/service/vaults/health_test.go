@@ -0,0 +1,43 @@
+package vaults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/http/resilience"
+	"github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/entities"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/vaults/database/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeBreakerClient struct {
+	breaker *resilience.CircuitBreaker
+}
+
+func (c *fakeBreakerClient) CircuitBreaker() *resilience.CircuitBreaker {
+	return c.breaker
+}
+
+func TestCircuitBreakers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	connector := New(mock2.NewMockDataEncryptionKeys(ctrl), mock.NewMockRoles(ctrl), testutils.NewMockLogger(ctrl))
+
+	openBreaker := resilience.NewCircuitBreaker(1, time.Minute)
+	openBreaker.Failure()
+	connector.createVault("akv-europe", entities.AzureVaultType, nil, &fakeBreakerClient{breaker: openBreaker})
+	connector.createVault("aws-europe", entities.AWSVaultType, nil, &fakeBreakerClient{breaker: resilience.NewCircuitBreaker(1, time.Minute)})
+	connector.createVault("hashicorp-quorum", entities.HashicorpVaultType, nil, struct{}{})
+
+	states := connector.CircuitBreakers(context.Background())
+
+	assert.Equal(t, map[string]string{
+		"akv-europe": "circuit breaker open",
+		"aws-europe": "circuit breaker closed",
+	}, states)
+}
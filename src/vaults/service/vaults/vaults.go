@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/vaults"
+	"github.com/consensys/quorum-key-manager/src/vaults/database"
 )
 
 type Vaults struct {
@@ -16,16 +17,18 @@ type Vaults struct {
 	mux    sync.RWMutex
 	vaults map[string]*entities.Vault
 	roles  auth.Roles
+	deks   database.DataEncryptionKeys
 }
 
 var _ vaults.Vaults = &Vaults{}
 
-func New(roles auth.Roles, logger log.Logger) *Vaults {
+func New(deks database.DataEncryptionKeys, roles auth.Roles, logger log.Logger) *Vaults {
 	return &Vaults{
 		logger: logger,
 		mux:    sync.RWMutex{},
 		vaults: make(map[string]*entities.Vault),
 		roles:  roles,
+		deks:   deks,
 	}
 }
 
@@ -58,7 +58,7 @@ func (s *acceptanceTestSuite) SetupSuite() {
 	s.hasicorpPluginClient.SetToken(s.env.hashicorpToken)
 	require.NoError(s.T(), err)
 
-	s.auth = authorizator.New(authtypes.ListPermissions(), "", s.env.logger)
+	s.auth = authorizator.New(context.Background(), authtypes.ListPermissions(), "", s.env.logger)
 	s.utils = utilsservice.New(s.env.logger)
 	s.db = postgres.New(s.env.logger, s.env.postgresClient)
 
@@ -86,7 +86,7 @@ func (s *acceptanceTestSuite) TestSecrets() {
 	storeName := "acceptance_secret_store"
 	logger := s.env.logger.WithComponent(storeName)
 	db := s.db.Secrets(storeName)
-	secretStore := hashicorp.New(s.hashicorpKvv2Client, db, s.env.logger)
+	secretStore := hashicorp.New(s.hashicorpKvv2Client, db, "", s.env.logger)
 
 	testSuite := new(secretsTestSuite)
 	testSuite.env = s.env
@@ -119,9 +119,9 @@ func (s *acceptanceTestSuite) TestKeys() {
 	testSuite = new(keysTestSuite)
 	testSuite.env = s.env
 	testSuite.db = db
-	secretStore := hashicorp.New(s.hashicorpKvv2Client, secretsDB, s.env.logger)
+	secretStore := hashicorp.New(s.hashicorpKvv2Client, secretsDB, "", s.env.logger)
 	testSuite.utils = s.utils
-	testSuite.store = keys.NewConnector(local.New(secretStore, secretsDB, logger), db, s.auth, logger)
+	testSuite.store = keys.NewConnector(local.New(secretStore, secretsDB, nil, logger), db, s.auth, logger)
 
 	suite.Run(s.T(), testSuite)
 }
@@ -150,7 +150,7 @@ func (s *acceptanceTestSuite) TestEthereum() {
 	testSuite.env = s.env
 	testSuite.db = db
 	testSuite.utils = s.utils
-	testSuite.store = eth.NewConnector(local.New(hashicorp.New(s.hashicorpKvv2Client, secretsDB, logger), secretsDB, logger), db, s.auth, logger)
+	testSuite.store = eth.NewConnector(local.New(hashicorp.New(s.hashicorpKvv2Client, secretsDB, "", logger), secretsDB, nil, logger), db, s.auth, logger)
 
 	suite.Run(s.T(), testSuite)
 }
@@ -159,7 +159,7 @@ func (s *acceptanceTestSuite) TestAliases() {
 	aliasRepository := aliaspg.NewAlias(s.env.postgresClient)
 	registryRepository := aliaspg.NewRegistry(s.env.postgresClient)
 
-	rolesService := roles.New(s.env.logger)
+	rolesService := roles.New(nil, s.env.logger)
 
 	testSuite := new(aliasStoreTestSuite)
 	testSuite.env = s.env
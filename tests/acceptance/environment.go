@@ -200,6 +200,7 @@ func (env *IntegrationEnvironment) createTables() error {
 	for _, v := range []interface{}{
 		&models.Secret{},
 		&models.Key{},
+		&models.KeyVersion{},
 		&models.ETHAccount{},
 		&models2.Registry{},
 		&models2.Alias{},
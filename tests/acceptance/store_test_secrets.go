@@ -3,6 +3,7 @@ package acceptancetests
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/consensys/quorum-key-manager/src/stores/database"
@@ -78,6 +79,38 @@ func (s *secretsTestSuite) TestSet() {
 		assert.True(s.T(), secret.Metadata.DeletedAt.IsZero())
 		assert.False(s.T(), secret.Metadata.Disabled)
 	})
+
+	s.Run("should create a secret only once when created concurrently", func() {
+		id := s.newID("my-secret")
+		nbWriters := 5
+
+		var wg sync.WaitGroup
+		errs := make(chan error, nbWriters)
+		for i := 0; i < nbWriters; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, err := s.store.CompareAndSet(ctx, id, fmt.Sprintf("my-secret-value-%d", i), "", &entities.Attributes{})
+				errs <- err
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+
+		created := 0
+		for err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.True(s.T(), errors.IsStatusConflictError(err))
+		}
+		assert.Equal(s.T(), 1, created)
+
+		versions, err := s.store.ListVersions(ctx, id)
+		require.NoError(s.T(), err)
+		assert.Len(s.T(), versions, 1)
+	})
 }
 
 func (s *secretsTestSuite) TestList() {
@@ -3,6 +3,7 @@
 package e2e
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"os"
@@ -112,6 +113,42 @@ func (s *secretsTestSuite) TestSet() {
 	})
 }
 
+func (s *secretsTestSuite) TestGenerate() {
+	s.RunT("should generate a secret without returning its value", func() {
+		secretID := fmt.Sprintf("my-secret-generate-%s", common.RandString(10))
+		secret, err := s.env.client.GenerateSecret(s.env.ctx, s.storeName, secretID, &types.GenerateSecretRequest{
+			Generator: "bytes",
+			Length:    16,
+		})
+		require.NoError(s.T(), err)
+		defer s.queueToDelete(secret)
+
+		assert.Empty(s.T(), secret.Value)
+		assert.Equal(s.T(), "base64", secret.ValueType)
+
+		secret, err = s.env.client.GetSecret(s.env.ctx, s.storeName, secretID, "")
+		require.NoError(s.T(), err)
+		value, err := base64.StdEncoding.DecodeString(secret.Value)
+		require.NoError(s.T(), err)
+		assert.Len(s.T(), value, 16)
+	})
+
+	s.RunT("should set and get a JSON secret", func() {
+		secretID := fmt.Sprintf("my-secret-json-%s", common.RandString(10))
+		secret, err := s.env.client.SetSecret(s.env.ctx, s.storeName, secretID, &types.SetSecretRequest{
+			Value:     `{"key":"value"}`,
+			ValueType: "json",
+		})
+		require.NoError(s.T(), err)
+		defer s.queueToDelete(secret)
+
+		secret, err = s.env.client.GetSecret(s.env.ctx, s.storeName, secretID, "")
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), `{"key":"value"}`, secret.Value)
+		assert.Equal(s.T(), "json", secret.ValueType)
+	})
+}
+
 func (s *secretsTestSuite) TestGetSecret() {
 	secretID := fmt.Sprintf("my-secret-get-%s", common.RandString(10))
 	request := &types.SetSecretRequest{
@@ -288,6 +325,44 @@ func (s *secretsTestSuite) TestDestroyDeleted() {
 	})
 }
 
+func (s *secretsTestSuite) TestSecretVersions() {
+	secretID := fmt.Sprintf("my-secret-versions-%s", common.RandString(10))
+	request := &types.SetSecretRequest{
+		Value: "my-secret-value",
+	}
+
+	secret, err := s.env.client.SetSecret(s.env.ctx, s.storeName, secretID, request)
+	require.NoError(s.T(), err)
+	defer s.queueToDelete(secret)
+
+	s.RunT("should fail to set a secret if its latest version is not the expected one", func() {
+		_, err := s.env.client.SetSecret(s.env.ctx, s.storeName, secretID, &types.SetSecretRequest{
+			Value:           "my-secret-value-2",
+			ExpectedVersion: "unknown-version",
+		})
+
+		httpError, ok := err.(*client.ResponseError)
+		require.True(s.T(), ok)
+		assert.Equal(s.T(), http.StatusConflict, httpError.StatusCode)
+	})
+
+	s.RunT("should set a secret if its latest version is the expected one and list its versions", func() {
+		secret2, err := s.env.client.SetSecret(s.env.ctx, s.storeName, secretID, &types.SetSecretRequest{
+			Value:           "my-secret-value-2",
+			ExpectedVersion: secret.Version,
+		})
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), "my-secret-value-2", secret2.Value)
+
+		versions, err := s.env.client.ListSecretVersions(s.env.ctx, s.storeName, secretID)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), versions, 2)
+		assert.Equal(s.T(), secret.Version, versions[0].Version)
+		assert.Equal(s.T(), secret2.Version, versions[1].Version)
+		assert.Empty(s.T(), versions[0].Value)
+	})
+}
+
 func (s *secretsTestSuite) TestList() {
 	secretID := fmt.Sprintf("my-secret-list-%s", common.RandString(10))
 	request := &types.SetSecretRequest{